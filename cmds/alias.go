@@ -0,0 +1,79 @@
+package cmds
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum-currency/digest"
+)
+
+var aliasResolveTimeout = time.Second * 5
+
+// resolveAlias finds the address of alias through the digest API, u.
+func resolveAlias(
+	enc *jsonenc.Encoder,
+	u *url.URL,
+	insecure bool,
+	name currency.AliasName,
+) (base.Address, error) {
+	if u == nil {
+		return nil, xerrors.Errorf("--digest is needed to resolve alias, %q", name)
+	}
+
+	au := *u
+	au.Path = path.Join(au.Path, "alias", name.String())
+
+	client := &http.Client{
+		Timeout: aliasResolveTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure}, // nolint:gosec
+		},
+	}
+
+	res, err := client.Get(au.String()) // nolint:noctx
+	if err != nil {
+		return nil, xerrors.Errorf("failed to request alias, %q: %w", name, err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, xerrors.Errorf("alias, %q not found", name)
+	default:
+		return nil, xerrors.Errorf("failed to request alias, %q: status=%d", name, res.StatusCode)
+	}
+
+	var uhal struct {
+		I json.RawMessage `json:"_embedded"`
+	}
+
+	if b, err := io.ReadAll(res.Body); err != nil {
+		return nil, err
+	} else if err := enc.Unmarshal(b, &uhal); err != nil {
+		return nil, err
+	}
+
+	if hinter, err := enc.DecodeByHint(uhal.I); err != nil {
+		return nil, err
+	} else if va, ok := hinter.(digest.AliasValue); !ok {
+		return nil, xerrors.Errorf("not digest.AliasValue: %T", hinter)
+	} else if va.IsReleased() || va.Name() != name {
+		return nil, xerrors.Errorf("alias, %q not found", name)
+	} else {
+		return va.Address(), nil
+	}
+}
@@ -40,6 +40,10 @@ var BaseNodeCommandHooks = func(cmd *BaseNodeCommand) []pm.Hook {
 			"load_digest_config", cmd.hookLoadDigestConfig).
 			SetOverride(true).
 			SetDir(process.HookNameConfigGenesisOperations, pm.HookDirAfter),
+		pm.NewHook(pm.HookPrefixPost, process.ProcessNameConfig,
+			"load_block_config", cmd.hookLoadBlockConfig).
+			SetOverride(true).
+			SetDir("load_digest_config", pm.HookDirAfter),
 		pm.NewHook(pm.HookPrefixPost, process.ProcessNameConfig,
 			"validate_digest_config", cmd.hookValidateDigestConfig).
 			SetOverride(true).
@@ -84,7 +88,7 @@ func HookLoadCurrencies(ctx context.Context) (context.Context, error) {
 
 	cp := currency.NewCurrencyPool()
 
-	if err := digest.LoadCurrenciesFromDatabase(st, base.NilHeight, func(sta state.State) (bool, error) {
+	if err := digest.LoadCurrencyRecordsFromDatabase(st, base.NilHeight, func(sta state.State) (bool, error) {
 		if err := cp.Set(sta); err != nil {
 			return false, err
 		} else {
@@ -96,6 +100,13 @@ func HookLoadCurrencies(ctx context.Context) (context.Context, error) {
 		return ctx, err
 	}
 
+	switch m, found, err := st.LastManifest(); {
+	case err != nil:
+		return ctx, err
+	case found:
+		cp.SetHeight(m.Height())
+	}
+
 	return context.WithValue(ctx, ContextValueCurrencyPool, cp), nil
 }
 
@@ -131,10 +142,19 @@ func HookInitializeProposalProcessor(ctx context.Context) (context.Context, erro
 		return ctx, err
 	}
 
+	var st *mongodbstorage.Database
+	if err := LoadDatabaseContextValue(ctx, &st); err != nil {
+		return ctx, err
+	}
+
 	if opr, err := AttachProposalProcessor(policy, nodepool, suffrage, cp); err != nil {
 		return ctx, err
 	} else {
-		return InitializeProposalProcessor(ctx, opr)
+		return InitializeProposalProcessor(
+			ctx,
+			opr.SetLastManifest(st.LastManifest).
+				SetHasOperationFact(st.HasOperationFact),
+		)
 	}
 }
 
@@ -151,33 +171,61 @@ func AttachProposalProcessor(
 		return nil, err
 	} else if _, err := opr.SetProcessor(currency.Transfers{}, currency.NewTransfersProcessor(cp)); err != nil {
 		return nil, err
-	}
-
-	var threshold base.Threshold
-	if i, err := base.NewThreshold(uint(len(suffrage.Nodes())), policy.ThresholdRatio()); err != nil {
+	} else if _, err := opr.SetProcessor(currency.CreateClaimableBalance{},
+		currency.NewCreateClaimableBalanceProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ClaimBalance{}, currency.NewClaimBalanceProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ReclaimBalance{}, currency.NewReclaimBalanceProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.Approve{}, currency.NewApproveProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.TransferFrom{}, currency.NewTransferFromProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.MultiTransfers{}, currency.NewMultiTransfersProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.Batch{}, currency.NewBatchProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AccountMerge{}, currency.NewAccountMergeProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.GuardiansUpdater{},
+		currency.NewGuardiansUpdaterProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.KeyRecovery{}, currency.NewKeyRecoveryProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.KeyRecoveryCanceler{},
+		currency.NewKeyRecoveryCancelerProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AccountPolicyUpdater{},
+		currency.NewAccountPolicyUpdaterProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AliasRegister{}, currency.NewAliasRegisterProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AliasTransfer{}, currency.NewAliasTransferProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AliasRelease{}, currency.NewAliasReleaseProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AccountDataUpdater{},
+		currency.NewAccountDataUpdaterProcessor(cp)); err != nil {
 		return nil, err
-	} else {
-		threshold = i
 	}
 
-	suffrageNodes := suffrage.Nodes()
-	pubs := make([]key.Publickey, len(suffrageNodes))
-	for i := range suffrageNodes {
-		if n, found := nodepool.Node(suffrageNodes[i]); !found {
-			return nil, xerrors.Errorf("suffrage node, %q not found in nodepool", suffrageNodes[i])
-		} else {
-			pubs[i] = n.Publickey()
-		}
-	}
+	suffrageKeys := NewSuffrageKeys(policy, nodepool, suffrage)
 
 	if _, err := opr.SetProcessor(currency.CurrencyRegister{},
-		currency.NewCurrencyRegisterProcessor(cp, pubs, threshold),
+		currency.NewCurrencyRegisterProcessor(cp, suffrageKeys),
 	); err != nil {
 		return nil, err
 	}
 
 	if _, err := opr.SetProcessor(currency.CurrencyPolicyUpdater{},
-		currency.NewCurrencyPolicyUpdaterProcessor(cp, pubs, threshold),
+		currency.NewCurrencyPolicyUpdaterProcessor(cp, suffrageKeys),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(currency.NetworkPolicyUpdater{},
+		currency.NewNetworkPolicyUpdaterProcessor(suffrageKeys),
 	); err != nil {
 		return nil, err
 	}
@@ -185,6 +233,39 @@ func AttachProposalProcessor(
 	return opr, nil
 }
 
+// NewSuffrageKeys returns the currency.SuffrageKeys, which loads the publickeys
+// of all the suffrage nodes from nodepool and the threshold from policy by each
+// proposal, so the changed suffrage and node keys are applied without restart.
+// The acting suffrage is not used; it rotates by height and round, but every
+// suffrage node can sign the operations.
+func NewSuffrageKeys(
+	policy *isaac.LocalPolicy,
+	nodepool *network.Nodepool,
+	suffrage base.Suffrage,
+) currency.SuffrageKeys {
+	return func(base.Height) ([]key.Publickey, base.Threshold, error) {
+		suffrageNodes := suffrage.Nodes()
+
+		var threshold base.Threshold
+		if i, err := base.NewThreshold(uint(len(suffrageNodes)), policy.ThresholdRatio()); err != nil {
+			return nil, threshold, err
+		} else {
+			threshold = i
+		}
+
+		pubs := make([]key.Publickey, len(suffrageNodes))
+		for i := range suffrageNodes {
+			if n, found := nodepool.Node(suffrageNodes[i]); !found {
+				return nil, threshold, xerrors.Errorf("suffrage node, %q not found in nodepool", suffrageNodes[i])
+			} else {
+				pubs[i] = n.Publickey()
+			}
+		}
+
+		return pubs, threshold, nil
+	}
+}
+
 func InitializeProposalProcessor(ctx context.Context, opr *currency.OperationProcessor) (context.Context, error) {
 	var oprs *hint.Hintmap
 	if err := process.LoadOperationProcessorsContextValue(ctx, &oprs); err != nil {
@@ -204,7 +285,24 @@ func InitializeProposalProcessor(ctx context.Context, opr *currency.OperationPro
 		currency.KeyUpdater{},
 		currency.Transfers{},
 		currency.CurrencyPolicyUpdater{},
+		currency.NetworkPolicyUpdater{},
 		currency.CurrencyRegister{},
+		currency.CreateClaimableBalance{},
+		currency.ClaimBalance{},
+		currency.ReclaimBalance{},
+		currency.Approve{},
+		currency.TransferFrom{},
+		currency.MultiTransfers{},
+		currency.Batch{},
+		currency.AccountMerge{},
+		currency.GuardiansUpdater{},
+		currency.KeyRecovery{},
+		currency.KeyRecoveryCanceler{},
+		currency.AccountPolicyUpdater{},
+		currency.AliasRegister{},
+		currency.AliasTransfer{},
+		currency.AliasRelease{},
+		currency.AccountDataUpdater{},
 	} {
 		if err := oprs.Add(hinter, opr); err != nil {
 			return ctx, err
@@ -263,6 +361,25 @@ func (cmd *BaseNodeCommand) hookLoadDigestConfig(ctx context.Context) (context.C
 	return context.WithValue(ctx, ContextValueDigestDesign, design), nil
 }
 
+func (cmd *BaseNodeCommand) hookLoadBlockConfig(ctx context.Context) (context.Context, error) {
+	var source []byte
+	if err := process.LoadConfigSourceContextValue(ctx, &source); err != nil {
+		return ctx, err
+	}
+
+	var m struct {
+		Block *BlockDesign
+	}
+
+	if err := yaml.Unmarshal(source, &m); err != nil {
+		return ctx, err
+	} else if m.Block == nil {
+		return ctx, nil
+	}
+
+	return context.WithValue(ctx, ContextValueBlockDesign, *m.Block), nil
+}
+
 func (cmd *BaseNodeCommand) hookValidateDigestConfig(ctx context.Context) (context.Context, error) {
 	var conf config.LocalNode
 	if err := config.LoadConfigContextValue(ctx, &conf); err != nil {
@@ -402,17 +519,30 @@ func hookVerboseConfig(ctx context.Context) (context.Context, error) {
 
 	m["digest"] = dd
 
+	var bd BlockDesign
+	if err := LoadBlockDesignContextValue(ctx, &bd); err != nil {
+		if !xerrors.Is(err, util.ContextValueNotFoundError) {
+			return ctx, err
+		}
+	}
+
+	m["block"] = bd
+
 	log.Debug().Interface("config", m).Msg("config loaded")
 
 	return ctx, nil
 }
 
 type OperationFlags struct {
-	Privatekey PrivatekeyFlag          `arg:"" name:"privatekey" help:"privatekey to sign operation" required:""`
-	Token      string                  `help:"token for operation" optional:""`
-	NetworkID  mitumcmds.NetworkIDFlag `name:"network-id" help:"network-id" required:""`
-	Memo       string                  `name:"memo" help:"memo"`
-	Pretty     bool                    `name:"pretty" help:"pretty format"`
+	Privatekey  PrivatekeyFlag          `arg:"" name:"privatekey" help:"privatekey to sign operation" required:""`
+	Token       string                  `help:"token for operation" optional:""`
+	NetworkID   mitumcmds.NetworkIDFlag `name:"network-id" help:"network-id" required:""`
+	Memo        string                  `name:"memo" help:"memo"`
+	Pretty      bool                    `name:"pretty" help:"pretty format"`
+	Digest      *url.URL                `name:"digest" help:"digest api url to resolve alias, \"@<alias>\""`
+	TLSInsecure bool                    `name:"tls-insecure" help:"allow insecure TLS connection to digest api"`
+	Expire      ExpiryFlag              `name:"expire" help:"expire operation after height or time, \"<height>,<RFC3339 time>\""`
+	Sequence    uint64                  `name:"sequence" help:"next sequence of account; 0 means no sequence"`
 }
 
 func (op *OperationFlags) IsValid([]byte) error {
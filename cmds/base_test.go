@@ -0,0 +1,82 @@
+package cmds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/isaac"
+	"github.com/spikeekips/mitum/network"
+	"github.com/spikeekips/mitum/util"
+)
+
+// changingSuffrage has the acting nodes less than the suffrage nodes, and the
+// suffrage nodes can be changed.
+type changingSuffrage struct {
+	*base.FixedSuffrage
+	nodes  []base.Address
+	acting []base.Address
+}
+
+func (sf *changingSuffrage) Nodes() []base.Address {
+	return sf.nodes
+}
+
+func (sf *changingSuffrage) Acting(height base.Height, round base.Round) (base.ActingSuffrage, error) {
+	return base.NewActingSuffrage(height, round, sf.acting[0], sf.acting), nil
+}
+
+type testSuffrageKeys struct {
+	suite.Suite
+}
+
+func (t *testSuffrageKeys) newNode() *network.LocalNode {
+	return network.NewLocalNode(currency.MustAddress(util.UUID().String()), key.MustNewBTCPrivatekey(), "")
+}
+
+func (t *testSuffrageKeys) TestAllSuffrageNodes() {
+	local := t.newNode()
+	remote := t.newNode()
+
+	nodepool := network.NewNodepool(local)
+	t.NoError(nodepool.Add(remote))
+
+	nodes := []base.Address{local.Address(), remote.Address()}
+	suffrage := &changingSuffrage{
+		FixedSuffrage: base.NewFixedSuffrage(local.Address(), nodes),
+		nodes:         nodes,
+		acting:        []base.Address{local.Address()},
+	}
+
+	suffrageKeys := NewSuffrageKeys(isaac.NewLocalPolicy(nil), nodepool, suffrage)
+
+	// NOTE the suffrage nodes, not acting at the height, are also included
+	pubs, threshold, err := suffrageKeys(base.Height(10))
+	t.NoError(err)
+	t.Equal(2, len(pubs))
+	t.True(pubs[0].Equal(local.Publickey()))
+	t.True(pubs[1].Equal(remote.Publickey()))
+	t.Equal(uint(2), threshold.Total)
+
+	// NOTE the changed suffrage nodes are loaded by the next proposal
+	suffrage.nodes = []base.Address{local.Address()}
+
+	pubs, threshold, err = suffrageKeys(base.Height(11))
+	t.NoError(err)
+	t.Equal(1, len(pubs))
+	t.Equal(uint(1), threshold.Total)
+
+	// NOTE unknown node in nodepool
+	suffrage.nodes = nodes
+	t.NoError(nodepool.Remove(remote.Address()))
+
+	_, _, err = suffrageKeys(base.Height(12))
+	t.Contains(err.Error(), "not found in nodepool")
+}
+
+func TestSuffrageKeys(t *testing.T) {
+	suite.Run(t, new(testSuffrageKeys))
+}
@@ -19,12 +19,17 @@ var (
 	ContextValueDigestNetwork  util.ContextKey = "digest_network"
 	ContextValueDigester       util.ContextKey = "digester"
 	ContextValueCurrencyPool   util.ContextKey = "currency_pool"
+	ContextValueBlockDesign    util.ContextKey = "block_design"
 )
 
 func LoadDigestDesignContextValue(ctx context.Context, l *DigestDesign) error {
 	return util.LoadFromContextValue(ctx, ContextValueDigestDesign, l)
 }
 
+func LoadBlockDesignContextValue(ctx context.Context, l *BlockDesign) error {
+	return util.LoadFromContextValue(ctx, ContextValueBlockDesign, l)
+}
+
 func LoadDatabaseContextValue(ctx context.Context, l **mongodbstorage.Database) error {
 	st := (storage.Database)(nil)
 	if err := process.LoadDatabaseContextValue(ctx, &st); err != nil {
@@ -22,6 +22,7 @@ type CreateAccountCommand struct {
 	Big       BigFlag        `arg:"" name:"big" help:"big to send" required:""`
 	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
 	Keys      []KeyFlag      `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
+	Salt      string         `name:"salt" help:"salt for the address of new account" optional:""`
 	Seal      FileLoad       `help:"seal" optional:""`
 	sender    base.Address
 	keys      currency.Keys
@@ -68,7 +69,7 @@ func (cmd *CreateAccountCommand) parseFlags() error {
 		return err
 	}
 
-	if a, err := cmd.Sender.Encode(jenc); err != nil {
+	if a, err := cmd.Sender.Resolve(jenc, cmd.Digest, cmd.TLSInsecure); err != nil {
 		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
 	} else {
 		cmd.sender = a
@@ -113,7 +114,13 @@ func (cmd *CreateAccountCommand) createOperation() (operation.Operation, error)
 		return nil, err
 	}
 
-	item := currency.NewCreateAccountsItemSingleAmount(cmd.keys, am)
+	var item currency.CreateAccountsItem
+	if len(cmd.Salt) > 0 {
+		item = currency.NewCreateAccountsItemSalted(cmd.keys, []currency.Amount{am}, cmd.Salt)
+	} else {
+		item = currency.NewCreateAccountsItemSingleAmount(cmd.keys, am)
+	}
+
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	} else {
@@ -121,6 +128,13 @@ func (cmd *CreateAccountCommand) createOperation() (operation.Operation, error)
 	}
 
 	fact := currency.NewCreateAccountsFact([]byte(cmd.Token), cmd.sender, items)
+	if !cmd.Expire.IsEmpty() {
+		fact = fact.WithExpiry(cmd.Expire.Expiry)
+	}
+
+	if cmd.Sequence > 0 {
+		fact = fact.WithSequence(cmd.Sequence)
+	}
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID()); err != nil {
@@ -4,6 +4,7 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
 )
@@ -11,12 +12,14 @@ import (
 type CurrencyPolicyUpdaterCommand struct {
 	*BaseCommand
 	OperationFlags
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:""`
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:""`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:""`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	po                      currency.CurrencyPolicy
+	Currency                  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:""`
+	CurrencyPolicyFlags       `prefix:"policy-" help:"currency policy" required:""`
+	FeeerString               string `name:"feeer" help:"feeer type, {nil, fixed, ratio, dynamic}" required:""`
+	CurrencyFixedFeeerFlags   `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags   `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyDynamicFeeerFlags `prefix:"feeer-dynamic-" help:"dynamic feeer"`
+	ActivationHeight          int64 `name:"activation-height" help:"activate policy from height; 0 means right away"`
+	po                        currency.CurrencyPolicy
 }
 
 func NewCurrencyPolicyUpdaterCommand() CurrencyPolicyUpdaterCommand {
@@ -67,10 +70,16 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	}
 
+	if cmd.ActivationHeight < 0 {
+		return xerrors.Errorf("invalid activation height, %d", cmd.ActivationHeight)
+	}
+
 	if err := cmd.CurrencyFixedFeeerFlags.IsValid(nil); err != nil {
 		return err
 	} else if err := cmd.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyDynamicFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer currency.Feeer
@@ -81,6 +90,8 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		feeer = cmd.CurrencyFixedFeeerFlags.feeer
 	case currency.FeeerRatio:
 		feeer = cmd.CurrencyRatioFeeerFlags.feeer
+	case currency.FeeerDynamic:
+		feeer = cmd.CurrencyDynamicFeeerFlags.feeer
 	default:
 		return xerrors.Errorf("unknown feeer type, %q", t)
 	}
@@ -91,7 +102,7 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	}
 
-	po := currency.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	po := cmd.CurrencyPolicyFlags.policy(feeer)
 	if err := po.IsValid(nil); err != nil {
 		return err
 	} else {
@@ -105,6 +116,13 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 
 func (cmd *CurrencyPolicyUpdaterCommand) createOperation() (currency.CurrencyPolicyUpdater, error) {
 	fact := currency.NewCurrencyPolicyUpdaterFact([]byte(cmd.Token), cmd.Currency.CID, cmd.po)
+	if cmd.ActivationHeight > 0 {
+		fact = fact.WithActivation(base.Height(cmd.ActivationHeight))
+	}
+
+	if !cmd.Expire.IsEmpty() {
+		fact = fact.WithExpiry(cmd.Expire.Expiry)
+	}
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(
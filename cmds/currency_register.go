@@ -70,23 +70,87 @@ func (fl *CurrencyRatioFeeerFlags) IsValid([]byte) error {
 	return nil
 }
 
+type CurrencyDynamicFeeerFlags struct {
+	Receiver    AddressFlag `name:"receiver" help:"fee receiver account address"`
+	Min         BigFlag     `name:"min" help:"minimum base fee"`
+	Max         BigFlag     `name:"max" help:"maximum base fee"`
+	Target      uint        `name:"target" help:"target utilization of block capacity in percent"`
+	Denominator uint        `name:"denominator" help:"base fee changes by 1/denominator in block" default:"8"`
+	feeer       currency.Feeer
+}
+
+func (fl *CurrencyDynamicFeeerFlags) IsValid([]byte) error {
+	if len(fl.Receiver.String()) < 1 {
+		return nil
+	}
+
+	var receiver base.Address
+	if a, err := fl.Receiver.Encode(jenc); err != nil {
+		return xerrors.Errorf("invalid receiver format, %q: %w", fl.Receiver.String(), err)
+	} else if err := a.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid receiver address, %q: %w", fl.Receiver.String(), err)
+	} else {
+		receiver = a
+	}
+
+	max := fl.Max.Big
+	if max.Int == nil {
+		max = currency.UnlimitedMaxFeeAmount
+	}
+
+	feeer := currency.NewDynamicFeeer(receiver, fl.Min.Big, max, fl.Target, fl.Denominator)
+	if err := feeer.IsValid(nil); err != nil {
+		return err
+	} else {
+		fl.feeer = feeer
+	}
+
+	return nil
+}
+
 type CurrencyPolicyFlags struct {
 	NewAccountMinBalance BigFlag `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	MinBalance           BigFlag `name:"min-balance" help:"minimum balance retained by account"`         // nolint lll
+	MinTransferAmount    BigFlag `name:"min-transfer-amount" help:"minimum amount of transfer"`          // nolint lll
+	MaxTransferAmount    BigFlag `name:"max-transfer-amount" help:"maximum amount of transfer"`          // nolint lll
 }
 
 func (fl *CurrencyPolicyFlags) IsValid([]byte) error {
 	return nil
 }
 
+func (fl *CurrencyPolicyFlags) policy(feeer currency.Feeer) currency.CurrencyPolicy {
+	po := currency.NewCurrencyPolicy(fl.NewAccountMinBalance.Big, feeer)
+	if fl.MinBalance.OverZero() {
+		po = po.WithMinBalance(fl.MinBalance.Big)
+	}
+
+	if fl.MinTransferAmount.OverZero() || fl.MaxTransferAmount.OverZero() {
+		min, max := currency.ZeroBig, currency.ZeroBig
+		if fl.MinTransferAmount.OverZero() {
+			min = fl.MinTransferAmount.Big
+		}
+
+		if fl.MaxTransferAmount.OverZero() {
+			max = fl.MaxTransferAmount.Big
+		}
+
+		po = po.WithTransferLimits(min, max)
+	}
+
+	return po
+}
+
 type CurrencyDesignFlags struct {
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:""`
-	GenesisAmount           BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:""`
-	GenesisAccount          AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:""` // nolint lll
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:""`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:""`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	currencyDesign          currency.CurrencyDesign
+	Currency                  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:""`
+	GenesisAmount             BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:""`
+	GenesisAccount            AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:""` // nolint lll
+	CurrencyPolicyFlags       `prefix:"policy-" help:"currency policy" required:""`
+	FeeerString               string `name:"feeer" help:"feeer type, {nil, fixed, ratio, dynamic}" required:""`
+	CurrencyFixedFeeerFlags   `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags   `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyDynamicFeeerFlags `prefix:"feeer-dynamic-" help:"dynamic feeer"`
+	currencyDesign            currency.CurrencyDesign
 }
 
 func (fl *CurrencyDesignFlags) IsValid([]byte) error {
@@ -96,6 +160,8 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	} else if err := fl.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := fl.CurrencyDynamicFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer currency.Feeer
@@ -106,6 +172,8 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		feeer = fl.CurrencyFixedFeeerFlags.feeer
 	case currency.FeeerRatio:
 		feeer = fl.CurrencyRatioFeeerFlags.feeer
+	case currency.FeeerDynamic:
+		feeer = fl.CurrencyDynamicFeeerFlags.feeer
 	default:
 		return xerrors.Errorf("unknown feeer type, %q", t)
 	}
@@ -116,7 +184,7 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	po := currency.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	po := fl.CurrencyPolicyFlags.policy(feeer)
 	if err := po.IsValid(nil); err != nil {
 		return err
 	}
@@ -206,6 +274,9 @@ func (cmd *CurrencyRegisterCommand) parseFlags() error {
 
 func (cmd *CurrencyRegisterCommand) createOperation() (currency.CurrencyRegister, error) {
 	fact := currency.NewCurrencyRegisterFact([]byte(cmd.Token), cmd.currencyDesign)
+	if !cmd.Expire.IsEmpty() {
+		fact = fact.WithExpiry(cmd.Expire.Expiry)
+	}
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(
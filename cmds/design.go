@@ -116,9 +116,15 @@ type CurrencyDesign struct {
 	CurrencyString             *string         `yaml:"currency"`
 	BalanceString              *string         `yaml:"balance"`
 	NewAccountMinBalanceString *string         `yaml:"new-account-min-balance"`
+	MinBalanceString           *string         `yaml:"min-balance"`
+	MinTransferAmountString    *string         `yaml:"min-transfer-amount"`
+	MaxTransferAmountString    *string         `yaml:"max-transfer-amount"`
 	Feeer                      *FeeerDesign    `yaml:"feeer"`
 	Balance                    currency.Amount `yaml:"-"`
 	NewAccountMinBalance       currency.Big    `yaml:"-"`
+	MinBalance                 currency.Big    `yaml:"-"`
+	MinTransferAmount          currency.Big    `yaml:"-"`
+	MaxTransferAmount          currency.Big    `yaml:"-"`
 }
 
 func (de *CurrencyDesign) IsValid([]byte) error {
@@ -153,6 +159,36 @@ func (de *CurrencyDesign) IsValid([]byte) error {
 		}
 	}
 
+	if de.MinBalanceString == nil {
+		de.MinBalance = currency.ZeroBig
+	} else {
+		if b, err := currency.NewBigFromString(*de.MinBalanceString); err != nil {
+			return err
+		} else {
+			de.MinBalance = b
+		}
+	}
+
+	if de.MinTransferAmountString == nil {
+		de.MinTransferAmount = currency.ZeroBig
+	} else {
+		if b, err := currency.NewBigFromString(*de.MinTransferAmountString); err != nil {
+			return err
+		} else {
+			de.MinTransferAmount = b
+		}
+	}
+
+	if de.MaxTransferAmountString == nil {
+		de.MaxTransferAmount = currency.ZeroBig
+	} else {
+		if b, err := currency.NewBigFromString(*de.MaxTransferAmountString); err != nil {
+			return err
+		} else {
+			de.MaxTransferAmount = b
+		}
+	}
+
 	if de.Feeer == nil {
 		de.Feeer = &FeeerDesign{}
 	} else if err := de.Feeer.IsValid(nil); err != nil {
@@ -179,6 +215,10 @@ func (no *FeeerDesign) IsValid([]byte) error {
 		if err := no.checkRatio(no.Extras); err != nil {
 			return err
 		}
+	case currency.FeeerDynamic:
+		if err := no.checkDynamic(no.Extras); err != nil {
+			return err
+		}
 	default:
 		return xerrors.Errorf("unknown type of feeer, %v", t)
 	}
@@ -228,6 +268,44 @@ func (no FeeerDesign) checkRatio(c map[string]interface{}) error {
 	return nil
 }
 
+func (no FeeerDesign) checkDynamic(c map[string]interface{}) error {
+	if a, found := c["min"]; !found {
+		return xerrors.Errorf("dynamic needs `min`")
+	} else if n, err := currency.NewBigFromInterface(a); err != nil {
+		return xerrors.Errorf("invalid min value, %v of dynamic: %w", a, err)
+	} else {
+		no.Extras["dynamic_min"] = n
+	}
+
+	if a, found := c["max"]; found {
+		if n, err := currency.NewBigFromInterface(a); err != nil {
+			return xerrors.Errorf("invalid max value, %v of dynamic: %w", a, err)
+		} else {
+			no.Extras["dynamic_max"] = n
+		}
+	}
+
+	for _, k := range []string{"target", "denominator"} {
+		if a, found := c[k]; !found {
+			return xerrors.Errorf("dynamic needs `%s`", k)
+		} else if n, ok := a.(int); !ok || n < 1 {
+			return xerrors.Errorf("invalid %s value, %v of dynamic; should be positive integer", k, a)
+		} else {
+			no.Extras["dynamic_"+k] = uint(n)
+		}
+	}
+
+	return nil
+}
+
+// BlockDesign is the ordering of operations in proposal; it is only used to
+// make proposal, so it can be different by node. The capacity of block is
+// decided by the network policy.
+type BlockDesign struct {
+	FeePriority       bool `yaml:"fee-priority" json:"fee-priority"`
+	FeePriorityWindow uint `yaml:"fee-priority-window" json:"fee-priority-window"`
+}
+
 type DigestDesign struct {
 	NetworkYAML     *yamlconfig.LocalNetwork `yaml:"network,omitempty"`
 	CacheYAML       *string                  `yaml:"cache,omitempty"`
@@ -2,10 +2,12 @@ package cmds
 
 import (
 	"bytes"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/xerrors"
 
@@ -13,7 +15,9 @@ import (
 	"github.com/spikeekips/mitum/base/key"
 	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
 	"github.com/spikeekips/mitum/util/encoder"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/localtime"
 
 	"github.com/spikeekips/mitum-currency/currency"
 )
@@ -112,12 +116,26 @@ func (v *PrivatekeyFlag) UnmarshalText(b []byte) error {
 	return nil
 }
 
+// AddressFlag is the address or the alias of address, "@<alias>".
 type AddressFlag struct {
-	s  string
-	ad base.AddressDecoder
+	s     string
+	ad    base.AddressDecoder
+	alias currency.AliasName
 }
 
 func (v *AddressFlag) UnmarshalText(b []byte) error {
+	if s := string(b); strings.HasPrefix(s, "@") {
+		name := currency.AliasName(s[1:])
+		if err := name.IsValid(nil); err != nil {
+			return err
+		}
+
+		v.s = s
+		v.alias = name
+
+		return nil
+	}
+
 	if ht, s, err := hint.ParseHintedString(string(b)); err != nil {
 		return err
 	} else {
@@ -132,10 +150,29 @@ func (v *AddressFlag) String() string {
 	return v.s
 }
 
+func (v *AddressFlag) IsAlias() bool {
+	return len(v.alias) > 0
+}
+
+// Encode returns the address. The alias can not be encoded; use Resolve.
 func (v *AddressFlag) Encode(enc encoder.Encoder) (base.Address, error) {
+	if v.IsAlias() {
+		return nil, xerrors.Errorf("alias, %q should be resolved through digest api", v.s)
+	}
+
 	return v.ad.Encode(enc)
 }
 
+// Resolve returns the address. The alias is resolved through the digest API,
+// u.
+func (v *AddressFlag) Resolve(enc *jsonenc.Encoder, u *url.URL, insecure bool) (base.Address, error) {
+	if !v.IsAlias() {
+		return v.Encode(enc)
+	}
+
+	return resolveAlias(enc, u, insecure, v.alias)
+}
+
 type BigFlag struct {
 	currency.Big
 }
@@ -200,3 +237,38 @@ func (v *CurrencyIDFlag) UnmarshalText(b []byte) error {
 func (v *CurrencyIDFlag) String() string {
 	return v.CID.String()
 }
+
+// ExpiryFlag parses the expiry of operation, "<height>", "<RFC3339 time>" or
+// "<height>,<RFC3339 time>".
+type ExpiryFlag struct {
+	currency.Expiry
+}
+
+func (v *ExpiryFlag) UnmarshalText(b []byte) error {
+	var height base.Height
+	var t time.Time
+
+	for _, s := range strings.SplitN(string(b), ",", 2) {
+		s = strings.TrimSpace(s)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			height = base.Height(i)
+
+			continue
+		}
+
+		if i, err := localtime.ParseRFC3339(s); err != nil {
+			return xerrors.Errorf("invalid expiry, %q; should be height or RFC3339 time", s)
+		} else {
+			t = i
+		}
+	}
+
+	ex := currency.NewExpiry(height, t)
+	if err := ex.IsValid(nil); err != nil {
+		return err
+	}
+
+	v.Expiry = ex
+
+	return nil
+}
@@ -12,18 +12,55 @@ var Hinters []hint.Hinter
 
 func init() {
 	currencyHinters := []hint.Hinter{
+		currency.AccountClosed{},
+		currency.AccountDataUpdaterFact{},
+		currency.AccountDataUpdater{},
+		currency.AccountData{},
+		currency.AccountMergeFact{},
+		currency.AccountMerge{},
+		currency.AccountPolicyUpdaterFact{},
+		currency.AccountPolicyUpdater{},
+		currency.AccountPolicy{},
+		currency.AccountSpent{},
+		currency.PendingAccountPolicy{},
 		currency.Account{},
 		currency.Address(""),
+		currency.AddressChecksumHinter,
+		currency.AliasRegisterFact{},
+		currency.AliasRegister{},
+		currency.AliasReleaseFact{},
+		currency.AliasRelease{},
+		currency.AliasTransferFact{},
+		currency.AliasTransfer{},
+		currency.Alias{},
+		currency.Allowance{},
 		currency.AmountState{},
 		currency.Amount{},
+		currency.ApproveFact{},
+		currency.Approve{},
+		currency.BatchFact{},
+		currency.Batch{},
+		currency.ClaimBalanceFact{},
+		currency.ClaimBalance{},
+		currency.ClaimableBalance{},
+		currency.CreateClaimableBalanceFact{},
+		currency.CreateClaimableBalance{},
 		currency.CreateAccountsFact{},
 		currency.CreateAccountsItemMultiAmountsHinter,
 		currency.CreateAccountsItemSingleAmountHinter,
+		currency.CreateAccountsItemSaltedHinter,
 		currency.CreateAccounts{},
 		currency.CurrencyDesign{},
 		currency.CurrencyPolicyUpdaterFact{},
+		currency.CurrencyPolicyUpdaterFactScheduledHinter,
 		currency.CurrencyPolicyUpdater{},
 		currency.CurrencyPolicy{},
+		currency.CurrencyPolicyMinBalanceHinter,
+		currency.CurrencyPolicyTransferLimitsHinter,
+		currency.PendingCurrencyPolicy{},
+		currency.NetworkPolicyUpdaterFact{},
+		currency.NetworkPolicyUpdater{},
+		currency.NetworkPolicy{},
 		currency.CurrencyRegisterFact{},
 		currency.CurrencyRegister{},
 		currency.FeeOperationFact{},
@@ -31,17 +68,62 @@ func init() {
 		currency.FixedFeeer{},
 		currency.GenesisCurrenciesFact{},
 		currency.GenesisCurrencies{},
+		currency.GuardiansUpdaterFact{},
+		currency.GuardiansUpdater{},
+		currency.Guardians{},
+		currency.KeyRecoveryCancelerFact{},
+		currency.KeyRecoveryCanceler{},
+		currency.KeyRecoveryFact{},
+		currency.KeyRecovery{},
 		currency.KeyUpdaterFact{},
+		currency.KeyUpdaterFactStrictHinter,
+		currency.TransfersFactExpiringHinter,
+		currency.CreateAccountsFactExpiringHinter,
+		currency.KeyUpdaterFactExpiringHinter,
+		currency.AccountDataUpdaterFactExpiringHinter,
+		currency.AccountMergeFactExpiringHinter,
+		currency.AccountPolicyUpdaterFactExpiringHinter,
+		currency.AliasRegisterFactExpiringHinter,
+		currency.AliasReleaseFactExpiringHinter,
+		currency.AliasTransferFactExpiringHinter,
+		currency.ApproveFactExpiringHinter,
+		currency.BatchFactExpiringHinter,
+		currency.ClaimBalanceFactExpiringHinter,
+		currency.CreateClaimableBalanceFactExpiringHinter,
+		currency.CurrencyPolicyUpdaterFactExpiringHinter,
+		currency.CurrencyRegisterFactExpiringHinter,
+		currency.GuardiansUpdaterFactExpiringHinter,
+		currency.KeyRecoveryFactExpiringHinter,
+		currency.KeyRecoveryCancelerFactExpiringHinter,
+		currency.MultiTransfersFactExpiringHinter,
+		currency.NetworkPolicyUpdaterFactExpiringHinter,
+		currency.ReclaimBalanceFactExpiringHinter,
+		currency.TransferFromFactExpiringHinter,
+		currency.TransfersFactSequencedHinter,
+		currency.CreateAccountsFactSequencedHinter,
+		currency.KeyUpdaterFactSequencedHinter,
 		currency.KeyUpdater{},
 		currency.Keys{},
+		currency.KeysWithThresholdsHinter,
 		currency.Key{},
+		currency.MultiTransfersFact{},
+		currency.MultiTransfersSender{},
+		currency.MultiTransfers{},
 		currency.NilFeeer{},
 		currency.RatioFeeer{},
+		currency.DynamicFeeer{},
+		currency.ReclaimBalanceFact{},
+		currency.ReclaimBalance{},
+		currency.Recovery{},
+		currency.TransferFromFact{},
+		currency.TransferFrom{},
 		currency.TransfersFact{},
 		currency.TransfersItemMultiAmountsHinter,
 		currency.TransfersItemSingleAmountHinter,
 		currency.Transfers{},
 		digest.AccountValue{},
+		digest.AliasValue{},
+		digest.AllowanceValue{},
 		digest.BaseHal{},
 		digest.NodeInfo{},
 		digest.OperationValue{},
@@ -140,6 +140,14 @@ func loadCurrencyDesign(de CurrencyDesign, ga base.Address) (currency.CurrencyDe
 		po = currency.NewCurrencyPolicy(de.NewAccountMinBalance, j)
 	}
 
+	if de.MinBalance.OverZero() {
+		po = po.WithMinBalance(de.MinBalance)
+	}
+
+	if de.MinTransferAmount.OverZero() || de.MaxTransferAmount.OverZero() {
+		po = po.WithTransferLimits(de.MinTransferAmount, de.MaxTransferAmount)
+	}
+
 	cd := currency.NewCurrencyDesign(de.Balance, nil, po)
 	if err := cd.IsValid(nil); err != nil {
 		return currency.CurrencyDesign{}, err
@@ -169,6 +177,21 @@ func loadGenesisCurrenciesFeeer(de FeeerDesign, ga base.Address) (currency.Feeer
 			de.Extras["ratio_min"].(currency.Big),
 			max,
 		)
+	case currency.FeeerDynamic:
+		var max currency.Big
+		if i, found := de.Extras["dynamic_max"]; !found {
+			max = currency.UnlimitedMaxFeeAmount
+		} else {
+			max = i.(currency.Big)
+		}
+
+		feeer = currency.NewDynamicFeeer(
+			ga,
+			de.Extras["dynamic_min"].(currency.Big),
+			max,
+			de.Extras["dynamic_target"].(uint),
+			de.Extras["dynamic_denominator"].(uint),
+		)
 	default:
 		return nil, xerrors.Errorf("unknown type of feeer, %q", de.Type)
 	}
@@ -17,6 +17,7 @@ type KeyAddressCommand struct {
 	*BaseCommand
 	Threshold uint      `arg:"" name:"threshold" help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
 	Keys      []KeyFlag `arg:"" name:"key" help:"key for address (ex: \"<public key>,<weight>\")" sep:"@" optional:""`
+	Checksum  bool      `name:"checksum" help:"print checksummed address"`
 }
 
 func NewKeyAddressCommand() KeyAddressCommand {
@@ -44,6 +45,8 @@ func (cmd *KeyAddressCommand) Run(version util.Version) error {
 
 	if a, err := currency.NewAddressFromKeys(keys); err != nil {
 		return err
+	} else if cmd.Checksum {
+		cmd.print(a.ChecksumString())
 	} else {
 		cmd.print(a.String())
 	}
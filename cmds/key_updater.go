@@ -17,6 +17,7 @@ type KeyUpdaterCommand struct {
 	Currency  CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
 	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
 	Keys      []KeyFlag      `name:"key" help:"key for account (ex: \"<public key>,<weight>\")" sep:"@"`
+	Strict    bool           `name:"strict" help:"new keys should also sign operation"`
 	target    base.Address
 	keys      currency.Keys
 }
@@ -65,7 +66,7 @@ func (cmd *KeyUpdaterCommand) parseFlags() error {
 		return xerrors.Errorf("--key must be given at least one")
 	}
 
-	if a, err := cmd.Target.Encode(jenc); err != nil {
+	if a, err := cmd.Target.Resolve(jenc, cmd.Digest, cmd.TLSInsecure); err != nil {
 		return xerrors.Errorf("invalid target format, %q: %w", cmd.Target.String(), err)
 	} else {
 		cmd.target = a
@@ -90,12 +91,24 @@ func (cmd *KeyUpdaterCommand) parseFlags() error {
 }
 
 func (cmd *KeyUpdaterCommand) createOperation() (operation.Operation, error) {
-	fact := currency.NewKeyUpdaterFact(
+	newFact := currency.NewKeyUpdaterFact
+	if cmd.Strict {
+		newFact = currency.NewKeyUpdaterFactStrict
+	}
+
+	fact := newFact(
 		[]byte(cmd.Token),
 		cmd.target,
 		cmd.keys,
 		cmd.Currency.CID,
 	)
+	if !cmd.Expire.IsEmpty() {
+		fact = fact.WithExpiry(cmd.Expire.Expiry)
+	}
+
+	if cmd.Sequence > 0 {
+		fact = fact.WithSequence(cmd.Sequence)
+	}
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID()); err != nil {
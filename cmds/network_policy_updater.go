@@ -0,0 +1,124 @@
+package cmds
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+)
+
+type NetworkPolicyFlags struct {
+	MaxTransferItems   uint `name:"max-transfer-items" help:"max number of transfer items" default:"10"`
+	MaxMemoSize        uint `name:"max-memo-size" help:"max size of memo" default:"100"`
+	MaxKeyInKeys       uint `name:"max-key-in-keys" help:"max number of keys in keys" default:"10"`
+	MinKeyWeight       uint `name:"min-key-weight" help:"min weight of key" default:"1"`
+	MaxKeyWeight       uint `name:"max-key-weight" help:"max weight of key" default:"100"`
+	MinThreshold       uint `name:"min-threshold" help:"min threshold of keys" default:"1"`
+	MaxThreshold       uint `name:"max-threshold" help:"max threshold of keys" default:"100"`
+	MaxBlockOperations uint `name:"max-block-operations" help:"max number of operations in block, 0 is no limit" default:"0"`
+	MaxBlockItems      uint `name:"max-block-items" help:"max number of items in block, 0 is no limit" default:"0"`
+	MaxDataEntries     uint `name:"max-data-entries" help:"max number of account data entries" default:"10"`
+	MaxDataKeySize     uint `name:"max-data-key-size" help:"max size of account data key" default:"32"`
+	MaxDataValueSize   uint `name:"max-data-value-size" help:"max size of account data value" default:"256"`
+	po                 currency.NetworkPolicy
+}
+
+func (fl *NetworkPolicyFlags) IsValid([]byte) error {
+	po := currency.NewNetworkPolicy(
+		fl.MaxTransferItems,
+		fl.MaxMemoSize,
+		fl.MaxKeyInKeys,
+		fl.MinKeyWeight,
+		fl.MaxKeyWeight,
+		fl.MinThreshold,
+		fl.MaxThreshold,
+	).SetBlockLimits(currency.BlockLimits{MaxOperations: fl.MaxBlockOperations, MaxItems: fl.MaxBlockItems}).
+		SetAccountDataPolicy(currency.NewAccountDataPolicy(fl.MaxDataEntries, fl.MaxDataKeySize, fl.MaxDataValueSize))
+	if err := po.IsValid(nil); err != nil {
+		return err
+	} else {
+		fl.po = po
+	}
+
+	return nil
+}
+
+type NetworkPolicyUpdaterCommand struct {
+	*BaseCommand
+	OperationFlags
+	NetworkPolicyFlags `prefix:"policy-" help:"network policy"`
+}
+
+func NewNetworkPolicyUpdaterCommand() NetworkPolicyUpdaterCommand {
+	return NetworkPolicyUpdaterCommand{
+		BaseCommand: NewBaseCommand("network-policy-updater-operation"),
+	}
+}
+
+func (cmd *NetworkPolicyUpdaterCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return xerrors.Errorf("failed to initialize command: %w", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return xerrors.Errorf("failed to create network-policy-updater operation: %w", err)
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return xerrors.Errorf("invalid network-policy-updater operation: %w", err)
+	} else {
+		cmd.Log().Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	if i, err := operation.NewBaseSeal(
+		cmd.OperationFlags.Privatekey,
+		[]operation.Operation{op},
+		[]byte(cmd.OperationFlags.NetworkID),
+	); err != nil {
+		return xerrors.Errorf("failed to create operation.Seal: %w", err)
+	} else {
+		cmd.Log().Debug().Interface("seal", i).Msg("seal loaded")
+
+		cmd.pretty(cmd.Pretty, i)
+	}
+
+	return nil
+}
+
+func (cmd *NetworkPolicyUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.NetworkPolicyFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	cmd.Log().Debug().Interface("network-policy", cmd.po).Msg("network policy loaded")
+
+	return nil
+}
+
+func (cmd *NetworkPolicyUpdaterCommand) createOperation() (currency.NetworkPolicyUpdater, error) {
+	fact := currency.NewNetworkPolicyUpdaterFact([]byte(cmd.Token), cmd.po)
+	if !cmd.Expire.IsEmpty() {
+		fact = fact.WithExpiry(cmd.Expire.Expiry)
+	}
+
+	var fs []operation.FactSign
+	if sig, err := operation.NewFactSignature(
+		cmd.OperationFlags.Privatekey,
+		fact,
+		[]byte(cmd.OperationFlags.NetworkID),
+	); err != nil {
+		return currency.NetworkPolicyUpdater{}, err
+	} else {
+		fs = append(fs, operation.NewBaseFactSign(cmd.OperationFlags.Privatekey.Publickey(), sig))
+	}
+
+	return currency.NewNetworkPolicyUpdater(fact, fs, cmd.OperationFlags.Memo)
+}
@@ -0,0 +1,167 @@
+package cmds
+
+import (
+	"context"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/isaac"
+	"github.com/spikeekips/mitum/launch/config"
+	"github.com/spikeekips/mitum/launch/pm"
+	"github.com/spikeekips/mitum/launch/process"
+	"github.com/spikeekips/mitum/network"
+	"github.com/spikeekips/mitum/states"
+	basicstate "github.com/spikeekips/mitum/states/basic"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/storage/blockdata"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/logging"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var ProcessorConsensusStates pm.Process
+
+func init() {
+	if i, err := pm.NewProcess(
+		process.ProcessNameConsensusStates,
+		[]string{
+			process.ProcessNameLocalNode,
+			process.ProcessNameDatabase,
+			process.ProcessNameBlockData,
+			process.ProcessNameSuffrage,
+			process.ProcessNameProposalProcessor,
+		},
+		ProcessConsensusStates,
+	); err != nil {
+		panic(err)
+	} else {
+		ProcessorConsensusStates = i
+	}
+}
+
+// ProcessConsensusStates replaces the consensus states process of mitum; the
+// proposal maker of suffrage node selects the staged operations by
+// currency.ProposalDatabase, so the BlockLimits and the fee priority are
+// applied only when the proposal is made.
+func ProcessConsensusStates(ctx context.Context) (context.Context, error) {
+	var nodepool *network.Nodepool
+	if err := process.LoadNodepoolContextValue(ctx, &nodepool); err != nil {
+		return ctx, err
+	}
+
+	var suffrage base.Suffrage
+	if err := process.LoadSuffrageContextValue(ctx, &suffrage); err != nil {
+		return ctx, err
+	}
+
+	if !suffrage.IsInside(nodepool.Local().Address()) {
+		return process.ProcessConsensusStates(ctx)
+	}
+
+	var policy *isaac.LocalPolicy
+	if err := process.LoadPolicyContextValue(ctx, &policy); err != nil {
+		return ctx, err
+	}
+
+	var st storage.Database
+	if err := process.LoadDatabaseContextValue(ctx, &st); err != nil {
+		return ctx, err
+	}
+
+	var blockData blockdata.BlockData
+	if err := process.LoadBlockDataContextValue(ctx, &blockData); err != nil {
+		return ctx, err
+	}
+
+	var pps *prprocessor.Processors
+	if err := process.LoadProposalProcessorContextValue(ctx, &pps); err != nil {
+		return ctx, err
+	}
+
+	var log logging.Logger
+	if err := config.LoadLogContextValue(ctx, &log); err != nil {
+		return ctx, err
+	}
+
+	var pst storage.Database
+	if i, err := newProposalDatabase(ctx, st); err != nil {
+		return ctx, err
+	} else {
+		pst = i
+	}
+
+	log.Debug().Msg("local is in suffrage")
+
+	proposalMaker := isaac.NewProposalMaker(nodepool.Local(), pst, policy)
+
+	ballotbox := isaac.NewBallotbox(
+		suffrage.Nodes,
+		func() base.Threshold {
+			if t, err := base.NewThreshold(
+				uint(len(suffrage.Nodes())),
+				policy.ThresholdRatio(),
+			); err != nil {
+				panic(err)
+			} else {
+				return t
+			}
+		},
+	)
+	_ = ballotbox.SetLogger(log)
+
+	stopped := basicstate.NewStoppedState()
+	booting := basicstate.NewBootingState(nodepool.Local(), st, blockData, policy, suffrage)
+	joining := basicstate.NewJoiningState(nodepool.Local(), st, policy, suffrage, ballotbox)
+	consensus := basicstate.NewConsensusState(st, policy, nodepool, suffrage, proposalMaker, pps)
+	syncing := basicstate.NewSyncingState(st, blockData, policy, nodepool)
+
+	var cs states.States
+	if i, err := basicstate.NewStates(
+		st,
+		policy,
+		nodepool,
+		suffrage,
+		ballotbox,
+		stopped,
+		booting,
+		joining,
+		consensus,
+		syncing,
+	); err != nil {
+		return ctx, err
+	} else {
+		cs = i
+	}
+
+	if i, ok := cs.(logging.SetLogger); ok {
+		_ = i.SetLogger(log)
+	}
+
+	return context.WithValue(ctx, process.ContextValueConsensusStates, cs), nil
+}
+
+// newProposalDatabase returns the database for the proposal maker; the fee
+// priority is set by BlockDesign.
+func newProposalDatabase(ctx context.Context, st storage.Database) (storage.Database, error) {
+	var cp *currency.CurrencyPool
+	if err := LoadCurrencyPoolContextValue(ctx, &cp); err != nil {
+		return nil, err
+	}
+
+	pst := currency.NewProposalDatabase(st, cp)
+
+	var bd BlockDesign
+	switch err := LoadBlockDesignContextValue(ctx, &bd); {
+	case err == nil:
+		if bd.FeePriority {
+			pst = pst.SetFeePriority(bd.FeePriorityWindow)
+		}
+	case !xerrors.Is(err, util.ContextValueNotFoundError):
+		return nil, err
+	}
+
+	return pst, nil
+}
@@ -45,6 +45,7 @@ var RunCommandHooks = func(cmd *RunCommand) []pm.Hook {
 
 func init() {
 	RunCommandProcesses = []pm.Process{
+		ProcessorConsensusStates,
 		ProcessorDigestDatabase,
 		ProcessorDigester,
 		ProcessorDigestAPI,
@@ -141,7 +142,7 @@ func (cmd *RunCommand) whenBlockSaved(
 			}()
 		}
 
-		if err := digest.LoadCurrenciesFromDatabase(st, blocks[0].Height(), func(sta state.State) (bool, error) {
+		if err := digest.LoadCurrencyRecordsFromDatabase(st, blocks[0].Height(), func(sta state.State) (bool, error) {
 			if err := cp.Set(sta); err != nil {
 				return false, err
 			} else {
@@ -153,6 +154,8 @@ func (cmd *RunCommand) whenBlockSaved(
 			cmd.Log().Error().Err(err).Msg("failed to load currency designs from database")
 		}
 
+		cp.SetHeight(blocks[len(blocks)-1].Height())
+
 		return ctx, nil
 	}
 }
@@ -7,6 +7,7 @@ type SealCommand struct {
 	KeyUpdater            KeyUpdaterCommand            `cmd:"" name:"key-updater" help:"update keys"`
 	CurrencyRegister      CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
 	CurrencyPolicyUpdater CurrencyPolicyUpdaterCommand `cmd:"" name:"currency-policy-updater" help:"update currency policy"` // nolint:lll
+	NetworkPolicyUpdater  NetworkPolicyUpdaterCommand  `cmd:"" name:"network-policy-updater" help:"update network policy"`   // nolint:lll
 	Sign                  SignSealCommand              `cmd:"" name:"sign" help:"sign seal"`
 	SignFact              SignFactCommand              `cmd:"" name:"sign-fact" help:"sign facts of operation seal"`
 }
@@ -19,6 +20,7 @@ func NewSealCommand() SealCommand {
 		KeyUpdater:            NewKeyUpdaterCommand(),
 		CurrencyRegister:      NewCurrencyRegisterCommand(),
 		CurrencyPolicyUpdater: NewCurrencyPolicyUpdaterCommand(),
+		NetworkPolicyUpdater:  NewNetworkPolicyUpdaterCommand(),
 		Sign:                  NewSignSealCommand(),
 		SignFact:              NewSignFactCommand(),
 	}
@@ -66,9 +66,9 @@ func (cmd *TransferCommand) parseFlags() error {
 		return err
 	}
 
-	if sender, err := cmd.Sender.Encode(jenc); err != nil {
+	if sender, err := cmd.Sender.Resolve(jenc, cmd.Digest, cmd.TLSInsecure); err != nil {
 		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
-	} else if receiver, err := cmd.Receiver.Encode(jenc); err != nil {
+	} else if receiver, err := cmd.Receiver.Resolve(jenc, cmd.Digest, cmd.TLSInsecure); err != nil {
 		return xerrors.Errorf("invalid sender format, %q: %w", cmd.Sender.String(), err)
 	} else {
 		cmd.sender = sender
@@ -103,6 +103,13 @@ func (cmd *TransferCommand) createOperation() (operation.Operation, error) { //
 	}
 
 	fact := currency.NewTransfersFact([]byte(cmd.Token), cmd.sender, items)
+	if !cmd.Expire.IsEmpty() {
+		fact = fact.WithExpiry(cmd.Expire.Expiry)
+	}
+
+	if cmd.Sequence > 0 {
+		fact = fact.WithSequence(cmd.Sequence)
+	}
 
 	var fs []operation.FactSign
 	if sig, err := operation.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID()); err != nil {
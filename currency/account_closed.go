@@ -0,0 +1,48 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountClosedType = hint.MustNewType(0xa0, 0x51, "mitum-currency-account-closed")
+	AccountClosedHint = hint.MustHint(AccountClosedType, "0.0.1")
+)
+
+// AccountClosed is the state value of the account closed by AccountMerge. It
+// keeps the account, which the balances were merged to.
+type AccountClosed struct {
+	receiver base.Address
+}
+
+func NewAccountClosed(receiver base.Address) AccountClosed {
+	return AccountClosed{receiver: receiver}
+}
+
+func (ac AccountClosed) Hint() hint.Hint {
+	return AccountClosedHint
+}
+
+func (ac AccountClosed) Bytes() []byte {
+	return ac.receiver.Bytes()
+}
+
+func (ac AccountClosed) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(ac.Bytes())
+}
+
+func (ac AccountClosed) IsValid([]byte) error {
+	if ac.receiver == nil {
+		return xerrors.Errorf("empty receiver of AccountClosed")
+	}
+
+	return ac.receiver.IsValid(nil)
+}
+
+func (ac AccountClosed) Receiver() base.Address {
+	return ac.receiver
+}
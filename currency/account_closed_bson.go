@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (ac AccountClosed) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(ac.Hint()),
+			bson.M{
+				"receiver": ac.receiver,
+			}))
+}
+
+type AccountClosedBSONUnpacker struct {
+	RC base.AddressDecoder `bson:"receiver"`
+}
+
+func (ac *AccountClosed) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uac AccountClosedBSONUnpacker
+	if err := enc.Unmarshal(b, &uac); err != nil {
+		return err
+	}
+
+	return ac.unpack(enc, uac.RC)
+}
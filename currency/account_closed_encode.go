@@ -0,0 +1,16 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (ac *AccountClosed) unpack(enc encoder.Encoder, bReceiver base.AddressDecoder) error {
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		ac.receiver = a
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AccountClosedJSONPacker struct {
+	jsonenc.HintedHead
+	RC base.Address `json:"receiver"`
+}
+
+func (ac AccountClosed) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountClosedJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(ac.Hint()),
+		RC:         ac.receiver,
+	})
+}
+
+type AccountClosedJSONUnpacker struct {
+	RC base.AddressDecoder `json:"receiver"`
+}
+
+func (ac *AccountClosed) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uac AccountClosedJSONUnpacker
+	if err := enc.Unmarshal(b, &uac); err != nil {
+		return err
+	}
+
+	return ac.unpack(enc, uac.RC)
+}
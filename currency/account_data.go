@@ -0,0 +1,215 @@
+package currency
+
+import (
+	"regexp"
+	"sort"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountDataType = hint.MustNewType(0xa0, 0x67, "mitum-currency-account-data")
+	AccountDataHint = hint.MustHint(AccountDataType, "0.0.1")
+)
+
+var ReValidAccountDataKey = regexp.MustCompile(`^[a-zA-Z0-9][\w\-\.]*$`)
+
+// DefaultAccountDataPolicy is the size limits of AccountData before
+// NetworkPolicyUpdater sets the account data policy of NetworkPolicy.
+var DefaultAccountDataPolicy = NewAccountDataPolicy(10, 32, 256)
+
+// AccountDataEntry is the key-value entry of AccountData. In
+// AccountDataUpdaterFact, the entry with empty value removes the key.
+type AccountDataEntry struct {
+	key   string
+	value string
+}
+
+func NewAccountDataEntry(key, value string) AccountDataEntry {
+	return AccountDataEntry{key: key, value: value}
+}
+
+func (en AccountDataEntry) Bytes() []byte {
+	return util.ConcatBytesSlice([]byte(en.key), []byte(en.value))
+}
+
+func (en AccountDataEntry) IsValid([]byte) error {
+	if !ReValidAccountDataKey.Match([]byte(en.key)) {
+		return xerrors.Errorf("invalid account data key, %q", en.key)
+	}
+
+	return nil
+}
+
+func (en AccountDataEntry) Key() string {
+	return en.key
+}
+
+func (en AccountDataEntry) Value() string {
+	return en.value
+}
+
+func (en AccountDataEntry) IsDelete() bool {
+	return len(en.value) < 1
+}
+
+// AccountData is the key-value metadata of account, like display name or
+// website. The entries are sorted by key.
+type AccountData struct {
+	entries []AccountDataEntry
+}
+
+func NewAccountData(entries []AccountDataEntry) AccountData {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	return AccountData{entries: entries}
+}
+
+func (ad AccountData) Hint() hint.Hint {
+	return AccountDataHint
+}
+
+func (ad AccountData) Bytes() []byte {
+	bs := make([][]byte, len(ad.entries))
+	for i := range ad.entries {
+		bs[i] = ad.entries[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (ad AccountData) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(ad.Bytes())
+}
+
+func (ad AccountData) IsValid([]byte) error {
+	founds := map[string]struct{}{}
+	for i := range ad.entries {
+		en := ad.entries[i]
+		if err := en.IsValid(nil); err != nil {
+			return err
+		} else if en.IsDelete() {
+			return xerrors.Errorf("empty value of account data, %q", en.key)
+		}
+
+		if _, found := founds[en.key]; found {
+			return xerrors.Errorf("duplicated account data key found, %q", en.key)
+		}
+
+		founds[en.key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ad AccountData) Entries() []AccountDataEntry {
+	return ad.entries
+}
+
+func (ad AccountData) Value(key string) (string, bool) {
+	for i := range ad.entries {
+		if ad.entries[i].key == key {
+			return ad.entries[i].value, true
+		}
+	}
+
+	return "", false
+}
+
+func (ad AccountData) IsEmpty() bool {
+	return len(ad.entries) < 1
+}
+
+// Update sets the given entries; the entry with empty value removes the key.
+func (ad AccountData) Update(entries []AccountDataEntry) (AccountData, error) {
+	m := map[string]string{}
+	for i := range ad.entries {
+		m[ad.entries[i].key] = ad.entries[i].value
+	}
+
+	for i := range entries {
+		en := entries[i]
+		if !en.IsDelete() {
+			m[en.key] = en.value
+
+			continue
+		}
+
+		if _, found := m[en.key]; !found {
+			return AccountData{}, xerrors.Errorf("account data key, %q not found", en.key)
+		}
+
+		delete(m, en.key)
+	}
+
+	nentries := make([]AccountDataEntry, len(m))
+	var i int
+	for k := range m {
+		nentries[i] = NewAccountDataEntry(k, m[k])
+		i++
+	}
+
+	return NewAccountData(nentries), nil
+}
+
+// AccountDataPolicy limits the number of entries and the sizes of key and
+// value of AccountData.
+type AccountDataPolicy struct {
+	maxEntries   uint
+	maxKeySize   uint
+	maxValueSize uint
+}
+
+func NewAccountDataPolicy(maxEntries, maxKeySize, maxValueSize uint) AccountDataPolicy {
+	return AccountDataPolicy{maxEntries: maxEntries, maxKeySize: maxKeySize, maxValueSize: maxValueSize}
+}
+
+func (po AccountDataPolicy) IsEmpty() bool {
+	return po.maxEntries < 1 && po.maxKeySize < 1 && po.maxValueSize < 1
+}
+
+func (po AccountDataPolicy) IsValid([]byte) error {
+	if po.maxEntries < 1 || po.maxKeySize < 1 || po.maxValueSize < 1 {
+		return xerrors.Errorf("invalid account data policy, %d, %d, %d", po.maxEntries, po.maxKeySize, po.maxValueSize)
+	}
+
+	return nil
+}
+
+func (po AccountDataPolicy) MaxEntries() uint {
+	return po.maxEntries
+}
+
+func (po AccountDataPolicy) MaxKeySize() uint {
+	return po.maxKeySize
+}
+
+func (po AccountDataPolicy) MaxValueSize() uint {
+	return po.maxValueSize
+}
+
+// Check checks AccountData is in the limits.
+func (po AccountDataPolicy) Check(ad AccountData) error {
+	if n := uint(len(ad.entries)); n > po.maxEntries {
+		return xerrors.Errorf("account data entries over allowed; %d > %d", n, po.maxEntries)
+	}
+
+	for i := range ad.entries {
+		en := ad.entries[i]
+		if n := uint(len(en.key)); n > po.maxKeySize {
+			return xerrors.Errorf("account data key, %q too long; %d > %d", en.key, n, po.maxKeySize)
+		}
+
+		if n := uint(len(en.value)); n > po.maxValueSize {
+			return xerrors.Errorf("value of account data key, %q too long; %d > %d", en.key, n, po.maxValueSize)
+		}
+	}
+
+	return nil
+}
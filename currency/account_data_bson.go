@@ -0,0 +1,53 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+type AccountDataEntryBSONPacker struct {
+	K string `bson:"key"`
+	V string `bson:"value"`
+}
+
+func packAccountDataEntriesBSON(entries []AccountDataEntry) []AccountDataEntryBSONPacker {
+	ens := make([]AccountDataEntryBSONPacker, len(entries))
+	for i := range entries {
+		ens[i] = AccountDataEntryBSONPacker{K: entries[i].key, V: entries[i].value}
+	}
+
+	return ens
+}
+
+func unpackAccountDataEntriesBSON(ens []AccountDataEntryBSONPacker) []AccountDataEntry {
+	entries := make([]AccountDataEntry, len(ens))
+	for i := range ens {
+		entries[i] = NewAccountDataEntry(ens[i].K, ens[i].V)
+	}
+
+	return entries
+}
+
+func (ad AccountData) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(ad.Hint()),
+			bson.M{
+				"entries": packAccountDataEntriesBSON(ad.entries),
+			}))
+}
+
+type AccountDataBSONUnpacker struct {
+	EN []AccountDataEntryBSONPacker `bson:"entries"`
+}
+
+func (ad *AccountData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uad AccountDataBSONUnpacker
+	if err := enc.Unmarshal(b, &uad); err != nil {
+		return err
+	}
+
+	*ad = NewAccountData(unpackAccountDataEntriesBSON(uad.EN))
+
+	return nil
+}
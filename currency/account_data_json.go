@@ -0,0 +1,55 @@
+package currency
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AccountDataEntryJSONPacker struct {
+	K string `json:"key"`
+	V string `json:"value"`
+}
+
+func packAccountDataEntriesJSON(entries []AccountDataEntry) []AccountDataEntryJSONPacker {
+	ens := make([]AccountDataEntryJSONPacker, len(entries))
+	for i := range entries {
+		ens[i] = AccountDataEntryJSONPacker{K: entries[i].key, V: entries[i].value}
+	}
+
+	return ens
+}
+
+func unpackAccountDataEntriesJSON(ens []AccountDataEntryJSONPacker) []AccountDataEntry {
+	entries := make([]AccountDataEntry, len(ens))
+	for i := range ens {
+		entries[i] = NewAccountDataEntry(ens[i].K, ens[i].V)
+	}
+
+	return entries
+}
+
+type AccountDataJSONPacker struct {
+	jsonenc.HintedHead
+	EN []AccountDataEntryJSONPacker `json:"entries"`
+}
+
+func (ad AccountData) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountDataJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(ad.Hint()),
+		EN:         packAccountDataEntriesJSON(ad.entries),
+	})
+}
+
+type AccountDataJSONUnpacker struct {
+	EN []AccountDataEntryJSONPacker `json:"entries"`
+}
+
+func (ad *AccountData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uad AccountDataJSONUnpacker
+	if err := enc.Unmarshal(b, &uad); err != nil {
+		return err
+	}
+
+	*ad = NewAccountData(unpackAccountDataEntriesJSON(uad.EN))
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountDataUpdaterFactType = hint.MustNewType(0xa0, 0x68, "mitum-currency-account-data-updater-operation-fact")
+	AccountDataUpdaterFactHint = hint.MustHint(AccountDataUpdaterFactType, "0.0.1")
+	AccountDataUpdaterType     = hint.MustNewType(0xa0, 0x69, "mitum-currency-account-data-updater-operation")
+	AccountDataUpdaterHint     = hint.MustHint(AccountDataUpdaterType, "0.0.1")
+)
+
+var (
+	AccountDataUpdaterFactExpiringHint   = hint.MustHint(AccountDataUpdaterFactType, "0.0.2")
+	AccountDataUpdaterFactExpiringHinter = AccountDataUpdaterFact{expiringFact: expiringFact{ht: AccountDataUpdaterFactExpiringHint}}
+)
+
+var MaxAccountDataUpdaterEntries uint = 100
+
+// AccountDataUpdaterFact sets or removes the entries of AccountData of target.
+// The entry with empty value removes the key.
+type AccountDataUpdaterFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	entries  []AccountDataEntry
+	currency CurrencyID
+}
+
+func NewAccountDataUpdaterFact(
+	token []byte,
+	target base.Address,
+	entries []AccountDataEntry,
+	currency CurrencyID,
+) AccountDataUpdaterFact {
+	fact := AccountDataUpdaterFact{
+		token:    token,
+		target:   target,
+		entries:  entries,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountDataUpdaterFact) WithExpiry(ex Expiry) AccountDataUpdaterFact {
+	fact.expiringFact = newExpiringFact(AccountDataUpdaterFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountDataUpdaterFact) Hint() hint.Hint {
+	return fact.factHint(AccountDataUpdaterFactHint)
+}
+
+func (fact AccountDataUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountDataUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountDataUpdaterFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	bs := make([][]byte, len(fact.entries)+3)
+	bs[0] = fact.token
+	bs[1] = fact.target.Bytes()
+	bs[2] = fact.currency.Bytes()
+
+	for i := range fact.entries {
+		bs[i+3] = fact.entries[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(append(bs, ext)...)
+}
+
+func (fact AccountDataUpdaterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for AccountDataUpdaterFact")
+	}
+
+	switch n := uint(len(fact.entries)); {
+	case n < 1:
+		return xerrors.Errorf("empty entries")
+	case n > MaxAccountDataUpdaterEntries:
+		return xerrors.Errorf("entries, %d over max, %d", n, MaxAccountDataUpdaterEntries)
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.entries {
+		en := fact.entries[i]
+		if err := en.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[en.key]; found {
+			return xerrors.Errorf("duplicated account data key found, %q", en.key)
+		}
+
+		founds[en.key] = struct{}{}
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact AccountDataUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountDataUpdaterFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact AccountDataUpdaterFact) Entries() []AccountDataEntry {
+	return fact.entries
+}
+
+func (fact AccountDataUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact AccountDataUpdaterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type AccountDataUpdater struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewAccountDataUpdater(fact AccountDataUpdaterFact, fs []operation.FactSign, memo string) (AccountDataUpdater, error) {
+	if bo, err := operation.NewBaseOperationFromFact(AccountDataUpdaterHint, fact, fs); err != nil {
+		return AccountDataUpdater{}, err
+	} else {
+		op := AccountDataUpdater{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op AccountDataUpdater) Hint() hint.Hint {
+	return AccountDataUpdaterHint
+}
+
+func (op AccountDataUpdater) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op AccountDataUpdater) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op AccountDataUpdater) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,83 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountDataUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash               `json:"hash"`
+	TK []byte                       `json:"token"`
+	TG base.Address                 `json:"target"`
+	EN []AccountDataEntryJSONPacker `json:"entries"`
+	CR CurrencyID                   `json:"currency"`
+	EX *Expiry                      `json:"expiry,omitempty"`
+}
+
+func (fact AccountDataUpdaterFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(AccountDataUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		EN:         packAccountDataEntriesJSON(fact.entries),
+		CR:         fact.currency,
+		EX:         ex,
+	})
+}
+
+type AccountDataUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes              `json:"hash"`
+	TK []byte                       `json:"token"`
+	TG base.AddressDecoder          `json:"target"`
+	EN []AccountDataEntryJSONPacker `json:"entries"`
+	CR string                       `json:"currency"`
+	EX *Expiry                      `json:"expiry,omitempty"`
+}
+
+func (fact *AccountDataUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact AccountDataUpdaterFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.TG, unpackAccountDataEntriesJSON(ufact.EN), ufact.CR, ufact.EX)
+}
+
+func (op AccountDataUpdater) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *AccountDataUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = AccountDataUpdater{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
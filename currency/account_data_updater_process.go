@@ -0,0 +1,128 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op AccountDataUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AccountDataUpdaterProcessor struct {
+	cp *CurrencyPool
+	po NetworkPolicy
+	AccountDataUpdater
+	sd     state.State
+	data   AccountData
+	sb     AmountState
+	fee    Big
+	height base.Height
+	ss     []state.State
+}
+
+func NewAccountDataUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(AccountDataUpdater); !ok {
+			return nil, xerrors.Errorf("not AccountDataUpdater, %T", op)
+		} else {
+			return &AccountDataUpdaterProcessor{
+				cp:                 cp,
+				po:                 DefaultNetworkPolicy,
+				AccountDataUpdater: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *AccountDataUpdaterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *AccountDataUpdaterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *AccountDataUpdaterProcessor) setNetworkPolicy(po NetworkPolicy) {
+	opp.po = po
+}
+
+func (opp *AccountDataUpdaterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AccountDataUpdaterFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.target), getState); err != nil {
+		return nil, err
+	}
+
+	var data AccountData
+	switch st, found, err := getState(StateKeyAccountData(fact.target)); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case !found || st.Value() == nil:
+		opp.sd = st
+	default:
+		if i, err := StateAccountDataValue(st); err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		} else {
+			opp.sd = st
+			data = i
+		}
+	}
+
+	if i, err := data.Update(fact.entries); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else if err := opp.po.AccountDataPolicy().Check(i); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else {
+		opp.data = i
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if err := checkFactSignsByState(fact.target, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.target, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *AccountDataUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AccountDataUpdaterFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateAccountDataValue(opp.sd, opp.data); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), append([]state.State{st, opp.sb}, opp.ss...)...)
+	}
+}
@@ -0,0 +1,268 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAccountDataUpdaterOperation struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAccountDataUpdaterOperation) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(AccountDataUpdater{}, NewAccountDataUpdaterProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAccountDataUpdaterOperation) newOperation(
+	target base.Address,
+	entries []AccountDataEntry,
+	pks []key.Privatekey,
+) AccountDataUpdater {
+	fact := NewAccountDataUpdaterFact(util.UUID().Bytes(), target, entries, t.cid)
+
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewAccountDataUpdater(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountDataUpdaterOperation) newDataState(a base.Address, data AccountData) state.State {
+	st, err := state.NewStateV0(StateKeyAccountData(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAccountDataValue(st, data)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAccountDataUpdaterOperation) data(pool *storage.Statepool, a base.Address) (AccountData, bool) {
+	for _, stu := range pool.Updates() {
+		if stu.Key() != StateKeyAccountData(a) {
+			continue
+		}
+
+		data, err := StateAccountDataValue(stu.GetState())
+		t.NoError(err)
+
+		return data, true
+	}
+
+	return AccountData{}, false
+}
+
+func (t *testAccountDataUpdaterOperation) TestSet() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{
+		NewAccountDataEntry("name", "showme"),
+		NewAccountDataEntry("website", "https://showme"),
+	}, sa.Privs())
+
+	t.NoError(opr.Process(op))
+
+	data, found := t.data(pool, sa.Address)
+	t.True(found)
+	t.Equal(2, len(data.Entries()))
+
+	v, found := data.Value("website")
+	t.True(found)
+	t.Equal("https://showme", v)
+
+	for _, stu := range pool.Updates() {
+		if stu.Key() == StateKeyBalance(sa.Address, t.cid) {
+			am, err := StateBalanceValue(stu.GetState())
+			t.NoError(err)
+			t.True(NewBig(32).Equal(am.Big()))
+		}
+	}
+}
+
+func (t *testAccountDataUpdaterOperation) TestDelete() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	data := NewAccountData([]AccountDataEntry{
+		NewAccountDataEntry("name", "showme"),
+		NewAccountDataEntry("website", "https://showme"),
+	})
+	pool, _ := t.statepool(sta, []state.State{t.newDataState(sa.Address, data)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{NewAccountDataEntry("website", "")}, sa.Privs())
+	t.NoError(opr.Process(op))
+
+	udata, found := t.data(pool, sa.Address)
+	t.True(found)
+	t.Equal(1, len(udata.Entries()))
+
+	_, found = udata.Value("website")
+	t.False(found)
+}
+
+func (t *testAccountDataUpdaterOperation) TestDeleteUnknownKey() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{NewAccountDataEntry("website", "")}, sa.Privs())
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not found")
+}
+
+func (t *testAccountDataUpdaterOperation) TestOverPolicy() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	st, err := state.NewStateV0(StateKeyNetworkPolicy, nil, base.Height(33))
+	t.NoError(err)
+
+	pst, err := SetStateNetworkPolicyValue(st,
+		DefaultNetworkPolicy.SetAccountDataPolicy(NewAccountDataPolicy(1, 10, 10)))
+	t.NoError(err)
+
+	data := NewAccountData([]AccountDataEntry{NewAccountDataEntry("name", "showme")})
+	pool, _ := t.statepool(sta, []state.State{t.newDataState(sa.Address, data), pst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{NewAccountDataEntry("website", "showme")}, sa.Privs())
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "entries over allowed")
+}
+
+func (t *testAccountDataUpdaterOperation) TestPolicyUpdatedInSameBlock() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	data := NewAccountData([]AccountDataEntry{NewAccountDataEntry("name", "showme")})
+	pool, _ := t.statepool(sta, []state.State{t.newDataState(sa.Address, data)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	priv := key.MustNewBTCPrivatekey()
+	threshold, err := base.NewThreshold(1, 100)
+	t.NoError(err)
+
+	copr := NewOperationProcessor(cp)
+	_, err = copr.SetProcessor(AccountDataUpdater{}, NewAccountDataUpdaterProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(NetworkPolicyUpdater{},
+		NewNetworkPolicyUpdaterProcessor(NewFixedSuffrageKeys([]key.Publickey{priv.Publickey()}, threshold)))
+	t.NoError(err)
+
+	opr := copr.New(pool)
+
+	// NOTE the updated network policy is applied from the next block
+	fact := NewNetworkPolicyUpdaterFact(util.UUID().Bytes(),
+		DefaultNetworkPolicy.SetAccountDataPolicy(NewAccountDataPolicy(1, 10, 10)))
+	sig, err := operation.NewFactSignature(priv, fact, nil)
+	t.NoError(err)
+
+	pop, err := NewNetworkPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(priv.Publickey(), sig)}, "")
+	t.NoError(err)
+	t.NoError(opr.Process(pop))
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{NewAccountDataEntry("website", "showme")}, sa.Privs())
+	t.NoError(opr.Process(op))
+
+	udata, found := t.data(pool, sa.Address)
+	t.True(found)
+	t.Equal(2, len(udata.Entries()))
+}
+
+func (t *testAccountDataUpdaterOperation) TestInsufficientFee() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, NewBig(4)))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{NewAccountDataEntry("name", "showme")}, sa.Privs())
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testAccountDataUpdaterOperation) TestInvalidSigning() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, []AccountDataEntry{
+		NewAccountDataEntry("name", "showme"),
+	}, []key.Privatekey{key.MustNewBTCPrivatekey()})
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func TestAccountDataUpdaterOperation(t *testing.T) {
+	suite.Run(t, new(testAccountDataUpdaterOperation))
+}
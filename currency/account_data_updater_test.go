@@ -0,0 +1,158 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testAccountDataUpdater struct {
+	baseTest
+}
+
+func (t *testAccountDataUpdater) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	fact := NewAccountDataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), []AccountDataEntry{
+		NewAccountDataEntry("name", "showme"),
+		NewAccountDataEntry("website", ""),
+	}, t.cid)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewAccountDataUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testAccountDataUpdater) TestInvalidFact() {
+	fact := NewAccountDataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), nil, t.cid)
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "empty entries")
+
+	fact = NewAccountDataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), []AccountDataEntry{
+		NewAccountDataEntry("-name", "showme"),
+	}, t.cid)
+	err = fact.IsValid(nil)
+	t.Contains(err.Error(), "invalid account data key")
+
+	fact = NewAccountDataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), []AccountDataEntry{
+		NewAccountDataEntry("name", "showme"),
+		NewAccountDataEntry("name", "findme"),
+	}, t.cid)
+	err = fact.IsValid(nil)
+	t.Contains(err.Error(), "duplicated account data key found")
+}
+
+func (t *testAccountDataUpdater) TestUpdate() {
+	data := NewAccountData(nil)
+
+	data, err := data.Update([]AccountDataEntry{
+		NewAccountDataEntry("website", "https://showme"),
+		NewAccountDataEntry("name", "showme"),
+	})
+	t.NoError(err)
+	t.NoError(data.IsValid(nil))
+	t.Equal(2, len(data.Entries()))
+	t.Equal("name", data.Entries()[0].Key())
+
+	data, err = data.Update([]AccountDataEntry{
+		NewAccountDataEntry("name", "findme"),
+		NewAccountDataEntry("website", ""),
+	})
+	t.NoError(err)
+	t.Equal(1, len(data.Entries()))
+
+	v, found := data.Value("name")
+	t.True(found)
+	t.Equal("findme", v)
+
+	_, found = data.Value("website")
+	t.False(found)
+
+	_, err = data.Update([]AccountDataEntry{NewAccountDataEntry("website", "")})
+	t.Contains(err.Error(), "not found")
+}
+
+func (t *testAccountDataUpdater) TestPolicy() {
+	po := NewAccountDataPolicy(2, 4, 6)
+
+	t.NoError(po.Check(NewAccountData([]AccountDataEntry{
+		NewAccountDataEntry("name", "showme"),
+	})))
+
+	err := po.Check(NewAccountData([]AccountDataEntry{
+		NewAccountDataEntry("a", "a"),
+		NewAccountDataEntry("b", "b"),
+		NewAccountDataEntry("c", "c"),
+	}))
+	t.Contains(err.Error(), "entries over allowed")
+
+	err = po.Check(NewAccountData([]AccountDataEntry{NewAccountDataEntry("names", "a")}))
+	t.Contains(err.Error(), "too long")
+
+	err = po.Check(NewAccountData([]AccountDataEntry{NewAccountDataEntry("name", "findme!")}))
+	t.Contains(err.Error(), "too long")
+}
+
+func TestAccountDataUpdater(t *testing.T) {
+	suite.Run(t, new(testAccountDataUpdater))
+}
+
+func testAccountDataUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		fact := NewAccountDataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), []AccountDataEntry{
+			NewAccountDataEntry("name", "showme"),
+			NewAccountDataEntry("website", ""),
+		}, CurrencyID("SHOWME"))
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewAccountDataUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(AccountDataUpdater)
+		tb := b.(AccountDataUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(AccountDataUpdaterFact)
+		ufact := tb.Fact().(AccountDataUpdaterFact)
+
+		t.True(fact.Hash().Equal(ufact.Hash()))
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.entries, ufact.entries)
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestAccountDataUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountDataUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountDataUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountDataUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountMergeFactType = hint.MustNewType(0xa0, 0x4f, "mitum-currency-account-merge-operation-fact")
+	AccountMergeFactHint = hint.MustHint(AccountMergeFactType, "0.0.1")
+	AccountMergeType     = hint.MustNewType(0xa0, 0x50, "mitum-currency-account-merge-operation")
+	AccountMergeHint     = hint.MustHint(AccountMergeType, "0.0.1")
+)
+
+var (
+	AccountMergeFactExpiringHint   = hint.MustHint(AccountMergeFactType, "0.0.2")
+	AccountMergeFactExpiringHinter = AccountMergeFact{expiringFact: expiringFact{ht: AccountMergeFactExpiringHint}}
+)
+
+// AccountMergeFact sweeps all the balances of sender into receiver and closes
+// the sender account. The closed account can not send operations any more.
+type AccountMergeFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewAccountMergeFact(token []byte, sender, receiver base.Address) AccountMergeFact {
+	fact := AccountMergeFact{
+		token:    token,
+		sender:   sender,
+		receiver: receiver,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountMergeFact) WithExpiry(ex Expiry) AccountMergeFact {
+	fact.expiringFact = newExpiringFact(AccountMergeFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountMergeFact) Hint() hint.Hint {
+	return fact.factHint(AccountMergeFactHint)
+}
+
+func (fact AccountMergeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountMergeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountMergeFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		ext,
+	)
+}
+
+func (fact AccountMergeFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for AccountMergeFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.receiver,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact AccountMergeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountMergeFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AccountMergeFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact AccountMergeFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type AccountMerge struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewAccountMerge(fact AccountMergeFact, fs []operation.FactSign, memo string) (AccountMerge, error) {
+	if bo, err := operation.NewBaseOperationFromFact(AccountMergeHint, fact, fs); err != nil {
+		return AccountMerge{}, err
+	} else {
+		op := AccountMerge{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op AccountMerge) Hint() hint.Hint {
+	return AccountMergeHint
+}
+
+func (op AccountMerge) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op AccountMerge) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op AccountMerge) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *AccountMergeFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bReceiver base.AddressDecoder,
+	ex *Expiry,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.sender = a
+	}
+
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.receiver = a
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, AccountMergeFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+
+	return nil
+}
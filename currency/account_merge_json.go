@@ -0,0 +1,80 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountMergeFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact AccountMergeFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(AccountMergeFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		RC:         fact.receiver,
+		EX:         ex,
+	})
+}
+
+type AccountMergeFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	EX *Expiry             `json:"expiry,omitempty"`
+}
+
+func (fact *AccountMergeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact AccountMergeFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, ufact.RC, ufact.EX)
+}
+
+func (op AccountMerge) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *AccountMerge) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = AccountMerge{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
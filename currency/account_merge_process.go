@@ -0,0 +1,171 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op AccountMerge) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AccountMergeProcessor struct {
+	cp *CurrencyPool
+	AccountMerge
+	sb     map[CurrencyID]AmountState
+	rb     map[CurrencyID]AmountState
+	amount map[CurrencyID][2]Big
+	closed state.State
+	height base.Height
+	ss     []state.State
+}
+
+func NewAccountMergeProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(AccountMerge); !ok {
+			return nil, xerrors.Errorf("not AccountMerge, %T", op)
+		} else {
+			return &AccountMergeProcessor{
+				cp:           cp,
+				AccountMerge: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *AccountMergeProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *AccountMergeProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *AccountMergeProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AccountMergeFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.receiver), "receiver", getState); err != nil {
+		return nil, err
+	} else if err := checkNotClosedAccount(fact.receiver, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if st, _, err := getState(StateKeyAccountClosed(fact.sender)); err != nil {
+		return nil, err
+	} else {
+		opp.closed = st
+	}
+
+	sb := map[CurrencyID]AmountState{}
+	rb := map[CurrencyID]AmountState{}
+	amount := map[CurrencyID][2]Big{}
+
+	var cids []CurrencyID
+	if opp.cp != nil {
+		cids = opp.cp.CIDs()
+	}
+
+	for i := range cids {
+		cid := cids[i]
+
+		var st state.State
+		switch j, found, err := getState(StateKeyBalance(fact.sender, cid)); {
+		case err != nil:
+			return nil, err
+		case !found:
+			continue
+		default:
+			st = j
+		}
+
+		var big Big
+		if am, err := StateBalanceValue(st); err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		} else if !am.Big().OverZero() {
+			continue
+		} else {
+			big = am.Big()
+		}
+
+		// NOTE the fee is taken from the swept balance; if the balance is not
+		// enough for fee, all the balance goes to fee.
+		fee := ZeroBig
+		if feeer, found := opp.cp.Feeer(cid); !found {
+			return nil, operation.NewBaseReasonError("currency not registered, %q", cid)
+		} else if j, err := feeer.Fee(big); err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		} else if j.Compare(big) > 0 {
+			fee = big
+		} else {
+			fee = j
+		}
+
+		if j, _, err := getState(StateKeyBalance(fact.receiver, cid)); err != nil {
+			return nil, err
+		} else {
+			rb[cid] = NewAmountState(j, cid)
+		}
+
+		sb[cid] = NewAmountState(st, cid)
+		amount[cid] = [2]Big{big, fee}
+	}
+
+	if ss, err := checkSpendingLimits(fact.sender, opp.height, amount, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	opp.sb = sb
+	opp.rb = rb
+	opp.amount = amount
+
+	return opp, nil
+}
+
+func (opp *AccountMergeProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AccountMergeFact)
+
+	sts := make([]state.State, len(opp.amount)*2+1, len(opp.amount)*2+1+len(opp.ss))
+
+	var i int
+	for cid := range opp.amount {
+		am := opp.amount[cid]
+
+		sts[i] = opp.sb[cid].Sub(am[0]).AddFee(am[1])
+		sts[i+1] = opp.rb[cid].Add(am[0].Sub(am[1]))
+		i += 2
+	}
+
+	if st, err := SetStateAccountClosedValue(opp.closed, NewAccountClosed(fact.receiver)); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	} else {
+		sts[len(sts)-1] = st
+	}
+
+	sts = append(sts, opp.ss...)
+
+	return setState(fact.Hash(), sts...)
+}
@@ -0,0 +1,294 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAccountMergeOperation struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAccountMergeOperation) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(AccountMerge{}, NewAccountMergeProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(MultiTransfers{}, NewMultiTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(TransferFrom{}, NewTransferFromProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAccountMergeOperation) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAccountMergeOperation) newOperation(sender, receiver base.Address, pks []key.Privatekey) AccountMerge {
+	fact := NewAccountMergeFact(util.UUID().Bytes(), sender, receiver)
+
+	op, err := NewAccountMerge(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountMergeOperation) newClosedState(a, to base.Address) state.State {
+	st, err := state.NewStateV0(StateKeyAccountClosed(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAccountClosedValue(st, NewAccountClosed(to))
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAccountMergeOperation) TestNew() {
+	cid0 := CurrencyID("SHOWME")
+	cid1 := CurrencyID("FINDME")
+
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid0), NewAmount(NewBig(44), cid1)})
+	ra, str := t.newAccount(true, []Amount{NewAmount(NewBig(1), cid0)})
+
+	pool, _ := t.statepool(sta, str)
+
+	fee := NewBig(3)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid0, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, fee))))
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid1, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(sa.Address, ra.Address, sa.Privs())
+	t.NoError(opr.Process(op))
+
+	balances := map[string]Big{}
+	fees := map[CurrencyID]Big{}
+
+	var closedTo base.Address
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+
+		switch {
+		case IsStateBalanceKey(st.Key()):
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			balances[st.Key()] = am.Big()
+
+			if f := st.(AmountState).Fee(); f.OverZero() {
+				fees[am.Currency()] = f
+			}
+		case IsStateAccountClosedKey(st.Key()):
+			t.Equal(StateKeyAccountClosed(sa.Address), st.Key())
+
+			ac, err := StateAccountClosedValue(st)
+			t.NoError(err)
+			closedTo = ac.Receiver()
+
+			t.True(op.Fact().Hash().Equal(stu.Operations()[0]))
+		}
+	}
+
+	t.True(ZeroBig.Equal(balances[StateKeyBalance(sa.Address, cid0)]))
+	t.True(ZeroBig.Equal(balances[StateKeyBalance(sa.Address, cid1)]))
+	t.True(NewBig(1 + 33 - 3).Equal(balances[StateKeyBalance(ra.Address, cid0)]))
+	t.True(NewBig(44 - 3).Equal(balances[StateKeyBalance(ra.Address, cid1)]))
+	t.True(fee.Equal(fees[cid0]))
+	t.True(fee.Equal(fees[cid1]))
+
+	t.NotNil(closedTo)
+	t.True(ra.Address.Equal(closedTo))
+}
+
+func (t *testAccountMergeOperation) TestFeeOverBalance() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(2), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, NewBig(3)))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newOperation(sa.Address, ra.Address, sa.Privs())))
+
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+		if !IsStateBalanceKey(st.Key()) {
+			continue
+		}
+
+		am, err := StateBalanceValue(st)
+		t.NoError(err)
+		t.True(ZeroBig.Equal(am.Big()))
+
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			t.True(NewBig(2).Equal(st.(AmountState).Fee()))
+		}
+	}
+}
+
+func (t *testAccountMergeOperation) TestUnderMinBalance() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(ra.Address, NewBig(3))).WithMinBalance(NewBig(10))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), ra.Address, po)))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE merge can take the balance under min balance
+	t.NoError(opr.Process(t.newOperation(sa.Address, ra.Address, sa.Privs())))
+
+	var found bool
+	for _, stu := range pool.Updates() {
+		if st := stu.GetState(); st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			t.True(ZeroBig.Equal(am.Big()))
+
+			found = true
+		}
+	}
+
+	t.True(found)
+}
+
+func (t *testAccountMergeOperation) TestClosedSender() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str, []state.State{t.newClosedState(sa.Address, ra.Address)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, []TransfersItem{
+		NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid)),
+	})
+	tf, err := NewTransfers(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already closed")
+}
+
+func (t *testAccountMergeOperation) TestClosedReceiver() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+	ca, stc := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str, stc, []state.State{t.newClosedState(ra.Address, ca.Address)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, ra.Address, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already closed")
+	t.Empty(pool.Updates())
+}
+
+func (t *testAccountMergeOperation) closedReceiver(f func(sa, ra *account) state.Processor, sts ...state.State) {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+	ca, stc := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str, stc, []state.State{t.newClosedState(ra.Address, ca.Address)}, sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ca.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(f(sa, ra))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already closed")
+	t.Empty(pool.Updates())
+}
+
+func (t *testAccountMergeOperation) TestTransfersToClosedReceiver() {
+	t.closedReceiver(func(sa, ra *account) state.Processor {
+		fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, []TransfersItem{
+			NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid)),
+		})
+		op, err := NewTransfers(fact, t.factSigns(fact, sa.Privs()), "")
+		t.NoError(err)
+
+		return op
+	})
+}
+
+func (t *testAccountMergeOperation) TestMultiTransfersToClosedReceiver() {
+	t.closedReceiver(func(sa, ra *account) state.Processor {
+		fact := NewMultiTransfersFact(util.UUID().Bytes(),
+			[]MultiTransfersSender{NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(1), t.cid)})},
+			[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid))},
+		)
+		op, err := NewMultiTransfers(fact, t.factSigns(fact, sa.Privs()), "")
+		t.NoError(err)
+
+		return op
+	})
+}
+
+func (t *testAccountMergeOperation) TestTransferFromToClosedReceiver() {
+	oa, sto := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	t.closedReceiver(func(sa, ra *account) state.Processor {
+		fact := NewTransferFromFact(util.UUID().Bytes(), sa.Address, oa.Address, ra.Address,
+			[]Amount{NewAmount(NewBig(1), t.cid)})
+		op, err := NewTransferFrom(fact, t.factSigns(fact, sa.Privs()), "")
+		t.NoError(err)
+
+		return op
+	}, sto...)
+}
+
+func TestAccountMergeOperation(t *testing.T) {
+	suite.Run(t, new(testAccountMergeOperation))
+}
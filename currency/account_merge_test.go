@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testAccountMerge struct {
+	baseTest
+}
+
+func (t *testAccountMerge) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	fact := NewAccountMergeFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress())
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewAccountMerge(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testAccountMerge) TestSameReceiver() {
+	a := NewTestAddress()
+	fact := NewAccountMergeFact(util.UUID().Bytes(), a, a)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func TestAccountMerge(t *testing.T) {
+	suite.Run(t, new(testAccountMerge))
+}
+
+func testAccountMergeEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		fact := NewAccountMergeFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress())
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewAccountMerge(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(AccountMerge)
+		tb := b.(AccountMerge)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(AccountMergeFact)
+		ufact := tb.Fact().(AccountMergeFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.receiver.Equal(ufact.receiver))
+	}
+
+	return t
+}
+
+func TestAccountMergeEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountMergeEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountMergeEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountMergeEncode(bsonenc.NewEncoder()))
+}
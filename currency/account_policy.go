@@ -0,0 +1,275 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountPolicyType = hint.MustNewType(0xa0, 0x5a, "mitum-currency-account-policy")
+	AccountPolicyHint = hint.MustHint(AccountPolicyType, "0.0.1")
+	AccountSpentType  = hint.MustNewType(0xa0, 0x5b, "mitum-currency-account-spent")
+	AccountSpentHint  = hint.MustHint(AccountSpentType, "0.0.1")
+
+	PendingAccountPolicyType = hint.MustNewType(0xa0, 0x6f, "mitum-currency-pending-account-policy")
+	PendingAccountPolicyHint = hint.MustHint(PendingAccountPolicyType, "0.0.1")
+)
+
+// AccountPolicy limits the outflow of account by currency. The sum of the
+// outflow in the recent window heights can not be over the limit. Empty
+// AccountPolicy means no limits.
+type AccountPolicy struct {
+	window base.Height
+	limits []Amount
+}
+
+func NewAccountPolicy(window base.Height, limits []Amount) AccountPolicy {
+	return AccountPolicy{window: window, limits: limits}
+}
+
+func (po AccountPolicy) Hint() hint.Hint {
+	return AccountPolicyHint
+}
+
+func (po AccountPolicy) Bytes() []byte {
+	bs := make([][]byte, len(po.limits)+1)
+	bs[0] = po.window.Bytes()
+
+	for i := range po.limits {
+		bs[i+1] = po.limits[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (po AccountPolicy) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(po.Bytes())
+}
+
+func (po AccountPolicy) IsValid([]byte) error {
+	if len(po.limits) < 1 {
+		return nil
+	}
+
+	if po.window < 1 {
+		return xerrors.Errorf("invalid window of AccountPolicy, %d", po.window)
+	}
+
+	foundCurrencies := map[CurrencyID]struct{}{}
+	for i := range po.limits {
+		am := po.limits[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return xerrors.Errorf("limit should be over zero, %v", am)
+		}
+
+		if _, found := foundCurrencies[am.Currency()]; found {
+			return xerrors.Errorf("duplicated currency of limit found, %q", am.Currency())
+		}
+
+		foundCurrencies[am.Currency()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (po AccountPolicy) Window() base.Height {
+	return po.window
+}
+
+func (po AccountPolicy) Limits() []Amount {
+	return po.limits
+}
+
+func (po AccountPolicy) Limit(cid CurrencyID) (Big, bool) {
+	for i := range po.limits {
+		if po.limits[i].Currency() == cid {
+			return po.limits[i].Big(), true
+		}
+	}
+
+	return ZeroBig, false
+}
+
+func (po AccountPolicy) IsEmpty() bool {
+	return len(po.limits) < 1
+}
+
+// IsRelaxedBy returns true when the new AccountPolicy allows more outflow than
+// the current; the limit is removed or increased, or the window is shortened.
+func (po AccountPolicy) IsRelaxedBy(npo AccountPolicy) bool {
+	if po.IsEmpty() {
+		return false
+	} else if npo.IsEmpty() || npo.window < po.window {
+		return true
+	}
+
+	for i := range po.limits {
+		am := po.limits[i]
+		if limit, found := npo.Limit(am.Currency()); !found || limit.Compare(am.Big()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PendingAccountPolicy is the relaxed AccountPolicy by AccountPolicyUpdater; it
+// replaces the AccountPolicy of account from the height, so the leaked keys can
+// not lift the limits at once.
+type PendingAccountPolicy struct {
+	policy AccountPolicy
+	height base.Height
+}
+
+func NewPendingAccountPolicy(policy AccountPolicy, height base.Height) PendingAccountPolicy {
+	return PendingAccountPolicy{policy: policy, height: height}
+}
+
+func (pp PendingAccountPolicy) Hint() hint.Hint {
+	return PendingAccountPolicyHint
+}
+
+func (pp PendingAccountPolicy) Bytes() []byte {
+	return util.ConcatBytesSlice(pp.policy.Bytes(), pp.height.Bytes())
+}
+
+func (pp PendingAccountPolicy) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(pp.Bytes())
+}
+
+func (pp PendingAccountPolicy) IsValid([]byte) error {
+	if err := pp.policy.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid PendingAccountPolicy: %w", err)
+	}
+
+	return pp.height.IsValid(nil)
+}
+
+func (pp PendingAccountPolicy) Policy() AccountPolicy {
+	return pp.policy
+}
+
+func (pp PendingAccountPolicy) Height() base.Height {
+	return pp.height
+}
+
+func (pp PendingAccountPolicy) IsActivated(height base.Height) bool {
+	return height >= pp.height
+}
+
+// SpentRecord is the outflow of account at the height.
+type SpentRecord struct {
+	height base.Height
+	big    Big
+}
+
+func NewSpentRecord(height base.Height, big Big) SpentRecord {
+	return SpentRecord{height: height, big: big}
+}
+
+func (sr SpentRecord) Bytes() []byte {
+	return util.ConcatBytesSlice(sr.height.Bytes(), sr.big.Bytes())
+}
+
+func (sr SpentRecord) Height() base.Height {
+	return sr.height
+}
+
+func (sr SpentRecord) Big() Big {
+	return sr.big
+}
+
+// AccountSpent keeps the outflow records of account by currency.
+type AccountSpent struct {
+	cid     CurrencyID
+	records []SpentRecord
+}
+
+func NewAccountSpent(cid CurrencyID, records []SpentRecord) AccountSpent {
+	return AccountSpent{cid: cid, records: records}
+}
+
+func (as AccountSpent) Hint() hint.Hint {
+	return AccountSpentHint
+}
+
+func (as AccountSpent) Bytes() []byte {
+	bs := make([][]byte, len(as.records)+1)
+	bs[0] = as.cid.Bytes()
+
+	for i := range as.records {
+		bs[i+1] = as.records[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (as AccountSpent) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(as.Bytes())
+}
+
+func (as AccountSpent) IsValid([]byte) error {
+	if err := as.cid.IsValid(nil); err != nil {
+		return err
+	}
+
+	for i := range as.records {
+		if !as.records[i].big.OverZero() {
+			return xerrors.Errorf("spent should be over zero, %v", as.records[i].big)
+		}
+	}
+
+	return nil
+}
+
+func (as AccountSpent) Currency() CurrencyID {
+	return as.cid
+}
+
+func (as AccountSpent) Records() []SpentRecord {
+	return as.records
+}
+
+// Sum returns the sum of the outflow in the window heights until the given
+// height.
+func (as AccountSpent) Sum(height, window base.Height) Big {
+	sum := ZeroBig
+	for i := range as.records {
+		if as.records[i].height > height-window {
+			sum = sum.Add(as.records[i].big)
+		}
+	}
+
+	return sum
+}
+
+// Add adds the new outflow at the given height. The records out of the window
+// are removed.
+func (as AccountSpent) Add(height, window base.Height, big Big) AccountSpent {
+	var records []SpentRecord
+	var added bool
+	for i := range as.records {
+		r := as.records[i]
+		switch {
+		case r.height <= height-window:
+			continue
+		case r.height == height:
+			r = NewSpentRecord(height, r.big.Add(big))
+			added = true
+		}
+
+		records = append(records, r)
+	}
+
+	if !added {
+		records = append(records, NewSpentRecord(height, big))
+	}
+
+	return NewAccountSpent(as.cid, records)
+}
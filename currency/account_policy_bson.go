@@ -0,0 +1,99 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (po AccountPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(po.Hint()),
+			bson.M{
+				"window": po.window,
+				"limits": po.limits,
+			}))
+}
+
+type AccountPolicyBSONUnpacker struct {
+	WD base.Height `bson:"window"`
+	LM []bson.Raw  `bson:"limits"`
+}
+
+func (po *AccountPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo AccountPolicyBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	lms := make([][]byte, len(upo.LM))
+	for i := range upo.LM {
+		lms[i] = upo.LM[i]
+	}
+
+	return po.unpack(enc, upo.WD, lms)
+}
+
+func (pp PendingAccountPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(pp.Hint()),
+			bson.M{
+				"policy": pp.policy,
+				"height": pp.height,
+			}))
+}
+
+type PendingAccountPolicyBSONUnpacker struct {
+	PO bson.Raw    `bson:"policy"`
+	HT base.Height `bson:"height"`
+}
+
+func (pp *PendingAccountPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upp PendingAccountPolicyBSONUnpacker
+	if err := enc.Unmarshal(b, &upp); err != nil {
+		return err
+	}
+
+	return pp.unpack(enc, upp.PO, upp.HT)
+}
+
+type SpentRecordBSONPacker struct {
+	HT base.Height `bson:"height"`
+	BG Big         `bson:"amount"`
+}
+
+func (as AccountSpent) MarshalBSON() ([]byte, error) {
+	records := make([]SpentRecordBSONPacker, len(as.records))
+	for i := range as.records {
+		records[i] = SpentRecordBSONPacker{HT: as.records[i].height, BG: as.records[i].big}
+	}
+
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(as.Hint()),
+			bson.M{
+				"currency": as.cid,
+				"records":  records,
+			}))
+}
+
+type AccountSpentBSONUnpacker struct {
+	CR string                  `bson:"currency"`
+	RS []SpentRecordBSONPacker `bson:"records"`
+}
+
+func (as *AccountSpent) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uas AccountSpentBSONUnpacker
+	if err := enc.Unmarshal(b, &uas); err != nil {
+		return err
+	}
+
+	records := make([]SpentRecord, len(uas.RS))
+	for i := range uas.RS {
+		records[i] = NewSpentRecord(uas.RS[i].HT, uas.RS[i].BG)
+	}
+
+	*as = NewAccountSpent(CurrencyID(uas.CR), records)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (po *AccountPolicy) unpack(enc encoder.Encoder, window base.Height, blimits [][]byte) error {
+	limits := make([]Amount, len(blimits))
+	for i := range blimits {
+		if am, err := DecodeAmount(enc, blimits[i]); err != nil {
+			return err
+		} else {
+			limits[i] = am
+		}
+	}
+
+	po.window = window
+	po.limits = limits
+
+	return nil
+}
+
+func (pp *PendingAccountPolicy) unpack(enc encoder.Encoder, bpo []byte, height base.Height) error {
+	if hinter, err := enc.DecodeByHint(bpo); err != nil {
+		return err
+	} else if po, ok := hinter.(AccountPolicy); !ok {
+		return xerrors.Errorf("not AccountPolicy: %T", hinter)
+	} else {
+		pp.policy = po
+	}
+
+	pp.height = height
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AccountPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	WD base.Height `json:"window"`
+	LM []Amount    `json:"limits"`
+}
+
+func (po AccountPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(po.Hint()),
+		WD:         po.window,
+		LM:         po.limits,
+	})
+}
+
+type AccountPolicyJSONUnpacker struct {
+	WD base.Height       `json:"window"`
+	LM []json.RawMessage `json:"limits"`
+}
+
+func (po *AccountPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo AccountPolicyJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	lms := make([][]byte, len(upo.LM))
+	for i := range upo.LM {
+		lms[i] = upo.LM[i]
+	}
+
+	return po.unpack(enc, upo.WD, lms)
+}
+
+type PendingAccountPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	PO AccountPolicy `json:"policy"`
+	HT base.Height   `json:"height"`
+}
+
+func (pp PendingAccountPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(PendingAccountPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(pp.Hint()),
+		PO:         pp.policy,
+		HT:         pp.height,
+	})
+}
+
+type PendingAccountPolicyJSONUnpacker struct {
+	PO json.RawMessage `json:"policy"`
+	HT base.Height     `json:"height"`
+}
+
+func (pp *PendingAccountPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upp PendingAccountPolicyJSONUnpacker
+	if err := enc.Unmarshal(b, &upp); err != nil {
+		return err
+	}
+
+	return pp.unpack(enc, upp.PO, upp.HT)
+}
+
+type SpentRecordJSONPacker struct {
+	HT base.Height `json:"height"`
+	BG Big         `json:"amount"`
+}
+
+type AccountSpentJSONPacker struct {
+	jsonenc.HintedHead
+	CR CurrencyID              `json:"currency"`
+	RS []SpentRecordJSONPacker `json:"records"`
+}
+
+func (as AccountSpent) MarshalJSON() ([]byte, error) {
+	records := make([]SpentRecordJSONPacker, len(as.records))
+	for i := range as.records {
+		records[i] = SpentRecordJSONPacker{HT: as.records[i].height, BG: as.records[i].big}
+	}
+
+	return jsonenc.Marshal(AccountSpentJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(as.Hint()),
+		CR:         as.cid,
+		RS:         records,
+	})
+}
+
+type AccountSpentJSONUnpacker struct {
+	CR string                  `json:"currency"`
+	RS []SpentRecordJSONPacker `json:"records"`
+}
+
+func (as *AccountSpent) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uas AccountSpentJSONUnpacker
+	if err := enc.Unmarshal(b, &uas); err != nil {
+		return err
+	}
+
+	records := make([]SpentRecord, len(uas.RS))
+	for i := range uas.RS {
+		records[i] = NewSpentRecord(uas.RS[i].HT, uas.RS[i].BG)
+	}
+
+	*as = NewAccountSpent(CurrencyID(uas.CR), records)
+
+	return nil
+}
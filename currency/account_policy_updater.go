@@ -0,0 +1,175 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountPolicyUpdaterFactType = hint.MustNewType(0xa0, 0x5c, "mitum-currency-account-policy-updater-operation-fact")
+	AccountPolicyUpdaterFactHint = hint.MustHint(AccountPolicyUpdaterFactType, "0.0.1")
+	AccountPolicyUpdaterType     = hint.MustNewType(0xa0, 0x5d, "mitum-currency-account-policy-updater-operation")
+	AccountPolicyUpdaterHint     = hint.MustHint(AccountPolicyUpdaterType, "0.0.1")
+)
+
+var (
+	AccountPolicyUpdaterFactExpiringHint   = hint.MustHint(AccountPolicyUpdaterFactType, "0.0.2")
+	AccountPolicyUpdaterFactExpiringHinter = AccountPolicyUpdaterFact{expiringFact: expiringFact{ht: AccountPolicyUpdaterFactExpiringHint}}
+)
+
+type AccountPolicyUpdaterFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	policy   AccountPolicy
+	currency CurrencyID
+}
+
+func NewAccountPolicyUpdaterFact(token []byte, target base.Address, policy AccountPolicy, currency CurrencyID) AccountPolicyUpdaterFact {
+	fact := AccountPolicyUpdaterFact{
+		token:    token,
+		target:   target,
+		policy:   policy,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountPolicyUpdaterFact) WithExpiry(ex Expiry) AccountPolicyUpdaterFact {
+	fact.expiringFact = newExpiringFact(AccountPolicyUpdaterFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountPolicyUpdaterFact) Hint() hint.Hint {
+	return fact.factHint(AccountPolicyUpdaterFactHint)
+}
+
+func (fact AccountPolicyUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountPolicyUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountPolicyUpdaterFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.policy.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact AccountPolicyUpdaterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for AccountPolicyUpdaterFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.policy,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact AccountPolicyUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountPolicyUpdaterFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact AccountPolicyUpdaterFact) Policy() AccountPolicy {
+	return fact.policy
+}
+
+func (fact AccountPolicyUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact AccountPolicyUpdaterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type AccountPolicyUpdater struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewAccountPolicyUpdater(fact AccountPolicyUpdaterFact, fs []operation.FactSign, memo string) (AccountPolicyUpdater, error) {
+	if bo, err := operation.NewBaseOperationFromFact(AccountPolicyUpdaterHint, fact, fs); err != nil {
+		return AccountPolicyUpdater{}, err
+	} else {
+		op := AccountPolicyUpdater{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op AccountPolicyUpdater) Hint() hint.Hint {
+	return AccountPolicyUpdaterHint
+}
+
+func (op AccountPolicyUpdater) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op AccountPolicyUpdater) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op AccountPolicyUpdater) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,46 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *AccountPolicyUpdaterFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	btarget base.AddressDecoder,
+	bpo []byte,
+	cr string,
+	ex *Expiry,
+) error {
+	var target base.Address
+	if a, err := btarget.Encode(enc); err != nil {
+		return err
+	} else {
+		target = a
+	}
+
+	var policy AccountPolicy
+	if hinter, err := enc.DecodeByHint(bpo); err != nil {
+		return err
+	} else if p, ok := hinter.(AccountPolicy); !ok {
+		return xerrors.Errorf("not AccountPolicy: %T", hinter)
+	} else {
+		policy = p
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, AccountPolicyUpdaterFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.target = target
+	fact.policy = policy
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountPolicyUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	TG base.Address   `json:"target"`
+	PO AccountPolicy  `json:"policy"`
+	CR CurrencyID     `json:"currency"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact AccountPolicyUpdaterFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(AccountPolicyUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		PO:         fact.policy,
+		CR:         fact.currency,
+		EX:         ex,
+	})
+}
+
+type AccountPolicyUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	TG base.AddressDecoder `json:"target"`
+	PO json.RawMessage     `json:"policy"`
+	CR string              `json:"currency"`
+	EX *Expiry             `json:"expiry,omitempty"`
+}
+
+func (fact *AccountPolicyUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact AccountPolicyUpdaterFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.TG, ufact.PO, ufact.CR, ufact.EX)
+}
+
+func (op AccountPolicyUpdater) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *AccountPolicyUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = AccountPolicyUpdater{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op AccountPolicyUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AccountPolicyUpdaterProcessor struct {
+	cp *CurrencyPool
+	AccountPolicyUpdater
+	height  base.Height
+	sp      state.State
+	pp      state.State
+	current AccountPolicy
+	sb      AmountState
+	fee     Big
+	ss      []state.State
+}
+
+func NewAccountPolicyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(AccountPolicyUpdater); !ok {
+			return nil, xerrors.Errorf("not AccountPolicyUpdater, %T", op)
+		} else {
+			return &AccountPolicyUpdaterProcessor{
+				cp:                   cp,
+				AccountPolicyUpdater: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *AccountPolicyUpdaterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *AccountPolicyUpdaterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+// PreProcess checks the new AccountPolicy against the AccountPolicy in effect.
+// The tightened policy is applied at once, but the relaxed policy is
+// activated after the window of the current policy.
+func (opp *AccountPolicyUpdaterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AccountPolicyUpdaterFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.target), getState); err != nil {
+		return nil, err
+	}
+
+	if st, _, err := getState(StateKeyAccountPolicy(fact.target)); err != nil {
+		return nil, err
+	} else {
+		opp.sp = st
+	}
+
+	if st, _, err := getState(StateKeyPendingAccountPolicy(fact.target)); err != nil {
+		return nil, err
+	} else {
+		opp.pp = st
+	}
+
+	switch po, err := loadAccountPolicy(fact.target, opp.height, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case po.IsEmpty() && fact.policy.IsEmpty():
+		return nil, operation.NewBaseReasonError("account policy of target does not exist")
+	case po.Hash().Equal(fact.policy.Hash()):
+		return nil, operation.NewBaseReasonError("same AccountPolicy with the existing")
+	default:
+		opp.current = po
+	}
+
+	if opp.cp != nil {
+		limits := fact.policy.Limits()
+		for i := range limits {
+			if !opp.cp.Exists(limits[i].Currency()) {
+				return nil, operation.NewBaseReasonError("currency not registered, %q", limits[i].Currency())
+			}
+		}
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if err := checkFactSignsByState(fact.target, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.target, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *AccountPolicyUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AccountPolicyUpdaterFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+
+	// NOTE the pending policy is replaced by the new one; if it is already
+	// activated, the current policy is kept instead of it.
+	po, pp := fact.policy, NewPendingAccountPolicy(fact.policy, opp.height)
+	if opp.current.IsRelaxedBy(fact.policy) {
+		po = opp.current
+		pp = NewPendingAccountPolicy(fact.policy, opp.height+opp.current.Window())
+	}
+
+	sts := []state.State{opp.sb}
+	if st, err := SetStateAccountPolicyValue(opp.sp, po); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	if st, err := SetStatePendingAccountPolicyValue(opp.pp, pp); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	return setState(fact.Hash(), append(sts, opp.ss...)...)
+}
+
+// loadAccountPolicy returns the AccountPolicy of account in effect at the
+// height; the activated PendingAccountPolicy replaces the AccountPolicy. If not
+// yet set, empty AccountPolicy is returned.
+func loadAccountPolicy(
+	a base.Address,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) (AccountPolicy, error) {
+	switch st, found, err := getState(StateKeyPendingAccountPolicy(a)); {
+	case err != nil:
+		return AccountPolicy{}, err
+	case found && st.Value() != nil:
+		if pp, err := StatePendingAccountPolicyValue(st); err != nil {
+			return AccountPolicy{}, err
+		} else if pp.IsActivated(height) {
+			return pp.Policy(), nil
+		}
+	}
+
+	switch st, found, err := getState(StateKeyAccountPolicy(a)); {
+	case err != nil:
+		return AccountPolicy{}, err
+	case !found || st.Value() == nil:
+		return AccountPolicy{}, nil
+	default:
+		return StateAccountPolicyValue(st)
+	}
+}
+
+// checkFeeSpendingLimits checks the fee, which is paid by holder, by the
+// spending limits of holder like the other outflow.
+func checkFeeSpendingLimits(
+	holder base.Address,
+	height base.Height,
+	cid CurrencyID,
+	fee Big,
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	return checkSpendingLimits(holder, height, map[CurrencyID][2]Big{cid: {fee, fee}}, getState)
+}
+
+// checkSpendingLimits checks the outflow of holder by the AccountPolicy. The
+// outflow of each currency is the first of required, which includes fee. The
+// updated AccountSpent states are returned.
+func checkSpendingLimits(
+	holder base.Address,
+	height base.Height,
+	required map[CurrencyID][2]Big,
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	var po AccountPolicy
+	switch i, err := loadAccountPolicy(holder, height, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case i.IsEmpty():
+		return nil, nil
+	default:
+		po = i
+	}
+
+	var sts []state.State // nolint:prealloc
+	for cid := range required {
+		limit, found := po.Limit(cid)
+		if !found {
+			continue
+		}
+
+		var st state.State
+		spent := NewAccountSpent(cid, nil)
+		switch i, found, err := getState(StateKeyAccountSpent(holder, cid)); {
+		case err != nil:
+			return nil, err
+		case !found || i.Value() == nil:
+			st = i
+		default:
+			if j, err := StateAccountSpentValue(i); err != nil {
+				return nil, operation.NewBaseReasonErrorFromError(err)
+			} else {
+				st = i
+				spent = j
+			}
+		}
+
+		out := required[cid][0]
+		if sum := spent.Sum(height, po.Window()); sum.Add(out).Compare(limit) > 0 {
+			return nil, operation.NewBaseReasonError(
+				"over spending limit of %s, %q; spent=%v + outflow=%v > limit=%v", holder, cid, sum, out, limit)
+		}
+
+		if nst, err := SetStateAccountSpentValue(st, spent.Add(height, po.Window(), out)); err != nil {
+			return nil, err
+		} else {
+			sts = append(sts, nst)
+		}
+	}
+
+	return sts, nil
+}
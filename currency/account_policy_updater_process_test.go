@@ -0,0 +1,539 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAccountPolicyOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAccountPolicyOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(AccountPolicyUpdater{}, NewAccountPolicyUpdaterProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(CreateAccounts{}, NewCreateAccountsProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(MultiTransfers{}, NewMultiTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(TransferFrom{}, NewTransferFromProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(CreateClaimableBalance{}, NewCreateClaimableBalanceProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Approve{}, NewApproveProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(AccountMerge{}, NewAccountMergeProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(AliasRegister{}, NewAliasRegisterProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAccountPolicyOperations) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAccountPolicyOperations) newPolicyState(a base.Address, po AccountPolicy) state.State {
+	st, err := state.NewStateV0(StateKeyAccountPolicy(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAccountPolicyValue(st, po)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAccountPolicyOperations) newSpentState(a base.Address, spent AccountSpent) state.State {
+	st, err := state.NewStateV0(StateKeyAccountSpent(a, spent.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAccountSpentValue(st, spent)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAccountPolicyOperations) newTransfers(sender, receiver base.Address, big Big, pks []key.Privatekey) Transfers {
+	fact := NewTransfersFact(util.UUID().Bytes(), sender, []TransfersItem{
+		NewTransfersItemSingleAmount(receiver, NewAmount(big, t.cid)),
+	})
+
+	op, err := NewTransfers(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountPolicyOperations) spent(pool *storage.Statepool, a base.Address) (AccountSpent, bool) {
+	for _, stu := range pool.Updates() {
+		if stu.Key() != StateKeyAccountSpent(a, t.cid) {
+			continue
+		}
+
+		spent, err := StateAccountSpentValue(stu.GetState())
+		t.NoError(err)
+
+		return spent, true
+	}
+
+	return AccountSpent{}, false
+}
+
+func (t *testAccountPolicyOperations) TestSetPolicy() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), sa.Address, po, t.cid)
+	op, err := NewAccountPolicyUpdater(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	var found bool
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+		switch {
+		case IsStateAccountPolicyKey(st.Key()):
+			upo, err := StateAccountPolicyValue(st)
+			t.NoError(err)
+			t.True(po.Hash().Equal(upo.Hash()))
+
+			found = true
+		case IsStateBalanceKey(st.Key()):
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			t.True(NewBig(32).Equal(am.Big()))
+		}
+	}
+
+	t.True(found)
+}
+
+func (t *testAccountPolicyOperations) TestSetPolicyUnknownCurrency() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), CurrencyID("FINDME"))})
+	fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), sa.Address, po, t.cid)
+	op, err := NewAccountPolicyUpdater(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "currency not registered")
+}
+
+func (t *testAccountPolicyOperations) TestTransfersUnderLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newTransfers(sa.Address, ra.Address, NewBig(19), sa.Privs())))
+
+	spent, found := t.spent(pool, sa.Address)
+	t.True(found)
+	t.Equal(1, len(spent.Records()))
+	t.Equal(pool.Height(), spent.Records()[0].Height())
+	t.True(NewBig(20).Equal(spent.Records()[0].Big()))
+}
+
+func (t *testAccountPolicyOperations) TestTransfersOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransfers(sa.Address, ra.Address, NewBig(20), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "over spending limit")
+	t.Empty(pool.Updates())
+}
+
+func (t *testAccountPolicyOperations) TestTransfersRollingWindow() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str)
+
+	window := base.Height(10)
+	po := NewAccountPolicy(window, []Amount{NewAmount(NewBig(20), t.cid)})
+
+	// NOTE 15 is out of window, 5 is in window
+	spent := NewAccountSpent(t.cid, []SpentRecord{
+		NewSpentRecord(pool.Height()-window, NewBig(15)),
+		NewSpentRecord(pool.Height()-window+1, NewBig(5)),
+	})
+
+	pool, _ = t.statepool(sta, str, []state.State{
+		t.newPolicyState(sa.Address, po),
+		t.newSpentState(sa.Address, spent),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransfers(sa.Address, ra.Address, NewBig(16), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "over spending limit")
+
+	t.NoError(opr.Process(t.newTransfers(sa.Address, ra.Address, NewBig(15), sa.Privs())))
+
+	uspent, found := t.spent(pool, sa.Address)
+	t.True(found)
+	t.Equal(2, len(uspent.Records()))
+	t.True(NewBig(20).Equal(uspent.Sum(pool.Height(), window)))
+}
+
+func (t *testAccountPolicyOperations) TestCreateAccountsOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewCreateAccountsFact(util.UUID().Bytes(), sa.Address, []CreateAccountsItem{
+		NewCreateAccountsItemSingleAmount(na.Keys(), NewAmount(NewBig(21), t.cid)),
+	})
+	op, err := NewCreateAccounts(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "over spending limit")
+}
+
+func (t *testAccountPolicyOperations) overLimit(err error) {
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "over spending limit")
+}
+
+func (t *testAccountPolicyOperations) TestMultiTransfersOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewMultiTransfersFact(util.UUID().Bytes(),
+		[]MultiTransfersSender{NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(21), t.cid)})},
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(21), t.cid))},
+	)
+	op, err := NewMultiTransfers(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.overLimit(opr.Process(op))
+}
+
+func (t *testAccountPolicyOperations) TestTransferFromOverLimitOfOwner() {
+	oa, sto := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	ast, err := state.NewStateV0(StateKeyAllowance(oa.Address, sa.Address, t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	aw, err := SetStateAllowanceValue(ast, NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(30), t.cid)))
+	t.NoError(err)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sto, sts, str, []state.State{aw, t.newPolicyState(oa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewTransferFromFact(util.UUID().Bytes(), sa.Address, oa.Address, ra.Address,
+		[]Amount{NewAmount(NewBig(21), t.cid)})
+	op, err := NewTransferFrom(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.overLimit(opr.Process(op))
+}
+
+func (t *testAccountPolicyOperations) TestCreateClaimableBalanceOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewCreateClaimableBalanceFact(util.UUID().Bytes(), sa.Address, na.Keys(),
+		[]Amount{NewAmount(NewBig(21), t.cid)}, pool.Height()+10)
+	op, err := NewCreateClaimableBalance(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.overLimit(opr.Process(op))
+}
+
+func (t *testAccountPolicyOperations) TestApproveOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	pa, stp := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	spent := NewAccountSpent(t.cid, nil).Add(base.NilHeight, po.Window(), NewBig(20))
+	pool, _ := t.statepool(sta, stp, []state.State{
+		t.newPolicyState(sa.Address, po),
+		t.newSpentState(sa.Address, spent),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), pa.Address, NewFixedFeeer(pa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewApproveFact(util.UUID().Bytes(), sa.Address, pa.Address, []Amount{NewAmount(NewBig(10), t.cid)})
+	op, err := NewApprove(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.overLimit(opr.Process(op))
+}
+
+func (t *testAccountPolicyOperations) TestAccountMergeOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewAccountMergeFact(util.UUID().Bytes(), sa.Address, ra.Address)
+	op, err := NewAccountMerge(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.overLimit(opr.Process(op))
+}
+
+func (t *testAccountPolicyOperations) newAliasRegister(sender base.Address, pks []key.Privatekey) AliasRegister {
+	fact := NewAliasRegisterFact(util.UUID().Bytes(), sender, AliasName("showme"), t.cid)
+
+	op, err := NewAliasRegister(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountPolicyOperations) TestFeeUnderLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, NewBig(5)))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newAliasRegister(sa.Address, sa.Privs())))
+
+	spent, found := t.spent(pool, sa.Address)
+	t.True(found)
+	t.Equal(1, len(spent.Records()))
+	t.True(NewBig(5).Equal(spent.Records()[0].Big()))
+}
+
+func (t *testAccountPolicyOperations) TestFeeOverLimit() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewFixedFeeer(ra.Address, NewBig(21)))))
+
+	opr := t.processor(cp, pool)
+
+	t.overLimit(opr.Process(t.newAliasRegister(sa.Address, sa.Privs())))
+	t.Empty(pool.Updates())
+}
+
+func (t *testAccountPolicyOperations) updatedPolicies(pool *storage.Statepool, a base.Address) (
+	AccountPolicy, PendingAccountPolicy,
+) {
+	var po AccountPolicy
+	var pp PendingAccountPolicy
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+		switch st.Key() {
+		case StateKeyAccountPolicy(a):
+			i, err := StateAccountPolicyValue(st)
+			t.NoError(err)
+			po = i
+		case StateKeyPendingAccountPolicy(a):
+			i, err := StatePendingAccountPolicyValue(st)
+			t.NoError(err)
+			pp = i
+		}
+	}
+
+	return po, pp
+}
+
+func (t *testAccountPolicyOperations) TestRelaxPolicyDelayed() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	window := base.Height(10)
+	po := NewAccountPolicy(window, []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npo := NewAccountPolicy(window, []Amount{NewAmount(NewBig(30), t.cid)})
+	fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), sa.Address, npo, t.cid)
+	op, err := NewAccountPolicyUpdater(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	upo, upp := t.updatedPolicies(pool, sa.Address)
+	t.True(po.Hash().Equal(upo.Hash()))
+	t.True(npo.Hash().Equal(upp.Policy().Hash()))
+	t.Equal(pool.Height()+window, upp.Height())
+	t.False(upp.IsActivated(pool.Height()))
+
+	// NOTE the relaxed limit is not yet applied
+	t.overLimit(opr.Process(t.newTransfers(sa.Address, ra.Address, NewBig(21), sa.Privs())))
+
+	getState := func(key string) (state.State, bool, error) {
+		for _, stu := range pool.Updates() {
+			if stu.Key() == key {
+				return stu.GetState(), true, nil
+			}
+		}
+
+		return nil, false, nil
+	}
+
+	i, err := loadAccountPolicy(sa.Address, upp.Height()-1, getState)
+	t.NoError(err)
+	t.True(po.Hash().Equal(i.Hash()))
+
+	i, err = loadAccountPolicy(sa.Address, upp.Height(), getState)
+	t.NoError(err)
+	t.True(npo.Hash().Equal(i.Hash()))
+}
+
+func (t *testAccountPolicyOperations) TestTightenPolicyImmediately() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	window := base.Height(10)
+	po := NewAccountPolicy(window, []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sta, str, []state.State{t.newPolicyState(sa.Address, po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ra.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npo := NewAccountPolicy(window, []Amount{NewAmount(NewBig(10), t.cid)})
+	fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), sa.Address, npo, t.cid)
+	op, err := NewAccountPolicyUpdater(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	upo, upp := t.updatedPolicies(pool, sa.Address)
+	t.True(npo.Hash().Equal(upo.Hash()))
+	t.True(upp.IsActivated(pool.Height()))
+
+	t.overLimit(opr.Process(t.newTransfers(sa.Address, ra.Address, NewBig(11), sa.Privs())))
+}
+
+func TestAccountPolicyOperations(t *testing.T) {
+	suite.Run(t, new(testAccountPolicyOperations))
+}
@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testAccountPolicyUpdater struct {
+	baseTest
+}
+
+func (t *testAccountPolicyUpdater) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+	fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), NewTestAddress(), po, t.cid)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewAccountPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testAccountPolicyUpdater) TestInvalidPolicy() {
+	t.NoError(NewAccountPolicy(base.Height(0), nil).IsValid(nil))
+
+	err := NewAccountPolicy(base.Height(0), []Amount{NewAmount(NewBig(20), t.cid)}).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "invalid window")
+
+	err = NewAccountPolicy(base.Height(10), []Amount{NewAmount(ZeroBig, t.cid)}).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "limit should be over zero")
+
+	err = NewAccountPolicy(base.Height(10), []Amount{
+		NewAmount(NewBig(20), t.cid),
+		NewAmount(NewBig(30), t.cid),
+	}).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated currency of limit found")
+}
+
+func (t *testAccountPolicyUpdater) TestSpent() {
+	window := base.Height(10)
+
+	spent := NewAccountSpent(t.cid, nil)
+	spent = spent.Add(base.Height(1), window, NewBig(3))
+	spent = spent.Add(base.Height(1), window, NewBig(4))
+	spent = spent.Add(base.Height(5), window, NewBig(5))
+
+	t.Equal(2, len(spent.Records()))
+	t.True(NewBig(12).Equal(spent.Sum(base.Height(10), window)))
+	t.True(NewBig(5).Equal(spent.Sum(base.Height(11), window)))
+
+	// NOTE the records out of window are removed
+	spent = spent.Add(base.Height(15), window, NewBig(6))
+	t.Equal(1, len(spent.Records()))
+	t.True(NewBig(6).Equal(spent.Sum(base.Height(15), window)))
+}
+
+func (t *testAccountPolicyUpdater) TestIsRelaxedBy() {
+	po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), t.cid)})
+
+	t.False(AccountPolicy{}.IsRelaxedBy(po))
+	t.False(po.IsRelaxedBy(NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(19), t.cid)})))
+	t.False(po.IsRelaxedBy(NewAccountPolicy(base.Height(11), []Amount{NewAmount(NewBig(20), t.cid)})))
+	t.False(po.IsRelaxedBy(NewAccountPolicy(base.Height(10), []Amount{
+		NewAmount(NewBig(20), t.cid),
+		NewAmount(NewBig(20), CurrencyID("FINDME")),
+	})))
+
+	t.True(po.IsRelaxedBy(AccountPolicy{}))
+	t.True(po.IsRelaxedBy(NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(21), t.cid)})))
+	t.True(po.IsRelaxedBy(NewAccountPolicy(base.Height(9), []Amount{NewAmount(NewBig(20), t.cid)})))
+	t.True(po.IsRelaxedBy(NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), CurrencyID("FINDME"))})))
+}
+
+func TestAccountPolicyUpdater(t *testing.T) {
+	suite.Run(t, new(testAccountPolicyUpdater))
+}
+
+func testAccountPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), CurrencyID("SHOWME"))})
+		fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), NewTestAddress(), po, CurrencyID("SHOWME"))
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewAccountPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(AccountPolicyUpdater)
+		tb := b.(AccountPolicyUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(AccountPolicyUpdaterFact)
+		ufact := tb.Fact().(AccountPolicyUpdaterFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.policy.Hash().Equal(ufact.policy.Hash()))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestAccountPolicyUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountPolicyUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountPolicyUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountPolicyUpdaterEncode(bsonenc.NewEncoder()))
+}
+
+func testPendingAccountPolicyEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		po := NewAccountPolicy(base.Height(10), []Amount{NewAmount(NewBig(20), CurrencyID("SHOWME"))})
+
+		return NewPendingAccountPolicy(po, base.Height(33))
+	}
+
+	t.compare = func(a, b interface{}) {
+		pa := a.(PendingAccountPolicy)
+		pb := b.(PendingAccountPolicy)
+
+		t.Equal(pa.Height(), pb.Height())
+		t.True(pa.Policy().Hash().Equal(pb.Policy().Hash()))
+		t.True(pa.Hash().Equal(pb.Hash()))
+	}
+
+	return t
+}
+
+func TestPendingAccountPolicyEncodeJSON(t *testing.T) {
+	suite.Run(t, testPendingAccountPolicyEncode(jsonenc.NewEncoder()))
+}
+
+func TestPendingAccountPolicyEncodeBSON(t *testing.T) {
+	suite.Run(t, testPendingAccountPolicyEncode(bsonenc.NewEncoder()))
+}
@@ -1,25 +1,51 @@
 package currency
 
 import (
+	"encoding/hex"
 	"strings"
 
 	"golang.org/x/xerrors"
 
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/logging"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 var (
 	AddressType = hint.MustNewType(0xa0, 0x00, "mitum-currency-address")
 	AddressHint = hint.MustHint(AddressType, "0.0.1")
+	// AddressChecksumHint is the hint of the checksummed address string,
+	// "<address>~<checksum>". The checksum is the first 4 bytes of sha256 of
+	// address in hex.
+	AddressChecksumHint = hint.MustHint(AddressType, "0.0.2")
+	// AddressChecksumHinter decodes the checksummed address string into
+	// ChecksumAddress.
+	AddressChecksumHinter = ChecksumAddress{}
 )
 
-var EmptyAddress = Address("")
+var (
+	EmptyAddress             = Address("")
+	AddressChecksumSeparator = "~"
+	AddressChecksumLength    = 8
+)
 
+// Address is the address of account. The checksummed address is checked and
+// converted to the plain address by NewAddress, so both point to the same
+// account.
 type Address string
 
 func NewAddress(name string) (Address, error) {
+	if i := strings.LastIndex(name, AddressChecksumSeparator); i >= 0 {
+		ca := Address(name[:i])
+		if err := ca.isValidChecksum(name[i+len(AddressChecksumSeparator):]); err != nil {
+			return EmptyAddress, err
+		}
+
+		return ca, ca.IsValid(nil)
+	}
+
 	ca := Address(name)
 
 	return ca, ca.IsValid(nil)
@@ -33,6 +59,21 @@ func NewAddressFromKeys(keys Keys) (Address, error) {
 	return NewAddress(keys.Hash().String())
 }
 
+// NewAddressFromKeysWithSalt derives the address from keys and salt, so the
+// same keys can own the several accounts by the different salts. With empty
+// salt, it is same with NewAddressFromKeys.
+func NewAddressFromKeysWithSalt(keys Keys, salt string) (Address, error) {
+	if len(salt) < 1 {
+		return NewAddressFromKeys(keys)
+	}
+
+	if err := keys.IsValid(nil); err != nil {
+		return EmptyAddress, err
+	}
+
+	return NewAddress(valuehash.NewSHA256(util.ConcatBytesSlice(keys.Hash().Bytes(), []byte(salt))).String())
+}
+
 func (ca Address) Raw() string {
 	return string(ca)
 }
@@ -41,6 +82,11 @@ func (ca Address) String() string {
 	return hint.HintedString(ca.Hint(), string(ca))
 }
 
+// ChecksumString returns the hinted string of the checksummed address.
+func (ca Address) ChecksumString() string {
+	return hint.HintedString(AddressChecksumHint, string(ca)+AddressChecksumSeparator+ca.checksum())
+}
+
 func (ca Address) Hint() hint.Hint {
 	return AddressHint
 }
@@ -50,15 +96,38 @@ func (ca Address) IsValid([]byte) error {
 		return xerrors.Errorf("empty address")
 	}
 
+	if strings.Contains(string(ca), AddressChecksumSeparator) {
+		return xerrors.Errorf("address can not have checksum separator, %q", AddressChecksumSeparator)
+	}
+
+	return nil
+}
+
+func (ca Address) checksum() string {
+	return hex.EncodeToString(valuehash.NewSHA256([]byte(ca)).Bytes()[:AddressChecksumLength/2])
+}
+
+func (ca Address) isValidChecksum(c string) error {
+	if len(c) != AddressChecksumLength {
+		return xerrors.Errorf("invalid length of address checksum, %d != %d", len(c), AddressChecksumLength)
+	}
+
+	if c != ca.checksum() {
+		return xerrors.Errorf("wrong address checksum, %q", c)
+	}
+
 	return nil
 }
 
 func (ca Address) Equal(a base.Address) bool {
-	if ca.Hint().Type() != a.Hint().Type() {
+	switch t := a.(type) {
+	case Address:
+		return ca == t
+	case ChecksumAddress:
+		return ca == t.Address
+	default:
 		return false
 	}
-
-	return ca == a.(Address)
 }
 
 func (ca Address) Bytes() []byte {
@@ -83,6 +152,25 @@ func (ca Address) MarshalLog(key string, e logging.Emitter, _ bool) logging.Emit
 	return e.Str(key, ca.String())
 }
 
+// ChecksumAddress is the address decoded from the checksummed address string.
+// Except the hint, it is same with the plain Address; it is encoded and
+// compared as the plain address, so both point to the same account.
+type ChecksumAddress struct {
+	Address
+}
+
+func (ca ChecksumAddress) Hint() hint.Hint {
+	return AddressChecksumHint
+}
+
+func (ca *ChecksumAddress) UnmarshalText(b []byte) error {
+	if !strings.Contains(string(b), AddressChecksumSeparator) {
+		return xerrors.Errorf("address checksum not found, %q", string(b))
+	}
+
+	return ca.Address.UnmarshalText(b)
+}
+
 type Addresses interface {
 	Addresses() ([]base.Address, error)
 }
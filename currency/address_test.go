@@ -1,11 +1,16 @@
 package currency
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util/encoder"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
@@ -91,6 +96,102 @@ func (t *testAddress) TestMultipleKeyOrder() {
 	t.Equal(a, b)
 }
 
+func (t *testAddress) newAddress() Address {
+	keys, err := NewKeys([]Key{t.newKey(100)}, 100)
+	t.NoError(err)
+
+	a, err := NewAddressFromKeys(keys)
+	t.NoError(err)
+
+	return a
+}
+
+func (t *testAddress) TestChecksum() {
+	a := t.newAddress()
+
+	h, raw, err := hint.ParseHintedString(a.ChecksumString())
+	t.NoError(err)
+	t.True(h.Equal(AddressChecksumHint))
+	t.True(strings.HasPrefix(raw, a.Raw()+AddressChecksumSeparator))
+
+	b, err := NewAddress(raw)
+	t.NoError(err)
+	t.True(a.Equal(b))
+	t.Equal(a.String(), b.String())
+
+	var c Address
+	t.NoError(c.UnmarshalText([]byte(raw)))
+	t.True(a.Equal(c))
+
+	var d ChecksumAddress
+	t.NoError(d.UnmarshalText([]byte(raw)))
+	t.True(a.Equal(d))
+	t.True(d.Hint().Equal(AddressChecksumHint))
+
+	// NOTE ChecksumAddress should have checksum
+	err = d.UnmarshalText([]byte(a.Raw()))
+	t.Contains(err.Error(), "address checksum not found")
+}
+
+func (t *testAddress) TestPlainStillValid() {
+	a := t.newAddress()
+
+	b, err := NewAddress(a.Raw())
+	t.NoError(err)
+	t.True(a.Equal(b))
+	t.True(b.Hint().Equal(AddressHint))
+}
+
+func (t *testAddress) TestWrongChecksum() {
+	a := t.newAddress()
+
+	_, raw, err := hint.ParseHintedString(a.ChecksumString())
+	t.NoError(err)
+
+	// NOTE typo in address
+	typo := []byte(raw)
+	if typo[0] == 'a' {
+		typo[0] = 'b'
+	} else {
+		typo[0] = 'a'
+	}
+
+	_, err = NewAddress(string(typo))
+	t.Contains(err.Error(), "wrong address checksum")
+
+	var c Address
+	err = c.UnmarshalText(typo)
+	t.Contains(err.Error(), "wrong address checksum")
+
+	_, err = NewAddress(raw[:len(raw)-1])
+	t.Contains(err.Error(), "invalid length of address checksum")
+
+	_, err = NewAddress(a.Raw() + AddressChecksumSeparator)
+	t.Contains(err.Error(), "invalid length of address checksum")
+}
+
+func (t *testAddress) TestDecodeChecksum() {
+	enc := jsonenc.NewEncoder()
+	encs := encoder.NewEncoders()
+	t.NoError(encs.AddEncoder(enc))
+	t.NoError(encs.AddHinter(Address("")))
+	t.NoError(encs.AddHinter(AddressChecksumHinter))
+
+	a := t.newAddress()
+
+	b, err := base.DecodeAddressFromString(enc, a.ChecksumString())
+	t.NoError(err)
+	t.IsType(ChecksumAddress{}, b)
+	t.True(a.Equal(b))
+	t.True(b.Equal(a))
+	t.Equal(a.String(), b.String())
+	t.Equal(StateKeyAccount(a), StateKeyAccount(b))
+
+	c, err := base.DecodeAddressFromString(enc, a.String())
+	t.NoError(err)
+	t.True(a.Equal(c))
+}
+
 func TestAddress(t *testing.T) {
 	suite.Run(t, new(testAddress))
 }
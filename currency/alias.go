@@ -0,0 +1,80 @@
+package currency
+
+import (
+	"regexp"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AliasType = hint.MustNewType(0xa0, 0x5e, "mitum-currency-alias")
+	AliasHint = hint.MustHint(AliasType, "0.0.1")
+)
+
+var (
+	MinLengthAliasName int = 3
+	MaxLengthAliasName int = 32
+	ReValidAliasName       = regexp.MustCompile(`^[a-z][a-z0-9_\-]*[a-z0-9]$`)
+)
+
+// AliasName is the human-readable name of account. AliasName starts with the
+// lower case alphabet, so it can not be confused with address.
+type AliasName string
+
+func (an AliasName) Bytes() []byte {
+	return []byte(an)
+}
+
+func (an AliasName) String() string {
+	return string(an)
+}
+
+func (an AliasName) IsValid([]byte) error {
+	if l := len(an); l < MinLengthAliasName || l > MaxLengthAliasName {
+		return xerrors.Errorf("invalid length of alias, %d <= %d <= %d", MinLengthAliasName, l, MaxLengthAliasName)
+	} else if !ReValidAliasName.Match([]byte(an)) {
+		return xerrors.Errorf("wrong alias, %q", an)
+	}
+
+	return nil
+}
+
+// Alias binds AliasName to address.
+type Alias struct {
+	name    AliasName
+	address base.Address
+}
+
+func NewAlias(name AliasName, address base.Address) Alias {
+	return Alias{name: name, address: address}
+}
+
+func (al Alias) Hint() hint.Hint {
+	return AliasHint
+}
+
+func (al Alias) Bytes() []byte {
+	return util.ConcatBytesSlice(al.name.Bytes(), al.address.Bytes())
+}
+
+func (al Alias) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(al.Bytes())
+}
+
+func (al Alias) IsValid([]byte) error {
+	return isvalid.Check([]isvalid.IsValider{al.name, al.address}, nil, false)
+}
+
+func (al Alias) Name() AliasName {
+	return al.name
+}
+
+func (al Alias) Address() base.Address {
+	return al.address
+}
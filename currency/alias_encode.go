@@ -0,0 +1,18 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (al *Alias) unpack(enc encoder.Encoder, name string, bAddress base.AddressDecoder) error {
+	if a, err := bAddress.Encode(enc); err != nil {
+		return err
+	} else {
+		al.address = a
+	}
+
+	al.name = AliasName(name)
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AliasRegisterFactType = hint.MustNewType(0xa0, 0x5f, "mitum-currency-alias-register-operation-fact")
+	AliasRegisterFactHint = hint.MustHint(AliasRegisterFactType, "0.0.1")
+	AliasRegisterType     = hint.MustNewType(0xa0, 0x60, "mitum-currency-alias-register-operation")
+	AliasRegisterHint     = hint.MustHint(AliasRegisterType, "0.0.1")
+)
+
+var (
+	AliasRegisterFactExpiringHint   = hint.MustHint(AliasRegisterFactType, "0.0.2")
+	AliasRegisterFactExpiringHinter = AliasRegisterFact{expiringFact: expiringFact{ht: AliasRegisterFactExpiringHint}}
+)
+
+type AliasRegisterFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	name     AliasName
+	currency CurrencyID
+}
+
+func NewAliasRegisterFact(token []byte, sender base.Address, name AliasName, currency CurrencyID) AliasRegisterFact {
+	fact := AliasRegisterFact{
+		token:    token,
+		sender:   sender,
+		name:     name,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AliasRegisterFact) WithExpiry(ex Expiry) AliasRegisterFact {
+	fact.expiringFact = newExpiringFact(AliasRegisterFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AliasRegisterFact) Hint() hint.Hint {
+	return fact.factHint(AliasRegisterFactHint)
+}
+
+func (fact AliasRegisterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AliasRegisterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AliasRegisterFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.name.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact AliasRegisterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for AliasRegisterFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.name,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact AliasRegisterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AliasRegisterFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AliasRegisterFact) Name() AliasName {
+	return fact.name
+}
+
+func (fact AliasRegisterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact AliasRegisterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type AliasRegister struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewAliasRegister(fact AliasRegisterFact, fs []operation.FactSign, memo string) (AliasRegister, error) {
+	if bo, err := operation.NewBaseOperationFromFact(AliasRegisterHint, fact, fs); err != nil {
+		return AliasRegister{}, err
+	} else {
+		op := AliasRegister{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op AliasRegister) Hint() hint.Hint {
+	return AliasRegisterHint
+}
+
+func (op AliasRegister) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op AliasRegister) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op AliasRegister) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,35 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *AliasRegisterFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	name string,
+	cr string,
+	ex *Expiry,
+) error {
+	var sender base.Address
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		sender = a
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, AliasRegisterFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.name = AliasName(name)
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
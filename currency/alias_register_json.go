@@ -0,0 +1,83 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AliasRegisterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	NM AliasName      `json:"name"`
+	CR CurrencyID     `json:"currency"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact AliasRegisterFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(AliasRegisterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		NM:         fact.name,
+		CR:         fact.currency,
+		EX:         ex,
+	})
+}
+
+type AliasRegisterFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	NM string              `json:"name"`
+	CR string              `json:"currency"`
+	EX *Expiry             `json:"expiry,omitempty"`
+}
+
+func (fact *AliasRegisterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact AliasRegisterFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, ufact.NM, ufact.CR, ufact.EX)
+}
+
+func (op AliasRegister) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *AliasRegister) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = AliasRegister{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op AliasRegister) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AliasRegisterProcessor struct {
+	cp *CurrencyPool
+	AliasRegister
+	sa     state.State
+	sb     AmountState
+	fee    Big
+	height base.Height
+	ss     []state.State
+}
+
+func NewAliasRegisterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(AliasRegister); !ok {
+			return nil, xerrors.Errorf("not AliasRegister, %T", op)
+		} else {
+			return &AliasRegisterProcessor{
+				cp:            cp,
+				AliasRegister: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *AliasRegisterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *AliasRegisterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *AliasRegisterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AliasRegisterFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	switch st, _, found, err := loadAlias(fact.name, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case found:
+		return nil, operation.NewBaseReasonError("alias, %q already registered", fact.name)
+	default:
+		opp.sa = st
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.sender, fact.currency), "balance of sender", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.sender, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *AliasRegisterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AliasRegisterFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateAliasValue(opp.sa, NewAlias(fact.name, fact.sender)); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), append([]state.State{st, opp.sb}, opp.ss...)...)
+	}
+}
+
+// loadAlias returns the alias state. If not yet registered or already
+// released, the bool return value is false.
+func loadAlias(
+	name AliasName,
+	getState func(key string) (state.State, bool, error),
+) (state.State, Alias, bool, error) {
+	switch st, found, err := getState(StateKeyAlias(name)); {
+	case err != nil:
+		return nil, Alias{}, false, err
+	case !found || st.Value() == nil:
+		return st, Alias{}, false, nil
+	default:
+		if al, found, err := StateAliasValue(st); err != nil {
+			return nil, Alias{}, false, err
+		} else {
+			return st, al, found, nil
+		}
+	}
+}
+
+// loadOwnedAlias returns the alias state, which is owned by sender.
+func loadOwnedAlias(
+	name AliasName,
+	sender base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	switch st, al, found, err := loadAlias(name, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case !found:
+		return nil, operation.NewBaseReasonError("alias, %q not registered", name)
+	case !al.Address().Equal(sender):
+		return nil, operation.NewBaseReasonError("alias, %q not owned by sender", name)
+	default:
+		return st, nil
+	}
+}
@@ -0,0 +1,308 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAliasOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAliasOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(AliasRegister{}, NewAliasRegisterProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(AliasTransfer{}, NewAliasTransferProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(AliasRelease{}, NewAliasReleaseProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAliasOperations) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAliasOperations) newAliasState(al Alias) state.State {
+	st, err := state.NewStateV0(StateKeyAlias(al.Name()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAliasValue(st, al)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAliasOperations) newRegister(sender *account, name AliasName) AliasRegister {
+	fact := NewAliasRegisterFact(util.UUID().Bytes(), sender.Address, name, t.cid)
+	op, err := NewAliasRegister(fact, t.factSigns(fact, sender.Privs()), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAliasOperations) updatedAlias(pool *storage.Statepool, name AliasName) (Alias, bool) {
+	for _, stu := range pool.Updates() {
+		if stu.Key() != StateKeyAlias(name) {
+			continue
+		}
+
+		al, found, err := StateAliasValue(stu.GetState())
+		t.NoError(err)
+
+		return al, found
+	}
+
+	panic("alias state not updated")
+}
+
+func (t *testAliasOperations) TestRegister() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	fee := NewBig(3)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	name := AliasName("showme")
+	t.NoError(opr.Process(t.newRegister(sa, name)))
+
+	al, found := t.updatedAlias(pool, name)
+	t.True(found)
+	t.Equal(name, al.Name())
+	t.True(sa.Address.Equal(al.Address()))
+
+	for _, stu := range pool.Updates() {
+		if stu.Key() == StateKeyBalance(sa.Address, t.cid) {
+			am, err := StateBalanceValue(stu.GetState())
+			t.NoError(err)
+			t.True(NewBig(30).Equal(am.Big()))
+		}
+	}
+}
+
+func (t *testAliasOperations) TestRegisterAlreadyRegistered() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	oa, sto := t.newAccount(true, nil)
+
+	name := AliasName("showme")
+	pool, _ := t.statepool(sta, sto, []state.State{t.newAliasState(NewAlias(name, oa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRegister(sa, name))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already registered")
+}
+
+func (t *testAliasOperations) TestRegisterInsufficientFee() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(2), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, NewBig(3)))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRegister(sa, AliasName("showme")))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance with fee")
+}
+
+func (t *testAliasOperations) TestRegisterSameAliasInProposal() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	oa, sto := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta, sto)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	name := AliasName("showme")
+	t.NoError(opr.Process(t.newRegister(sa, name)))
+
+	err := opr.Process(t.newRegister(oa, name))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already processed")
+}
+
+func (t *testAliasOperations) TestTransfer() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	name := AliasName("showme")
+	pool, _ := t.statepool(sta, str, []state.State{t.newAliasState(NewAlias(name, sa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewAliasTransferFact(util.UUID().Bytes(), sa.Address, name, ra.Address, t.cid)
+	op, err := NewAliasTransfer(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	al, found := t.updatedAlias(pool, name)
+	t.True(found)
+	t.True(ra.Address.Equal(al.Address()))
+}
+
+func (t *testAliasOperations) TestTransferNotOwned() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	oa, sto := t.newAccount(true, nil)
+
+	name := AliasName("showme")
+	pool, _ := t.statepool(sta, sto, []state.State{t.newAliasState(NewAlias(name, oa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewAliasTransferFact(util.UUID().Bytes(), sa.Address, name, oa.Address, t.cid)
+	op, err := NewAliasTransfer(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not owned by sender")
+}
+
+func (t *testAliasOperations) TestTransferUnknownReceiver() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, _ := t.newAccount(false, nil)
+
+	name := AliasName("showme")
+	pool, _ := t.statepool(sta, []state.State{t.newAliasState(NewAlias(name, sa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewAliasTransferFact(util.UUID().Bytes(), sa.Address, name, ra.Address, t.cid)
+	op, err := NewAliasTransfer(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "does not exist")
+}
+
+func (t *testAliasOperations) TestRelease() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	name := AliasName("showme")
+	pool, _ := t.statepool(sta, []state.State{t.newAliasState(NewAlias(name, sa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewAliasReleaseFact(util.UUID().Bytes(), sa.Address, name, t.cid)
+	op, err := NewAliasRelease(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	_, found := t.updatedAlias(pool, name)
+	t.False(found)
+}
+
+func (t *testAliasOperations) TestRegisterReleased() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	name := AliasName("showme")
+
+	st, err := state.NewStateV0(StateKeyAlias(name), nil, base.NilHeight)
+	t.NoError(err)
+	released, err := ClearStateAliasValue(st)
+	t.NoError(err)
+
+	pool, _ := t.statepool(sta, []state.State{released})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRegister(sa, name)))
+
+	al, found := t.updatedAlias(pool, name)
+	t.True(found)
+	t.True(sa.Address.Equal(al.Address()))
+}
+
+func (t *testAliasOperations) TestReleaseNotRegistered() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewAliasReleaseFact(util.UUID().Bytes(), sa.Address, AliasName("showme"), t.cid)
+	op, err := NewAliasRelease(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not registered")
+}
+
+func TestAliasOperations(t *testing.T) {
+	suite.Run(t, new(testAliasOperations))
+}
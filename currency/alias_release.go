@@ -0,0 +1,175 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AliasReleaseFactType = hint.MustNewType(0xa0, 0x63, "mitum-currency-alias-release-operation-fact")
+	AliasReleaseFactHint = hint.MustHint(AliasReleaseFactType, "0.0.1")
+	AliasReleaseType     = hint.MustNewType(0xa0, 0x64, "mitum-currency-alias-release-operation")
+	AliasReleaseHint     = hint.MustHint(AliasReleaseType, "0.0.1")
+)
+
+var (
+	AliasReleaseFactExpiringHint   = hint.MustHint(AliasReleaseFactType, "0.0.2")
+	AliasReleaseFactExpiringHinter = AliasReleaseFact{expiringFact: expiringFact{ht: AliasReleaseFactExpiringHint}}
+)
+
+type AliasReleaseFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	name     AliasName
+	currency CurrencyID
+}
+
+func NewAliasReleaseFact(token []byte, sender base.Address, name AliasName, currency CurrencyID) AliasReleaseFact {
+	fact := AliasReleaseFact{
+		token:    token,
+		sender:   sender,
+		name:     name,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AliasReleaseFact) WithExpiry(ex Expiry) AliasReleaseFact {
+	fact.expiringFact = newExpiringFact(AliasReleaseFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AliasReleaseFact) Hint() hint.Hint {
+	return fact.factHint(AliasReleaseFactHint)
+}
+
+func (fact AliasReleaseFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AliasReleaseFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AliasReleaseFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.name.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact AliasReleaseFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for AliasReleaseFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.name,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact AliasReleaseFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AliasReleaseFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AliasReleaseFact) Name() AliasName {
+	return fact.name
+}
+
+func (fact AliasReleaseFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact AliasReleaseFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type AliasRelease struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewAliasRelease(fact AliasReleaseFact, fs []operation.FactSign, memo string) (AliasRelease, error) {
+	if bo, err := operation.NewBaseOperationFromFact(AliasReleaseHint, fact, fs); err != nil {
+		return AliasRelease{}, err
+	} else {
+		op := AliasRelease{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op AliasRelease) Hint() hint.Hint {
+	return AliasReleaseHint
+}
+
+func (op AliasRelease) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op AliasRelease) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op AliasRelease) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,75 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact AliasReleaseFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"hash":     fact.h,
+		"token":    fact.token,
+		"sender":   fact.sender,
+		"name":     fact.name,
+		"currency": fact.currency,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
+}
+
+type AliasReleaseFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	NM string              `bson:"name"`
+	CR string              `bson:"currency"`
+	EX *Expiry             `bson:"expiry,omitempty"`
+}
+
+func (fact *AliasReleaseFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact AliasReleaseFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, ufact.NM, ufact.CR, ufact.EX)
+}
+
+func (op AliasRelease) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *AliasRelease) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = AliasRelease{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
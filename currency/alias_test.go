@@ -0,0 +1,114 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testAlias struct {
+	baseTest
+}
+
+func (t *testAlias) TestAliasName() {
+	t.NoError(AliasName("showme").IsValid(nil))
+	t.NoError(AliasName("show-me_0").IsValid(nil))
+
+	for _, s := range []string{"sh", "ShowMe", "0showme", "showme-", "show:me", "@showme"} {
+		t.Error(AliasName(s).IsValid(nil), s)
+	}
+}
+
+func (t *testAlias) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	fact := NewAliasRegisterFact(util.UUID().Bytes(), NewTestAddress(), AliasName("showme"), t.cid)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewAliasRegister(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testAlias) TestInvalidName() {
+	fact := NewAliasRegisterFact(util.UUID().Bytes(), NewTestAddress(), AliasName("Show Me"), t.cid)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "wrong alias")
+}
+
+func (t *testAlias) TestTransferToSender() {
+	sender := NewTestAddress()
+	fact := NewAliasTransferFact(util.UUID().Bytes(), sender, AliasName("showme"), sender, t.cid)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func TestAlias(t *testing.T) {
+	suite.Run(t, new(testAlias))
+}
+
+func testAliasOperationsEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		fact := NewAliasTransferFact(
+			util.UUID().Bytes(),
+			NewTestAddress(),
+			AliasName("showme"),
+			NewTestAddress(),
+			CurrencyID("SHOWME"),
+		)
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewAliasTransfer(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(AliasTransfer)
+		tb := b.(AliasTransfer)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(AliasTransferFact)
+		ufact := tb.Fact().(AliasTransferFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.name, ufact.name)
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestAliasTransferEncodeJSON(t *testing.T) {
+	suite.Run(t, testAliasOperationsEncode(jsonenc.NewEncoder()))
+}
+
+func TestAliasTransferEncodeBSON(t *testing.T) {
+	suite.Run(t, testAliasOperationsEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,193 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AliasTransferFactType = hint.MustNewType(0xa0, 0x61, "mitum-currency-alias-transfer-operation-fact")
+	AliasTransferFactHint = hint.MustHint(AliasTransferFactType, "0.0.1")
+	AliasTransferType     = hint.MustNewType(0xa0, 0x62, "mitum-currency-alias-transfer-operation")
+	AliasTransferHint     = hint.MustHint(AliasTransferType, "0.0.1")
+)
+
+var (
+	AliasTransferFactExpiringHint   = hint.MustHint(AliasTransferFactType, "0.0.2")
+	AliasTransferFactExpiringHinter = AliasTransferFact{expiringFact: expiringFact{ht: AliasTransferFactExpiringHint}}
+)
+
+type AliasTransferFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	name     AliasName
+	receiver base.Address
+	currency CurrencyID
+}
+
+func NewAliasTransferFact(
+	token []byte,
+	sender base.Address,
+	name AliasName,
+	receiver base.Address,
+	currency CurrencyID,
+) AliasTransferFact {
+	fact := AliasTransferFact{
+		token:    token,
+		sender:   sender,
+		name:     name,
+		receiver: receiver,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AliasTransferFact) WithExpiry(ex Expiry) AliasTransferFact {
+	fact.expiringFact = newExpiringFact(AliasTransferFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AliasTransferFact) Hint() hint.Hint {
+	return fact.factHint(AliasTransferFactHint)
+}
+
+func (fact AliasTransferFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AliasTransferFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AliasTransferFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.name.Bytes(),
+		fact.receiver.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact AliasTransferFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for AliasTransferFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.name,
+		fact.receiver,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return xerrors.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact AliasTransferFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AliasTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AliasTransferFact) Name() AliasName {
+	return fact.name
+}
+
+func (fact AliasTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact AliasTransferFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact AliasTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type AliasTransfer struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewAliasTransfer(fact AliasTransferFact, fs []operation.FactSign, memo string) (AliasTransfer, error) {
+	if bo, err := operation.NewBaseOperationFromFact(AliasTransferHint, fact, fs); err != nil {
+		return AliasTransfer{}, err
+	} else {
+		op := AliasTransfer{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op AliasTransfer) Hint() hint.Hint {
+	return AliasTransferHint
+}
+
+func (op AliasTransfer) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op AliasTransfer) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op AliasTransfer) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
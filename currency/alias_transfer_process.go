@@ -0,0 +1,110 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op AliasTransfer) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AliasTransferProcessor struct {
+	cp *CurrencyPool
+	AliasTransfer
+	sa     state.State
+	sb     AmountState
+	fee    Big
+	height base.Height
+	ss     []state.State
+}
+
+func NewAliasTransferProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(AliasTransfer); !ok {
+			return nil, xerrors.Errorf("not AliasTransfer, %T", op)
+		} else {
+			return &AliasTransferProcessor{
+				cp:            cp,
+				AliasTransfer: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *AliasTransferProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *AliasTransferProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *AliasTransferProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AliasTransferFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := loadOwnedAlias(fact.name, fact.sender, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+	}
+
+	if err := checkExistsState(StateKeyAccount(fact.receiver), getState); err != nil {
+		return nil, err
+	} else if err := checkNotClosedAccount(fact.receiver, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.sender, fact.currency), "balance of sender", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.sender, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *AliasTransferProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AliasTransferFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateAliasValue(opp.sa, NewAlias(fact.name, fact.receiver)); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), append([]state.State{st, opp.sb}, opp.ss...)...)
+	}
+}
@@ -0,0 +1,86 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AllowanceType = hint.MustNewType(0xa0, 0x44, "mitum-currency-allowance")
+	AllowanceHint = hint.MustHint(AllowanceType, "0.0.1")
+)
+
+// Allowance is the amount, which spender can withdraw from the balance of
+// owner by TransferFrom.
+type Allowance struct {
+	owner   base.Address
+	spender base.Address
+	amount  Amount
+}
+
+func NewAllowance(owner, spender base.Address, amount Amount) Allowance {
+	return Allowance{
+		owner:   owner,
+		spender: spender,
+		amount:  amount,
+	}
+}
+
+func (aw Allowance) Hint() hint.Hint {
+	return AllowanceHint
+}
+
+func (aw Allowance) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		aw.owner.Bytes(),
+		aw.spender.Bytes(),
+		aw.amount.Bytes(),
+	)
+}
+
+func (aw Allowance) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(aw.Bytes())
+}
+
+func (aw Allowance) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		aw.owner,
+		aw.spender,
+		aw.amount,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid Allowance: %w", err)
+	}
+
+	if aw.owner.Equal(aw.spender) {
+		return xerrors.Errorf("spender is same with owner, %q", aw.owner)
+	}
+
+	if !aw.amount.Big().OverNil() {
+		return xerrors.Errorf("allowance should not be under zero")
+	}
+
+	return nil
+}
+
+func (aw Allowance) Owner() base.Address {
+	return aw.owner
+}
+
+func (aw Allowance) Spender() base.Address {
+	return aw.spender
+}
+
+func (aw Allowance) Amount() Amount {
+	return aw.amount
+}
+
+func (aw Allowance) WithBig(big Big) Allowance {
+	aw.amount = aw.amount.WithBig(big)
+
+	return aw
+}
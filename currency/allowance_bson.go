@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (aw Allowance) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(aw.Hint()),
+			bson.M{
+				"owner":   aw.owner,
+				"spender": aw.spender,
+				"amount":  aw.amount,
+			}))
+}
+
+type AllowanceBSONUnpacker struct {
+	OW base.AddressDecoder `bson:"owner"`
+	SP base.AddressDecoder `bson:"spender"`
+	AM bson.Raw            `bson:"amount"`
+}
+
+func (aw *Allowance) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uaw AllowanceBSONUnpacker
+	if err := enc.Unmarshal(b, &uaw); err != nil {
+		return err
+	}
+
+	return aw.unpack(enc, uaw.OW, uaw.SP, uaw.AM)
+}
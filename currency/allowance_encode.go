@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (aw *Allowance) unpack(
+	enc encoder.Encoder,
+	bOwner base.AddressDecoder,
+	bSpender base.AddressDecoder,
+	bam []byte,
+) error {
+	if a, err := bOwner.Encode(enc); err != nil {
+		return err
+	} else {
+		aw.owner = a
+	}
+
+	if a, err := bSpender.Encode(enc); err != nil {
+		return err
+	} else {
+		aw.spender = a
+	}
+
+	if am, err := DecodeAmount(enc, bam); err != nil {
+		return err
+	} else {
+		aw.amount = am
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AllowanceJSONPacker struct {
+	jsonenc.HintedHead
+	OW base.Address `json:"owner"`
+	SP base.Address `json:"spender"`
+	AM Amount       `json:"amount"`
+}
+
+func (aw Allowance) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AllowanceJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(aw.Hint()),
+		OW:         aw.owner,
+		SP:         aw.spender,
+		AM:         aw.amount,
+	})
+}
+
+type AllowanceJSONUnpacker struct {
+	OW base.AddressDecoder `json:"owner"`
+	SP base.AddressDecoder `json:"spender"`
+	AM json.RawMessage     `json:"amount"`
+}
+
+func (aw *Allowance) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uaw AllowanceJSONUnpacker
+	if err := enc.Unmarshal(b, &uaw); err != nil {
+		return err
+	}
+
+	return aw.unpack(enc, uaw.OW, uaw.SP, uaw.AM)
+}
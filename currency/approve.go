@@ -0,0 +1,201 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ApproveFactType = hint.MustNewType(0xa0, 0x45, "mitum-currency-approve-operation-fact")
+	ApproveFactHint = hint.MustHint(ApproveFactType, "0.0.1")
+	ApproveType     = hint.MustNewType(0xa0, 0x46, "mitum-currency-approve-operation")
+	ApproveHint     = hint.MustHint(ApproveType, "0.0.1")
+)
+
+var (
+	ApproveFactExpiringHint   = hint.MustHint(ApproveFactType, "0.0.2")
+	ApproveFactExpiringHinter = ApproveFact{expiringFact: expiringFact{ht: ApproveFactExpiringHint}}
+)
+
+// ApproveFact sets the allowances of spender over the balances of owner. The
+// previous allowance of same currency is replaced; zero amount revokes it.
+type ApproveFact struct {
+	expiringFact
+	h       valuehash.Hash
+	token   []byte
+	owner   base.Address
+	spender base.Address
+	amounts []Amount
+}
+
+func NewApproveFact(token []byte, owner, spender base.Address, amounts []Amount) ApproveFact {
+	fact := ApproveFact{
+		token:   token,
+		owner:   owner,
+		spender: spender,
+		amounts: amounts,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ApproveFact) WithExpiry(ex Expiry) ApproveFact {
+	fact.expiringFact = newExpiringFact(ApproveFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ApproveFact) Hint() hint.Hint {
+	return fact.factHint(ApproveFactHint)
+}
+
+func (fact ApproveFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ApproveFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	bs := make([][]byte, len(fact.amounts)+3)
+	bs[0] = fact.token
+	bs[1] = fact.owner.Bytes()
+	bs[2] = fact.spender.Bytes()
+
+	for i := range fact.amounts {
+		bs[i+3] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(append(bs, ext)...)
+}
+
+func (fact ApproveFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ApproveFact")
+	} else if n := len(fact.amounts); n < 1 {
+		return xerrors.Errorf("empty amounts")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.owner,
+		fact.spender,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.owner.Equal(fact.spender) {
+		return xerrors.Errorf("spender is same with owner, %q", fact.owner)
+	}
+
+	founds := map[CurrencyID]struct{}{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return xerrors.Errorf("duplicated currency found, %q", am.Currency())
+		} else {
+			founds[am.Currency()] = struct{}{}
+		}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverNil() {
+			return xerrors.Errorf("allowance should not be under zero")
+		}
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ApproveFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact ApproveFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact ApproveFact) Amounts() []Amount {
+	return fact.amounts
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.owner, fact.spender}, nil
+}
+
+type Approve struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewApprove(fact ApproveFact, fs []operation.FactSign, memo string) (Approve, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ApproveHint, fact, fs); err != nil {
+		return Approve{}, err
+	} else {
+		op := Approve{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op Approve) Hint() hint.Hint {
+	return ApproveHint
+}
+
+func (op Approve) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op Approve) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op Approve) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,90 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ApproveFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	OW base.Address   `json:"owner"`
+	SP base.Address   `json:"spender"`
+	AM []Amount       `json:"amounts"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact ApproveFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(ApproveFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		OW:         fact.owner,
+		SP:         fact.spender,
+		AM:         fact.amounts,
+		EX:         ex,
+	})
+}
+
+type ApproveFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	OW base.AddressDecoder `json:"owner"`
+	SP base.AddressDecoder `json:"spender"`
+	AM []json.RawMessage   `json:"amounts"`
+	EX *Expiry             `json:"expiry,omitempty"`
+}
+
+func (fact *ApproveFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact ApproveFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ufact.AM))
+	for i := range ufact.AM {
+		ams[i] = ufact.AM[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.OW, ufact.SP, ams, ufact.EX)
+}
+
+func (op Approve) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *Approve) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = Approve{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op Approve) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ApproveProcessor struct {
+	cp *CurrencyPool
+	Approve
+	sb       map[CurrencyID]AmountState
+	aw       map[CurrencyID]state.State
+	required map[CurrencyID][2]Big
+	height   base.Height
+	ss       []state.State
+}
+
+func NewApproveProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(Approve); !ok {
+			return nil, xerrors.Errorf("not Approve, %T", op)
+		} else {
+			return &ApproveProcessor{
+				cp:      cp,
+				Approve: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ApproveProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *ApproveProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ApproveProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ApproveFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.owner), getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.spender), "spender", getState); err != nil {
+		return nil, err
+	}
+
+	required := map[CurrencyID][2]Big{}
+	aw := map[CurrencyID]state.State{}
+	for i := range fact.amounts {
+		cid := fact.amounts[i].Currency()
+
+		fee := ZeroBig
+		if opp.cp != nil {
+			if feeer, found := opp.cp.Feeer(cid); !found {
+				return nil, operation.NewBaseReasonError("currency not registered, %q", cid)
+			} else if j, err := feeer.Fee(ZeroBig); err != nil {
+				return nil, operation.NewBaseReasonErrorFromError(err)
+			} else {
+				fee = j
+			}
+		}
+
+		required[cid] = [2]Big{fee, fee}
+
+		if st, _, err := getState(StateKeyAllowance(fact.owner, fact.spender, cid)); err != nil {
+			return nil, err
+		} else {
+			aw[cid] = st
+		}
+	}
+
+	if sb, err := CheckEnoughBalance(opp.cp, fact.owner, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = sb
+	}
+
+	// NOTE the approved amounts are not spent yet; they are checked by the
+	// spending limits of owner in TransferFrom.
+	if ss, err := checkSpendingLimits(fact.owner, opp.height, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	if err := checkFactSignsByState(fact.owner, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.aw = aw
+	opp.required = required
+
+	return opp, nil
+}
+
+func (opp *ApproveProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ApproveFact)
+
+	sts := make([]state.State, len(fact.amounts)*2, len(fact.amounts)*2+len(opp.ss))
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+
+		if st, err := SetStateAllowanceValue(
+			opp.aw[am.Currency()],
+			NewAllowance(fact.owner, fact.spender, am),
+		); err != nil {
+			return operation.NewBaseReasonError("failed to set allowance: %w", err)
+		} else {
+			sts[i*2] = st
+		}
+
+		rq := opp.required[am.Currency()]
+		sts[i*2+1] = opp.sb[am.Currency()].Sub(rq[0]).AddFee(rq[1])
+	}
+
+	sts = append(sts, opp.ss...)
+
+	return setState(fact.Hash(), sts...)
+}
@@ -0,0 +1,234 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	BatchFactType = hint.MustNewType(0xa0, 0x4d, "mitum-currency-batch-operation-fact")
+	BatchFactHint = hint.MustHint(BatchFactType, "0.0.1")
+	BatchType     = hint.MustNewType(0xa0, 0x4e, "mitum-currency-batch-operation")
+	BatchHint     = hint.MustHint(BatchType, "0.0.1")
+)
+
+var (
+	BatchFactExpiringHint   = hint.MustHint(BatchFactType, "0.0.2")
+	BatchFactExpiringHinter = BatchFact{expiringFact: expiringFact{ht: BatchFactExpiringHint}}
+)
+
+var MaxBatchOperations uint = 10
+
+// BatchFact wraps the currency operations, which should be processed
+// together; if one of them fails, none of them is applied. The operations keep
+// their own signatures, so the signer of Batch does not need to be one of
+// them.
+type BatchFact struct {
+	expiringFact
+	h     valuehash.Hash
+	token []byte
+	ops   []operation.Operation
+}
+
+func NewBatchFact(token []byte, ops []operation.Operation) BatchFact {
+	fact := BatchFact{
+		token: token,
+		ops:   ops,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact BatchFact) WithExpiry(ex Expiry) BatchFact {
+	fact.expiringFact = newExpiringFact(BatchFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact BatchFact) Hint() hint.Hint {
+	return fact.factHint(BatchFactHint)
+}
+
+func (fact BatchFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact BatchFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BatchFact) Token() []byte {
+	return fact.token
+}
+
+func (fact BatchFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	bs := make([][]byte, len(fact.ops)+1)
+	bs[0] = fact.token
+
+	for i := range fact.ops {
+		bs[i+1] = fact.ops[i].Hash().Bytes()
+	}
+
+	return util.ConcatBytesSlice(append(bs, ext)...)
+}
+
+func (fact BatchFact) IsValid(b []byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for BatchFact")
+	} else if n := len(fact.ops); n < 1 {
+		return xerrors.Errorf("empty operations")
+	} else if n > int(MaxBatchOperations) {
+		return xerrors.Errorf("operations, %d over max, %d", n, MaxBatchOperations)
+	}
+
+	if err := fact.h.IsValid(nil); err != nil {
+		return err
+	}
+
+	foundFacts := map[string]struct{}{}
+	for i := range fact.ops {
+		op := fact.ops[i]
+		if !IsBatchableOperation(op) {
+			return xerrors.Errorf("not batchable operation, %T", op)
+		}
+
+		if err := op.IsValid(b); err != nil {
+			return xerrors.Errorf("invalid operation found: %w", err)
+		}
+
+		k := op.Fact().Hash().String()
+		if _, found := foundFacts[k]; found {
+			return xerrors.Errorf("duplicated operation found, %s", op.Fact().Hash())
+		}
+
+		foundFacts[k] = struct{}{}
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact BatchFact) Operations() []operation.Operation {
+	return fact.ops
+}
+
+func (fact BatchFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+	for i := range fact.ops {
+		if i, ok := fact.ops[i].Fact().(interface {
+			Addresses() ([]base.Address, error)
+		}); ok {
+			if j, err := i.Addresses(); err != nil {
+				return nil, err
+			} else {
+				as = append(as, j...)
+			}
+		}
+	}
+
+	return as, nil
+}
+
+// IsBatchableOperation checks the operation can be included in Batch. The
+// operations, which are signed by nodes, like CurrencyRegister, can not be
+// batched.
+func IsBatchableOperation(op operation.Operation) bool {
+	switch op.(type) {
+	case Transfers,
+		CreateAccounts,
+		KeyUpdater,
+		CreateClaimableBalance,
+		ClaimBalance,
+		ReclaimBalance,
+		Approve,
+		TransferFrom,
+		MultiTransfers,
+		AccountMerge,
+		GuardiansUpdater,
+		KeyRecovery,
+		KeyRecoveryCanceler,
+		AccountPolicyUpdater,
+		AliasRegister,
+		AliasTransfer,
+		AliasRelease,
+		AccountDataUpdater:
+		return true
+	default:
+		return false
+	}
+}
+
+type Batch struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewBatch(
+	fact BatchFact,
+	fs []operation.FactSign,
+	memo string,
+) (Batch, error) {
+	if bo, err := operation.NewBaseOperationFromFact(BatchHint, fact, fs); err != nil {
+		return Batch{}, err
+	} else {
+		op := Batch{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op Batch) Hint() hint.Hint {
+	return BatchHint
+}
+
+func (op Batch) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op Batch) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op Batch) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,75 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact BatchFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"hash":       fact.h,
+		"token":      fact.token,
+		"operations": fact.ops,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
+}
+
+type BatchFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	OP []bson.Raw      `bson:"operations"`
+	EX *Expiry         `bson:"expiry,omitempty"`
+}
+
+func (fact *BatchFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact BatchFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ops := make([][]byte, len(ufact.OP))
+	for i := range ufact.OP {
+		ops[i] = ufact.OP[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ops, ufact.EX)
+}
+
+func (op Batch) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *Batch) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = Batch{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
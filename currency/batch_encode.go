@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *BatchFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bops [][]byte,
+	ex *Expiry,
+) error {
+	ops := make([]operation.Operation, len(bops))
+	for i := range bops {
+		if hinter, err := enc.DecodeByHint(bops[i]); err != nil {
+			return err
+		} else if j, ok := hinter.(operation.Operation); !ok {
+			return xerrors.Errorf("not Operation: %T", hinter)
+		} else {
+			ops[i] = j
+		}
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, BatchFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.ops = ops
+
+	return nil
+}
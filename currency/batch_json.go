@@ -0,0 +1,83 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type BatchFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash        `json:"hash"`
+	TK []byte                `json:"token"`
+	OP []operation.Operation `json:"operations"`
+	EX *Expiry               `json:"expiry,omitempty"`
+}
+
+func (fact BatchFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(BatchFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		OP:         fact.ops,
+		EX:         ex,
+	})
+}
+
+type BatchFactJSONUnpacker struct {
+	H  valuehash.Bytes   `json:"hash"`
+	TK []byte            `json:"token"`
+	OP []json.RawMessage `json:"operations"`
+	EX *Expiry           `json:"expiry,omitempty"`
+}
+
+func (fact *BatchFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact BatchFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ops := make([][]byte, len(ufact.OP))
+	for i := range ufact.OP {
+		ops[i] = ufact.OP[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ops, ufact.EX)
+}
+
+func (op Batch) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *Batch) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = Batch{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op Batch) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type BatchProcessor struct {
+	cp *CurrencyPool
+	Batch
+	height          base.Height
+	getNewProcessor func(state.Processor) (state.Processor, bool, error)
+	sts             []state.State
+}
+
+func NewBatchProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(Batch); !ok {
+			return nil, xerrors.Errorf("not Batch, %T", op)
+		} else {
+			return &BatchProcessor{
+				cp:     cp,
+				Batch:  i,
+				height: base.NilHeight,
+			}, nil
+		}
+	}
+}
+
+func (opp *BatchProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *BatchProcessor) setProposalHeight(h base.Height) {
+	opp.height = h
+}
+
+func (opp *BatchProcessor) setSubProcessors(f func(state.Processor) (state.Processor, bool, error)) {
+	opp.getNewProcessor = f
+}
+
+// PreProcess processes the operations of Batch in order against the scratch
+// state view; the states are not set to the proposal Statepool until Process.
+func (opp *BatchProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if opp.getNewProcessor == nil {
+		return nil, xerrors.Errorf("sub processors of Batch not set")
+	}
+
+	fact := opp.Fact().(BatchFact)
+
+	bp := newBatchStatepool(getState)
+	for i := range fact.ops {
+		op, ok := fact.ops[i].(state.Processor)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not state.Processor in batch, %T", fact.ops[i])
+		}
+
+		var sp state.Processor
+		switch j, known, err := opp.getNewProcessor(op); {
+		case err != nil:
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		case !known:
+			return nil, operation.NewBaseReasonError("unknown operation in batch, %T", op)
+		default:
+			sp = j
+		}
+
+		if j, ok := sp.(proposalHeightSetter); ok {
+			j.setProposalHeight(opp.height)
+		}
+
+		if j, err := sp.(state.PreProcessor).PreProcess(bp.get, bp.set); err != nil {
+			return nil, operation.NewBaseReasonError("failed to preprocess %d operation in batch: %w", i, err)
+		} else if err := j.Process(bp.get, bp.set); err != nil {
+			return nil, operation.NewBaseReasonError("failed to process %d operation in batch: %w", i, err)
+		}
+	}
+
+	opp.sts = bp.updates()
+
+	return opp, nil
+}
+
+func (opp *BatchProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	return setState(opp.Fact().Hash(), opp.sts...)
+}
+
+// batchStatepool is the scratch state view of Batch. The states set by the
+// operations in Batch are kept here and the next operations read them instead
+// of the states of the proposal Statepool. OperationProcessor also keeps the
+// states of the processed operations of proposal with it.
+type batchStatepool struct {
+	getState func(string) (state.State, bool, error)
+	cached   map[string]state.State
+	updated  map[string]state.State
+	keys     []string
+}
+
+func newBatchStatepool(getState func(string) (state.State, bool, error)) *batchStatepool {
+	return &batchStatepool{
+		getState: getState,
+		cached:   map[string]state.State{},
+		updated:  map[string]state.State{},
+	}
+}
+
+func (bp *batchStatepool) get(key string) (state.State, bool, error) {
+	if st, found := bp.cached[key]; found {
+		return st, true, nil
+	}
+
+	return bp.getState(key)
+}
+
+func (bp *batchStatepool) set(_ valuehash.Hash, sts ...state.State) error {
+	for i := range sts {
+		st := sts[i]
+		k := st.Key()
+
+		prev, found := bp.updated[k]
+		if !found {
+			bp.keys = append(bp.keys, k)
+		}
+
+		t, ok := st.(AmountState)
+		if !ok {
+			bp.updated[k] = st
+			bp.cached[k] = st
+
+			continue
+		}
+
+		// NOTE AmountState has the difference of balance; the differences are
+		// summed up for the proposal Statepool, and the merged balance is
+		// cached for the next operations.
+		if found {
+			if p, ok := prev.(AmountState); ok {
+				bp.updated[k] = p.Add(t.add).AddFee(t.fee)
+			} else {
+				return xerrors.Errorf("not AmountState, %T for %q", prev, k)
+			}
+		} else {
+			bp.updated[k] = t
+		}
+
+		// NOTE the delta is merged to the cached balance, which already has
+		// the deltas set before.
+		base := t.Clear()
+		if c, found := bp.cached[k]; found {
+			base = c
+		}
+
+		if merged, err := t.Merge(base); err != nil {
+			return err
+		} else {
+			bp.cached[k] = merged.Clear()
+		}
+	}
+
+	return nil
+}
+
+func (bp *batchStatepool) updates() []state.State {
+	sts := make([]state.State, len(bp.keys))
+	for i := range bp.keys {
+		sts[i] = bp.updated[bp.keys[i]]
+	}
+
+	return sts
+}
@@ -0,0 +1,311 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testBatchOperation struct {
+	baseTestOperationProcessor
+}
+
+func (t *testBatchOperation) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Batch{}, NewBatchProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(CreateAccounts{}, NewCreateAccountsProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(KeyUpdater{}, NewKeyUpdaterProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testBatchOperation) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testBatchOperation) newBatch(ops []operation.Operation, pks []key.Privatekey) Batch {
+	fact := NewBatchFact(util.UUID().Bytes(), ops)
+
+	op, err := NewBatch(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testBatchOperation) newCreateAccount(sender base.Address, keys Keys, am Amount, pks []key.Privatekey) CreateAccounts {
+	fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{
+		NewCreateAccountsItemSingleAmount(keys, am),
+	})
+
+	op, err := NewCreateAccounts(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testBatchOperation) newKeyUpdater(target base.Address, keys Keys, pks []key.Privatekey) KeyUpdater {
+	fact := NewKeyUpdaterFact(util.UUID().Bytes(), target, keys, t.cid)
+
+	op, err := NewKeyUpdater(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testBatchOperation) newTransfers(sender, receiver base.Address, am Amount, pks []key.Privatekey) Transfers {
+	fact := NewTransfersFact(util.UUID().Bytes(), sender, []TransfersItem{
+		NewTransfersItemSingleAmount(receiver, am),
+	})
+
+	op, err := NewTransfers(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testBatchOperation) TestNew() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	nb := t.baseTest.newAccount()
+	nc := t.baseTest.newAccount()
+
+	pool, _ := t.statepool(sta)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE create new account, update it's keys and transfer from it with the
+	// updated keys.
+	ops := []operation.Operation{
+		t.newCreateAccount(sa.Address, nb.Keys(), NewAmount(NewBig(10), t.cid), sa.Privs()),
+		t.newKeyUpdater(nb.Address, nc.Keys(), nb.Privs()),
+		t.newTransfers(nb.Address, sa.Address, NewAmount(NewBig(3), t.cid), nc.Privs()),
+	}
+
+	op := t.newBatch(ops, sa.Privs())
+	t.NoError(opr.Process(op))
+
+	var nkeys Keys
+	balances := map[string]Amount{}
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+
+		switch {
+		case IsStateBalanceKey(st.Key()):
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			balances[st.Key()] = am
+		case st.Key() == StateKeyAccount(nb.Address):
+			ac, err := LoadStateAccountValue(st)
+			t.NoError(err)
+			nkeys = ac.Keys()
+		}
+	}
+
+	t.True(NewBig(100 - 10 - 1 + 3).Equal(balances[StateKeyBalance(sa.Address, t.cid)].Big()))
+	t.True(NewBig(10 - 1 - 3 - 1).Equal(balances[StateKeyBalance(nb.Address, t.cid)].Big()))
+	t.True(nc.Keys().Equal(nkeys))
+
+	added := pool.AddedOperations()
+	t.Equal(len(ops), len(added))
+	for i := range ops {
+		_, found := added[ops[i].Fact().Hash().String()]
+		t.True(found)
+	}
+}
+
+func (t *testBatchOperation) TestFailedOperation() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	nb := t.baseTest.newAccount()
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	ops := []operation.Operation{
+		t.newCreateAccount(sa.Address, nb.Keys(), NewAmount(NewBig(10), t.cid), sa.Privs()),
+		t.newTransfers(nb.Address, sa.Address, NewAmount(NewBig(11), t.cid), nb.Privs()),
+	}
+
+	err := opr.Process(t.newBatch(ops, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "failed to preprocess 1 operation in batch")
+	t.Contains(err.Error(), "insufficient balance")
+
+	t.Empty(pool.Updates())
+	t.Empty(pool.AddedOperations())
+}
+
+func (t *testBatchOperation) TestSenderInProposal() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+
+	pool, _ := t.statepool(sta, stb)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newTransfers(sb.Address, sa.Address, NewAmount(NewBig(1), t.cid), sb.Privs())))
+
+	ops := []operation.Operation{
+		t.newTransfers(sa.Address, sb.Address, NewAmount(NewBig(3), t.cid), sa.Privs()),
+		t.newTransfers(sb.Address, sa.Address, NewAmount(NewBig(3), t.cid), sb.Privs()),
+	}
+
+	t.NoError(opr.Process(t.newBatch(ops, sa.Privs())))
+
+	var nsa, nsb Amount
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			nsa, _ = StateBalanceValue(st.GetState())
+		} else if st.Key() == StateKeyBalance(sb.Address, t.cid) {
+			nsb, _ = StateBalanceValue(st.GetState())
+		}
+	}
+
+	t.Equal(NewBig(101), nsa.Big())
+	t.Equal(NewBig(99), nsb.Big())
+}
+
+func (t *testBatchOperation) TestKnownOperationInBatch() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+
+	pool, _ := t.statepool(sta, stb)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	known := t.newTransfers(sa.Address, sb.Address, NewAmount(NewBig(3), t.cid), sa.Privs())
+
+	copr := t.processor(cp, nil).(*OperationProcessor)
+	copr.SetHasOperationFact(func(h valuehash.Hash) (bool, error) {
+		return h.Equal(known.Fact().Hash()), nil
+	})
+
+	opr := copr.New(pool)
+
+	ops := []operation.Operation{
+		t.newTransfers(sb.Address, sa.Address, NewAmount(NewBig(1), t.cid), sb.Privs()),
+		known,
+	}
+
+	_, err := opr.PreProcess(t.newBatch(ops, sb.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "known operation")
+}
+
+func (t *testBatchOperation) TestProcessedOperationInProposal() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+
+	pool, _ := t.statepool(sta, stb)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	standalone := t.newTransfers(sa.Address, sb.Address, NewAmount(NewBig(3), t.cid), sa.Privs())
+	t.NoError(opr.Process(standalone))
+
+	// NOTE same operation in Batch is rejected.
+	err := opr.Process(t.newBatch([]operation.Operation{standalone}, sb.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already processed in proposal")
+
+	// NOTE the operation in Batch can not be processed again by itself.
+	inner := t.newTransfers(sb.Address, sa.Address, NewAmount(NewBig(1), t.cid), sb.Privs())
+	t.NoError(opr.Process(t.newBatch([]operation.Operation{inner}, sb.Privs())))
+
+	err = opr.Process(inner)
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already processed in proposal")
+}
+
+func (t *testBatchOperation) TestStatepoolSameKeyInOneSet() {
+	bst := t.newStateBalance(NewTestAddress(), NewBig(10), t.cid)
+
+	bp := newBatchStatepool(func(key string) (state.State, bool, error) {
+		if key == bst.Key() {
+			return bst, true, nil
+		}
+
+		return nil, false, nil
+	})
+
+	st, _, err := bp.get(bst.Key())
+	t.NoError(err)
+
+	t.NoError(bp.set(nil,
+		NewAmountState(st, t.cid).Add(NewBig(3)),
+		NewAmountState(st, t.cid).Sub(NewBig(2)),
+	))
+
+	cached, _, err := bp.get(bst.Key())
+	t.NoError(err)
+
+	am, err := StateBalanceValue(cached)
+	t.NoError(err)
+	t.True(NewBig(11).Equal(am.Big()))
+
+	t.NoError(bp.set(nil, NewAmountState(cached, t.cid).Add(NewBig(4))))
+
+	cached, _, err = bp.get(bst.Key())
+	t.NoError(err)
+
+	am, err = StateBalanceValue(cached)
+	t.NoError(err)
+	t.True(NewBig(15).Equal(am.Big()))
+
+	ups := bp.updates()
+	t.Equal(1, len(ups))
+	t.True(NewBig(5).Equal(ups[0].(AmountState).add))
+}
+
+func TestBatchOperation(t *testing.T) {
+	suite.Run(t, new(testBatchOperation))
+}
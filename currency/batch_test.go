@@ -0,0 +1,146 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testBatch struct {
+	baseTest
+}
+
+func (t *testBatch) newTransfers(pk key.Privatekey) Transfers {
+	fact := NewTransfersFact(util.UUID().Bytes(), NewTestAddress(), []TransfersItem{
+		NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(3), t.cid)),
+	})
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testBatch) newBatch(ops []operation.Operation) Batch {
+	pk := key.MustNewBTCPrivatekey()
+
+	fact := NewBatchFact(util.UUID().Bytes(), ops)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewBatch(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testBatch) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	op := t.newBatch([]operation.Operation{t.newTransfers(pk), t.newTransfers(pk)})
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testBatch) TestEmptyOperations() {
+	op := t.newBatch(nil)
+
+	err := op.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "empty operations")
+}
+
+func (t *testBatch) TestDuplicatedOperation() {
+	tf := t.newTransfers(key.MustNewBTCPrivatekey())
+
+	op := t.newBatch([]operation.Operation{tf, tf})
+
+	err := op.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated operation found")
+}
+
+func (t *testBatch) TestNestedBatch() {
+	pk := key.MustNewBTCPrivatekey()
+
+	nested := t.newBatch([]operation.Operation{t.newTransfers(pk)})
+	op := t.newBatch([]operation.Operation{nested})
+
+	err := op.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "not batchable operation")
+}
+
+func TestBatch(t *testing.T) {
+	suite.Run(t, new(testBatch))
+}
+
+func testBatchEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		var ops []operation.Operation
+		for i := 0; i < 2; i++ {
+			fact := NewTransfersFact(util.UUID().Bytes(), NewTestAddress(), []TransfersItem{
+				NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(3), CurrencyID("SEEME"))),
+			})
+			sig, err := operation.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			tf, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+			t.NoError(err)
+
+			ops = append(ops, tf)
+		}
+
+		fact := NewBatchFact(util.UUID().Bytes(), ops)
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewBatch(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Batch)
+		tb := b.(Batch)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(BatchFact)
+		ufact := tb.Fact().(BatchFact)
+
+		t.Equal(len(fact.ops), len(ufact.ops))
+		for i := range fact.ops {
+			t.True(fact.ops[i].Hash().Equal(ufact.ops[i].Hash()))
+			t.True(fact.ops[i].Fact().Hash().Equal(ufact.ops[i].Fact().Hash()))
+		}
+	}
+
+	return t
+}
+
+func TestBatchEncodeJSON(t *testing.T) {
+	suite.Run(t, testBatchEncode(jsonenc.NewEncoder()))
+}
+
+func TestBatchEncodeBSON(t *testing.T) {
+	suite.Run(t, testBatchEncode(bsonenc.NewEncoder()))
+}
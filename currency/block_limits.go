@@ -0,0 +1,75 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+)
+
+// BlockLimits is the capacity of block. MaxOperations limits the number of
+// operations and MaxItems limits the number of items in block; zero means no
+// limit. The operations in Batch are counted by themselves. BlockLimits is
+// applied when the proposal is made, see ProposalDatabase, so the operations
+// over it wait for the next proposal instead of being rejected.
+type BlockLimits struct {
+	MaxOperations uint
+	MaxItems      uint
+}
+
+// DefaultBlockCapacity is the number of items of block, which is used to
+// measure the utilization of block without BlockLimits.
+var DefaultBlockCapacity uint = 100
+
+func (bl BlockLimits) IsEmpty() bool {
+	return bl.MaxOperations < 1 && bl.MaxItems < 1
+}
+
+// Capacity returns the number of items, which block can have; without
+// MaxItems, MaxOperations is used, because one operation has one item at least.
+func (bl BlockLimits) Capacity() uint {
+	switch {
+	case bl.MaxItems > 0:
+		return bl.MaxItems
+	case bl.MaxOperations > 0:
+		return bl.MaxOperations
+	default:
+		return DefaultBlockCapacity
+	}
+}
+
+// fits counts the operations and items of fact and checks they are under the
+// BlockLimits. The fact over the BlockLimits is not counted.
+func (bl BlockLimits) fits(operations, items uint, fact base.Fact) (uint, uint, bool) {
+	o, i := countFact(fact)
+
+	switch {
+	case bl.MaxOperations > 0 && operations+o > bl.MaxOperations:
+		return operations, items, false
+	case bl.MaxItems > 0 && items+i > bl.MaxItems:
+		return operations, items, false
+	default:
+		return operations + o, items + i, true
+	}
+}
+
+// countFact returns the number of operations and items of fact. The fact
+// without items is counted as one item.
+func countFact(fact base.Fact) (uint, uint) {
+	switch t := fact.(type) {
+	case BatchFact:
+		var operations, items uint
+		for _, op := range t.Operations() {
+			o, i := countFact(op.Fact())
+			operations += o
+			items += i
+		}
+
+		return operations, items
+	case TransfersFact:
+		return 1, uint(len(t.Items()))
+	case CreateAccountsFact:
+		return 1, uint(len(t.Items()))
+	case MultiTransfersFact:
+		return 1, uint(len(t.Items()))
+	default:
+		return 1, 1
+	}
+}
@@ -0,0 +1,151 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+)
+
+type testBlockLimits struct {
+	testTransfersOperations
+}
+
+func (t *testBlockLimits) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	return copr.New(pool)
+}
+
+func (t *testBlockLimits) newNetworkPolicyState(limits BlockLimits) state.State {
+	st, err := state.NewStateV0(StateKeyNetworkPolicy, nil, base.Height(33))
+	t.NoError(err)
+
+	nst, err := SetStateNetworkPolicyValue(st, DefaultNetworkPolicy.SetBlockLimits(limits))
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testBlockLimits) newSeal(sender *account, items ...TransfersItem) operation.Seal {
+	op := t.newTransfer(sender.Address, sender.Privs(), items)
+
+	sl, err := operation.NewBaseSeal(sender.Priv, []operation.Operation{op}, nil)
+	t.NoError(err)
+
+	return sl
+}
+
+func (t *testBlockLimits) pick(st storage.Database) []operation.Seal {
+	var picked []operation.Seal
+	t.NoError(st.StagedOperationSeals(func(sl operation.Seal) (bool, error) {
+		picked = append(picked, sl)
+
+		return true, nil
+	}, true))
+
+	return picked
+}
+
+func (t *testBlockLimits) compareSeals(expected, picked []operation.Seal) {
+	t.Equal(len(expected), len(picked))
+	for i := range expected {
+		t.True(expected[i].Hash().Equal(picked[i].Hash()))
+	}
+}
+
+func (t *testBlockLimits) TestMaxOperations() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+
+	item := t.newTransfersItem(ra.Address, NewBig(1))
+	seals := []operation.Seal{t.newSeal(sa, item), t.newSeal(sa, item), t.newSeal(sa, item)}
+
+	st := NewProposalDatabase(dummyStagedDatabase{
+		seals:  seals,
+		states: map[string]state.State{StateKeyNetworkPolicy: t.newNetworkPolicyState(BlockLimits{MaxOperations: 2})},
+	}, nil)
+
+	// NOTE the seals over the limits are not picked; they wait for the next
+	// proposal.
+	t.compareSeals(seals[:2], t.pick(st))
+}
+
+func (t *testBlockLimits) TestMaxItems() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+	rb, _ := t.newAccount(true, nil)
+
+	seals := []operation.Seal{
+		t.newSeal(sa, t.newTransfersItem(ra.Address, NewBig(1)), t.newTransfersItem(rb.Address, NewBig(1))),
+		t.newSeal(sa, t.newTransfersItem(ra.Address, NewBig(1)), t.newTransfersItem(rb.Address, NewBig(1))),
+		t.newSeal(sa, t.newTransfersItem(ra.Address, NewBig(1))),
+	}
+
+	st := NewProposalDatabase(dummyStagedDatabase{
+		seals:  seals,
+		states: map[string]state.State{StateKeyNetworkPolicy: t.newNetworkPolicyState(BlockLimits{MaxItems: 3})},
+	}, nil)
+
+	// NOTE the skipped seal is not counted, so the smaller seal after it is
+	// picked.
+	t.compareSeals([]operation.Seal{seals[0], seals[2]}, t.pick(st))
+}
+
+func (t *testBlockLimits) TestKnownOperationNotCounted() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+
+	item := t.newTransfersItem(ra.Address, NewBig(1))
+	seals := []operation.Seal{t.newSeal(sa, item), t.newSeal(sa, item)}
+
+	st := NewProposalDatabase(dummyStagedDatabase{
+		seals:  seals,
+		states: map[string]state.State{StateKeyNetworkPolicy: t.newNetworkPolicyState(BlockLimits{MaxOperations: 1})},
+		known:  map[string]struct{}{seals[0].Operations()[0].Fact().Hash().String(): {}},
+	}, nil)
+
+	t.compareSeals(seals, t.pick(st))
+}
+
+func (t *testBlockLimits) TestWithoutLimits() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+
+	item := t.newTransfersItem(ra.Address, NewBig(1))
+	seals := []operation.Seal{t.newSeal(sa, item), t.newSeal(sa, item), t.newSeal(sa, item)}
+
+	st := NewProposalDatabase(dummyStagedDatabase{seals: seals}, nil)
+
+	t.compareSeals(seals, t.pick(st))
+}
+
+func (t *testBlockLimits) TestNotRejectedInProcess() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newNetworkPolicyState(BlockLimits{MaxOperations: 1})})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE the proposal of the other node over the limits is not rejected, so
+	// the operations are not lost.
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))}
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+	t.NoError(opr.Process(t.newTransfer(sa.Address, sa.Privs(), items)))
+	t.NoError(opr.Close())
+}
+
+func TestBlockLimits(t *testing.T) {
+	suite.Run(t, new(testBlockLimits))
+}
@@ -0,0 +1,172 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ClaimBalanceFactType = hint.MustNewType(0xa0, 0x40, "mitum-currency-claim-balance-operation-fact")
+	ClaimBalanceFactHint = hint.MustHint(ClaimBalanceFactType, "0.0.1")
+	ClaimBalanceType     = hint.MustNewType(0xa0, 0x41, "mitum-currency-claim-balance-operation")
+	ClaimBalanceHint     = hint.MustHint(ClaimBalanceType, "0.0.1")
+)
+
+var (
+	ClaimBalanceFactExpiringHint   = hint.MustHint(ClaimBalanceFactType, "0.0.2")
+	ClaimBalanceFactExpiringHinter = ClaimBalanceFact{expiringFact: expiringFact{ht: ClaimBalanceFactExpiringHint}}
+)
+
+// ClaimBalanceFact creates the account of keys and moves the all the claimable
+// balances of it into the balance of new account. The claimable balances are
+// sent to the address, which is not yet created, so the fact is signed by the
+// keys instead of the keys of account.
+type ClaimBalanceFact struct {
+	expiringFact
+	h     valuehash.Hash
+	token []byte
+	keys  Keys
+}
+
+func NewClaimBalanceFact(token []byte, keys Keys) ClaimBalanceFact {
+	fact := ClaimBalanceFact{
+		token: token,
+		keys:  keys,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ClaimBalanceFact) WithExpiry(ex Expiry) ClaimBalanceFact {
+	fact.expiringFact = newExpiringFact(ClaimBalanceFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ClaimBalanceFact) Hint() hint.Hint {
+	return fact.factHint(ClaimBalanceFactHint)
+}
+
+func (fact ClaimBalanceFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ClaimBalanceFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimBalanceFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.keys.Bytes(),
+		ext,
+	)
+}
+
+func (fact ClaimBalanceFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ClaimBalanceFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.keys,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ClaimBalanceFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ClaimBalanceFact) Keys() Keys {
+	return fact.keys
+}
+
+// Owner returns the address of keys, which has the claimable balances.
+func (fact ClaimBalanceFact) Owner() (base.Address, error) {
+	return NewAddressFromKeys(fact.keys)
+}
+
+func (fact ClaimBalanceFact) Addresses() ([]base.Address, error) {
+	if a, err := fact.Owner(); err != nil {
+		return nil, err
+	} else {
+		return []base.Address{a}, nil
+	}
+}
+
+type ClaimBalance struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewClaimBalance(fact ClaimBalanceFact, fs []operation.FactSign, memo string) (ClaimBalance, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ClaimBalanceHint, fact, fs); err != nil {
+		return ClaimBalance{}, err
+	} else {
+		op := ClaimBalance{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ClaimBalance) Hint() hint.Hint {
+	return ClaimBalanceHint
+}
+
+func (op ClaimBalance) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ClaimBalance) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ClaimBalance) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
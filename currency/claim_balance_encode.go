@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ClaimBalanceFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bKeys []byte,
+	ex *Expiry,
+) error {
+	if hinter, err := enc.DecodeByHint(bKeys); err != nil {
+		return err
+	} else if k, ok := hinter.(Keys); !ok {
+		return xerrors.Errorf("not Keys: %T", hinter)
+	} else {
+		fact.keys = k
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, ClaimBalanceFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+
+	return nil
+}
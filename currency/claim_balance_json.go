@@ -0,0 +1,78 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ClaimBalanceFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	KS Keys           `json:"keys"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact ClaimBalanceFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(ClaimBalanceFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		KS:         fact.keys,
+		EX:         ex,
+	})
+}
+
+type ClaimBalanceFactJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	TK []byte          `json:"token"`
+	KS json.RawMessage `json:"keys"`
+	EX *Expiry         `json:"expiry,omitempty"`
+}
+
+func (fact *ClaimBalanceFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact ClaimBalanceFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.KS, ufact.EX)
+}
+
+func (op ClaimBalance) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *ClaimBalance) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = ClaimBalance{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ClaimBalance) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ClaimBalanceProcessor struct {
+	cp *CurrencyPool
+	ClaimBalance
+	owner   base.Address
+	ns      state.State
+	cs      state.State
+	sb      map[CurrencyID]AmountState
+	claimed map[CurrencyID][2]Big
+}
+
+func NewClaimBalanceProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ClaimBalance); !ok {
+			return nil, xerrors.Errorf("not ClaimBalance, %T", op)
+		} else {
+			return &ClaimBalanceProcessor{
+				cp:           cp,
+				ClaimBalance: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ClaimBalanceProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+// PreProcess checks the claimable balances of the address of keys; the
+// account of keys is created, so the fact signs are checked by the keys of
+// fact.
+func (opp *ClaimBalanceProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ClaimBalanceFact)
+
+	if a, err := fact.Owner(); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else {
+		opp.owner = a
+	}
+
+	if st, err := notExistsState(StateKeyAccount(opp.owner), "keys of owner", getState); err != nil {
+		return nil, err
+	} else {
+		opp.ns = st
+	}
+
+	var cbs []ClaimableBalance
+	switch st, i, err := loadClaimableBalances(opp.owner, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case len(i) < 1:
+		return nil, operation.NewBaseReasonError("claimable balance of owner does not exist")
+	default:
+		opp.cs = st
+		cbs = i
+	}
+
+	if sb, claimed, err := claimedAmountStates(opp.cp, opp.owner, sumClaimableBalances(cbs), getState); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else {
+		opp.sb = sb
+		opp.claimed = claimed
+	}
+
+	if err := checkClaimedMinBalance(opp.cp, opp.claimed); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if err := checkThreshold(opp.Signs(), fact.keys, opp.Hint().Type()); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ClaimBalanceProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ClaimBalanceFact)
+
+	var sts []state.State // nolint:prealloc
+	if ac, err := NewAccount(opp.owner, fact.keys); err != nil {
+		return err
+	} else if st, err := SetStateAccountValue(opp.ns, ac); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	if st, err := SetStateClaimableBalancesValue(opp.cs, nil); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	for k := range opp.claimed {
+		cl := opp.claimed[k]
+		sts = append(sts, opp.sb[k].Add(cl[0].Sub(cl[1])).AddFee(cl[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// claimedAmountStates loads the balance states of holder for the claimed
+// amounts. The fee of each currency is taken from the claimed amount.
+func claimedAmountStates(
+	cp *CurrencyPool,
+	holder base.Address,
+	amounts map[CurrencyID]Big,
+	getState func(key string) (state.State, bool, error),
+) (map[CurrencyID]AmountState, map[CurrencyID][2]Big, error) {
+	sb := map[CurrencyID]AmountState{}
+	claimed := map[CurrencyID][2]Big{}
+
+	for cid := range amounts {
+		big := amounts[cid]
+
+		fee := ZeroBig
+		if cp != nil {
+			if feeer, found := cp.Feeer(cid); !found {
+				return nil, nil, xerrors.Errorf("currency not registered, %q", cid)
+			} else if i, err := feeer.Fee(ZeroBig); err != nil {
+				return nil, nil, err
+			} else if big.Compare(i) < 0 {
+				return nil, nil, xerrors.Errorf("claimable amount under fee, %v < %v", big, i)
+			} else {
+				fee = i
+			}
+		}
+
+		if st, _, err := getState(StateKeyBalance(holder, cid)); err != nil {
+			return nil, nil, err
+		} else {
+			sb[cid] = NewAmountState(st, cid)
+		}
+
+		claimed[cid] = [2]Big{big, fee}
+	}
+
+	return sb, claimed, nil
+}
+
+// checkClaimedMinBalance checks the claimed amounts of new account like
+// CreateAccounts; the amount except fee should be over NewAccountMinBalance.
+func checkClaimedMinBalance(cp *CurrencyPool, claimed map[CurrencyID][2]Big) error {
+	if cp == nil {
+		return nil
+	}
+
+	for cid := range claimed {
+		policy, found := cp.Policy(cid)
+		if !found {
+			return xerrors.Errorf("currency not registered, %q", cid)
+		}
+
+		if big := claimed[cid][0].Sub(claimed[cid][1]); big.Compare(policy.NewAccountMinBalance()) < 0 {
+			return xerrors.Errorf("amount should be over minimum balance, %v < %v", big, policy.NewAccountMinBalance())
+		}
+	}
+
+	return nil
+}
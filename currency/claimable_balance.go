@@ -0,0 +1,103 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ClaimableBalanceType = hint.MustNewType(0xa0, 0x37, "mitum-currency-claimable-balance")
+	ClaimableBalanceHint = hint.MustHint(ClaimableBalanceType, "0.0.1")
+)
+
+// ClaimableBalance is the parked amounts, which are sent to the address not
+// yet created. fact is the fact hash of CreateClaimableBalance operation.
+type ClaimableBalance struct {
+	fact    valuehash.Hash
+	sender  base.Address
+	amounts []Amount
+	expire  base.Height
+}
+
+func NewClaimableBalance(
+	fact valuehash.Hash,
+	sender base.Address,
+	amounts []Amount,
+	expire base.Height,
+) ClaimableBalance {
+	return ClaimableBalance{
+		fact:    fact,
+		sender:  sender,
+		amounts: amounts,
+		expire:  expire,
+	}
+}
+
+func (cb ClaimableBalance) Hint() hint.Hint {
+	return ClaimableBalanceHint
+}
+
+func (cb ClaimableBalance) Bytes() []byte {
+	bs := make([][]byte, len(cb.amounts)+3)
+	bs[0] = cb.fact.Bytes()
+	bs[1] = cb.sender.Bytes()
+	bs[2] = cb.expire.Bytes()
+
+	for i := range cb.amounts {
+		bs[i+3] = cb.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (cb ClaimableBalance) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(cb.Bytes())
+}
+
+func (cb ClaimableBalance) IsValid([]byte) error {
+	if err := isvalid.Check([]isvalid.IsValider{
+		cb.fact,
+		cb.sender,
+		cb.expire,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid ClaimableBalance: %w", err)
+	}
+
+	if n := len(cb.amounts); n < 1 {
+		return xerrors.Errorf("empty amounts")
+	}
+
+	for i := range cb.amounts {
+		if err := cb.amounts[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cb ClaimableBalance) Fact() valuehash.Hash {
+	return cb.fact
+}
+
+func (cb ClaimableBalance) Sender() base.Address {
+	return cb.sender
+}
+
+func (cb ClaimableBalance) Amounts() []Amount {
+	return cb.amounts
+}
+
+func (cb ClaimableBalance) Expire() base.Height {
+	return cb.expire
+}
+
+// IsExpired returns true when the sender can reclaim it at the given height.
+func (cb ClaimableBalance) IsExpired(height base.Height) bool {
+	return height >= cb.expire
+}
@@ -0,0 +1,41 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (cb ClaimableBalance) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(cb.Hint()),
+			bson.M{
+				"fact":    cb.fact,
+				"sender":  cb.sender,
+				"amounts": cb.amounts,
+				"expire":  cb.expire,
+			}))
+}
+
+type ClaimableBalanceBSONUnpacker struct {
+	FC valuehash.Bytes     `bson:"fact"`
+	SD base.AddressDecoder `bson:"sender"`
+	AM []bson.Raw          `bson:"amounts"`
+	EX base.Height         `bson:"expire"`
+}
+
+func (cb *ClaimableBalance) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ucb ClaimableBalanceBSONUnpacker
+	if err := enc.Unmarshal(b, &ucb); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ucb.AM))
+	for i := range ucb.AM {
+		ams[i] = ucb.AM[i]
+	}
+
+	return cb.unpack(enc, ucb.FC, ucb.SD, ams, ucb.EX)
+}
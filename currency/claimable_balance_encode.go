@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (cb *ClaimableBalance) unpack(
+	enc encoder.Encoder,
+	fact valuehash.Hash,
+	bSender base.AddressDecoder,
+	bams [][]byte,
+	expire base.Height,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		cb.sender = a
+	}
+
+	ams := make([]Amount, len(bams))
+	for i := range bams {
+		if j, err := DecodeAmount(enc, bams[i]); err != nil {
+			return err
+		} else {
+			ams[i] = j
+		}
+	}
+
+	cb.fact = fact
+	cb.amounts = ams
+	cb.expire = expire
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ClaimableBalanceJSONPacker struct {
+	jsonenc.HintedHead
+	FC valuehash.Hash `json:"fact"`
+	SD base.Address   `json:"sender"`
+	AM []Amount       `json:"amounts"`
+	EX base.Height    `json:"expire"`
+}
+
+func (cb ClaimableBalance) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ClaimableBalanceJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(cb.Hint()),
+		FC:         cb.fact,
+		SD:         cb.sender,
+		AM:         cb.amounts,
+		EX:         cb.expire,
+	})
+}
+
+type ClaimableBalanceJSONUnpacker struct {
+	FC valuehash.Bytes     `json:"fact"`
+	SD base.AddressDecoder `json:"sender"`
+	AM []json.RawMessage   `json:"amounts"`
+	EX base.Height         `json:"expire"`
+}
+
+func (cb *ClaimableBalance) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ucb ClaimableBalanceJSONUnpacker
+	if err := enc.Unmarshal(b, &ucb); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ucb.AM))
+	for i := range ucb.AM {
+		ams[i] = ucb.AM[i]
+	}
+
+	return cb.unpack(enc, ucb.FC, ucb.SD, ams, ucb.EX)
+}
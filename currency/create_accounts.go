@@ -18,6 +18,16 @@ var (
 	CreateAccountsHint     = hint.MustHint(CreateAccountsType, "0.0.1")
 )
 
+var (
+	CreateAccountsFactExpiringHint   = hint.MustHint(CreateAccountsFactType, "0.0.2")
+	CreateAccountsFactExpiringHinter = CreateAccountsFact{hint: CreateAccountsFactExpiringHint}
+)
+
+var (
+	CreateAccountsFactSequencedHint   = hint.MustHint(CreateAccountsFactType, "0.0.3")
+	CreateAccountsFactSequencedHinter = CreateAccountsFact{hint: CreateAccountsFactSequencedHint}
+)
+
 var MaxCreateAccountsItems uint = 10
 
 type AmountsItem interface {
@@ -31,14 +41,18 @@ type CreateAccountsItem interface {
 	Bytes() []byte
 	Keys() Keys
 	Address() (base.Address, error)
+	Salt() string
 	Rebuild() CreateAccountsItem
 }
 
 type CreateAccountsFact struct {
-	h      valuehash.Hash
-	token  []byte
-	sender base.Address
-	items  []CreateAccountsItem
+	hint     hint.Hint
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	items    []CreateAccountsItem
+	expiry   Expiry
+	sequence uint64
 }
 
 func NewCreateAccountsFact(token []byte, sender base.Address, items []CreateAccountsItem) CreateAccountsFact {
@@ -52,8 +66,37 @@ func NewCreateAccountsFact(token []byte, sender base.Address, items []CreateAcco
 	return fact
 }
 
+// WithExpiry sets the Expiry; the sequenced fact keeps the sequenced hint.
+func (fact CreateAccountsFact) WithExpiry(ex Expiry) CreateAccountsFact {
+	if !fact.IsSequenced() {
+		fact.hint = CreateAccountsFactExpiringHint
+	}
+
+	fact.expiry = ex
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// WithSequence returns the sequenced CreateAccountsFact; the operation is
+// accepted only with the next sequence of sender. The Expiry is kept.
+func (fact CreateAccountsFact) WithSequence(sequence uint64) CreateAccountsFact {
+	fact.hint = CreateAccountsFactSequencedHint
+	fact.sequence = sequence
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact CreateAccountsFact) Hint() hint.Hint {
-	return CreateAccountsFactHint
+	switch {
+	case fact.hint.Equal(CreateAccountsFactSequencedHint):
+		return CreateAccountsFactSequencedHint
+	case fact.hint.Equal(CreateAccountsFactExpiringHint):
+		return CreateAccountsFactExpiringHint
+	default:
+		return CreateAccountsFactHint
+	}
 }
 
 func (fact CreateAccountsFact) Hash() valuehash.Hash {
@@ -70,10 +113,19 @@ func (fact CreateAccountsFact) Bytes() []byte {
 		is[i] = fact.items[i].Bytes()
 	}
 
+	var ex []byte
+	switch ht := fact.Hint(); {
+	case ht.Equal(CreateAccountsFactSequencedHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), fact.expiry.Bytes(), util.Uint64ToBytes(fact.sequence))
+	case ht.Equal(CreateAccountsFactExpiringHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), fact.expiry.Bytes())
+	}
+
 	return util.ConcatBytesSlice(
 		fact.token,
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(is...),
+		ex,
 	)
 }
 
@@ -93,25 +145,35 @@ func (fact CreateAccountsFact) IsValid([]byte) error {
 		return err
 	}
 
-	foundKeys := map[string]struct{}{}
+	if fact.IsExpiring() {
+		if err := fact.expiry.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.IsSequenced() && fact.sequence < 1 {
+		return xerrors.Errorf("sequence should be over zero")
+	}
+
+	// NOTE with the different salts, the same Keys can be used
+	foundAddresses := map[string]struct{}{}
 	for i := range fact.items {
 		if err := fact.items[i].IsValid(nil); err != nil {
 			return err
 		}
 
 		it := fact.items[i]
-		k := it.Keys().Hash().String()
-		if _, found := foundKeys[k]; found {
-			return xerrors.Errorf("duplicated acocunt Keys found, %s", k)
-		}
-
 		switch a, err := it.Address(); {
 		case err != nil:
 			return err
 		case fact.sender.Equal(a):
 			return xerrors.Errorf("target address is same with sender, %q", fact.sender)
 		default:
-			foundKeys[k] = struct{}{}
+			if _, found := foundAddresses[a.String()]; found {
+				return xerrors.Errorf("duplicated acocunt Keys found, %s", it.Keys().Hash())
+			}
+
+			foundAddresses[a.String()] = struct{}{}
 		}
 	}
 
@@ -134,6 +196,26 @@ func (fact CreateAccountsFact) Items() []CreateAccountsItem {
 	return fact.items
 }
 
+func (fact CreateAccountsFact) Expiry() Expiry {
+	return fact.expiry
+}
+
+func (fact CreateAccountsFact) IsExpiring() bool {
+	return fact.Hint().Equal(CreateAccountsFactExpiringHint) || (fact.IsSequenced() && !fact.expiry.IsEmpty())
+}
+
+func (fact CreateAccountsFact) Sequence() uint64 {
+	return fact.sequence
+}
+
+func (fact CreateAccountsFact) IsSequenced() bool {
+	return fact.Hint().Equal(CreateAccountsFactSequencedHint)
+}
+
+func (fact CreateAccountsFact) SequenceAccount() base.Address {
+	return fact.sender
+}
+
 func (fact CreateAccountsFact) Targets() ([]base.Address, error) {
 	as := make([]base.Address, len(fact.items))
 	for i := range fact.items {
@@ -10,14 +10,22 @@ import (
 )
 
 func (fact CreateAccountsFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":   fact.h,
-				"token":  fact.token,
-				"sender": fact.sender,
-				"items":  fact.items,
-			}))
+	m := bson.M{
+		"hash":   fact.h,
+		"token":  fact.token,
+		"sender": fact.sender,
+		"items":  fact.items,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	if fact.IsSequenced() {
+		m["sequence"] = fact.sequence
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type CreateAccountsFactBSONUnpacker struct {
@@ -25,9 +33,16 @@ type CreateAccountsFactBSONUnpacker struct {
 	TK []byte              `bson:"token"`
 	SD base.AddressDecoder `bson:"sender"`
 	IT []bson.Raw          `bson:"items"`
+	EX *Expiry             `bson:"expiry,omitempty"`
+	SQ uint64              `bson:"sequence,omitempty"`
 }
 
 func (fact *CreateAccountsFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var uca CreateAccountsFactBSONUnpacker
 	if err := bson.Unmarshal(b, &uca); err != nil {
 		return err
@@ -38,7 +53,7 @@ func (fact *CreateAccountsFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error
 		bits[i] = uca.IT[i]
 	}
 
-	return fact.unpack(enc, uca.H, uca.TK, uca.SD, bits)
+	return fact.unpack(enc, ht.H, uca.H, uca.TK, uca.SD, bits, uca.EX, uca.SQ)
 }
 
 func (op CreateAccounts) MarshalBSON() ([]byte, error) {
@@ -3,15 +3,19 @@ package currency
 import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (fact *CreateAccountsFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	tk []byte,
 	bSender base.AddressDecoder,
 	bits [][]byte,
+	ex *Expiry,
+	sequence uint64,
 ) error {
 	var sender base.Address
 	if a, err := bSender.Encode(enc); err != nil {
@@ -29,10 +33,17 @@ func (fact *CreateAccountsFact) unpack(
 		}
 	}
 
+	fact.hint = ht
 	fact.h = h
 	fact.token = tk
 	fact.sender = sender
 	fact.items = its
 
+	if ex != nil {
+		fact.expiry = *ex
+	}
+
+	fact.sequence = sequence
+
 	return nil
 }
@@ -12,6 +12,7 @@ type BaseCreateAccountsItem struct {
 	hint    hint.Hint
 	keys    Keys
 	amounts []Amount
+	salt    string
 }
 
 func NewBaseCreateAccountsItem(ht hint.Hint, keys Keys, amounts []Amount) BaseCreateAccountsItem {
@@ -34,6 +35,10 @@ func (it BaseCreateAccountsItem) Bytes() []byte {
 		bs[i+1] = it.amounts[i].Bytes()
 	}
 
+	if len(it.salt) > 0 {
+		bs = append(bs, []byte(it.salt))
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -42,6 +47,15 @@ func (it BaseCreateAccountsItem) IsValid([]byte) error {
 		return err
 	}
 
+	// NOTE only the salted item can have salt.
+	if it.hint.Type().Equal(CreateAccountsItemSaltedType) {
+		if err := isValidCreateAccountsItemSalt(it.salt); err != nil {
+			return err
+		}
+	} else if len(it.salt) > 0 {
+		return xerrors.Errorf("salt not allowed for %q", it.hint.Type())
+	}
+
 	if n := len(it.amounts); n == 0 {
 		return xerrors.Errorf("empty amounts")
 	}
@@ -70,7 +84,11 @@ func (it BaseCreateAccountsItem) Keys() Keys {
 }
 
 func (it BaseCreateAccountsItem) Address() (base.Address, error) {
-	return NewAddressFromKeys(it.keys)
+	return NewAddressFromKeysWithSalt(it.keys, it.salt)
+}
+
+func (it BaseCreateAccountsItem) Salt() string {
+	return it.salt
 }
 
 func (it BaseCreateAccountsItem) Amounts() []Amount {
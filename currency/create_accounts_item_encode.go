@@ -7,7 +7,7 @@ import (
 	"github.com/spikeekips/mitum/util/hint"
 )
 
-func (it *BaseCreateAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bas [][]byte) error {
+func (it *BaseCreateAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bas [][]byte, salt string) error {
 	it.hint = ht
 
 	if hinter, err := enc.DecodeByHint(bks); err != nil {
@@ -28,6 +28,7 @@ func (it *BaseCreateAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks
 	}
 
 	it.amounts = amounts
+	it.salt = salt
 
 	return nil
 }
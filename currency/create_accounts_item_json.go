@@ -10,6 +10,7 @@ type CreateAccountsItemJSONPacker struct {
 	jsonenc.HintedHead
 	KS Keys     `json:"keys"`
 	AS []Amount `json:"amounts"`
+	SL string   `json:"salt,omitempty"`
 }
 
 func (it BaseCreateAccountsItem) MarshalJSON() ([]byte, error) {
@@ -17,12 +18,14 @@ func (it BaseCreateAccountsItem) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(it.Hint()),
 		KS:         it.keys,
 		AS:         it.amounts,
+		SL:         it.salt,
 	})
 }
 
 type CreateAccountsItemJSONUnpacker struct {
 	KS json.RawMessage   `json:"keys"`
 	AM []json.RawMessage `json:"amounts"`
+	SL string            `json:"salt,omitempty"`
 }
 
 func (it *BaseCreateAccountsItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -41,5 +44,5 @@ func (it *BaseCreateAccountsItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) err
 		bam[i] = uca.AM[i]
 	}
 
-	return it.unpack(enc, ht.H, uca.KS, bam)
+	return it.unpack(enc, ht.H, uca.KS, bam, uca.SL)
 }
@@ -15,15 +15,24 @@ type CreateAccountsFactJSONPacker struct {
 	TK []byte               `json:"token"`
 	SD base.Address         `json:"sender"`
 	IT []CreateAccountsItem `json:"items"`
+	EX *Expiry              `json:"expiry,omitempty"`
+	SQ uint64               `json:"sequence,omitempty"`
 }
 
 func (fact CreateAccountsFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
 	return jsonenc.Marshal(CreateAccountsFactJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
 		H:          fact.h,
 		TK:         fact.token,
 		SD:         fact.sender,
 		IT:         fact.items,
+		EX:         ex,
+		SQ:         fact.sequence,
 	})
 }
 
@@ -32,9 +41,16 @@ type CreateAccountsFactJSONUnpacker struct {
 	TK []byte              `json:"token"`
 	SD base.AddressDecoder `json:"sender"`
 	IT []json.RawMessage   `json:"items"`
+	EX *Expiry             `json:"expiry,omitempty"`
+	SQ uint64              `json:"sequence,omitempty"`
 }
 
 func (fact *CreateAccountsFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var uca CreateAccountsFactJSONUnpacker
 	if err := jsonenc.Unmarshal(b, &uca); err != nil {
 		return err
@@ -45,7 +61,7 @@ func (fact *CreateAccountsFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error
 		bits[i] = uca.IT[i]
 	}
 
-	return fact.unpack(enc, uca.H, uca.TK, uca.SD, bits)
+	return fact.unpack(enc, ht.H, uca.H, uca.TK, uca.SD, bits, uca.EX, uca.SQ)
 }
 
 func (op CreateAccounts) MarshalJSON() ([]byte, error) {
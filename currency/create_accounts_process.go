@@ -21,6 +21,8 @@ type CreateAccountsItemProcessor struct {
 	item CreateAccountsItem
 	ns   state.State
 	nb   map[CurrencyID]AmountState
+	cs   state.State
+	cb   map[CurrencyID]Big
 }
 
 func (opp *CreateAccountsItemProcessor) PreProcess(
@@ -67,6 +69,27 @@ func (opp *CreateAccountsItemProcessor) PreProcess(
 		}
 	}
 
+	// NOTE the claimable balances of target are moved into the new balances
+	switch st, cbs, err := loadClaimableBalances(target, getState); {
+	case err != nil:
+		return err
+	case len(cbs) > 0:
+		opp.cs = st
+		opp.cb = sumClaimableBalances(cbs)
+
+		for cid := range opp.cb {
+			if _, found := nb[cid]; found {
+				continue
+			}
+
+			if b, _, err := getState(StateKeyBalance(target, cid)); err != nil {
+				return err
+			} else {
+				nb[cid] = NewAmountState(b, cid)
+			}
+		}
+	}
+
 	opp.nb = nb
 
 	return nil
@@ -77,22 +100,45 @@ func (opp *CreateAccountsItemProcessor) Process(
 	_ func(valuehash.Hash, ...state.State) error,
 ) ([]state.State, error) {
 	var nac Account
-	if ac, err := NewAccountFromKeys(opp.item.Keys()); err != nil {
+	if a, err := opp.item.Address(); err != nil {
+		return nil, err
+	} else if ac, err := NewAccount(a, opp.item.Keys()); err != nil {
 		return nil, err
 	} else {
 		nac = ac
 	}
 
-	sts := make([]state.State, len(opp.item.Amounts())+1)
+	var sts []state.State // nolint:prealloc
 	if st, err := SetStateAccountValue(opp.ns, nac); err != nil {
 		return nil, err
 	} else {
-		sts[0] = st
+		sts = append(sts, st)
+	}
+
+	nb := map[CurrencyID]AmountState{}
+	for k := range opp.nb {
+		nb[k] = opp.nb[k]
 	}
 
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
-		sts[i+1] = opp.nb[am.Currency()].Add(am.Big())
+		nb[am.Currency()] = nb[am.Currency()].Add(am.Big())
+	}
+
+	if opp.cs != nil {
+		for cid := range opp.cb {
+			nb[cid] = nb[cid].Add(opp.cb[cid])
+		}
+
+		if st, err := SetStateClaimableBalancesValue(opp.cs, nil); err != nil {
+			return nil, err
+		} else {
+			sts = append(sts, st)
+		}
+	}
+
+	for cid := range nb {
+		sts = append(sts, nb[cid])
 	}
 
 	return sts, nil
@@ -104,6 +150,8 @@ type CreateAccountsProcessor struct {
 	sb       map[CurrencyID]AmountState
 	ns       []*CreateAccountsItemProcessor
 	required map[CurrencyID][2]Big
+	height   base.Height
+	ss       []state.State
 }
 
 func NewCreateAccountsProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -119,6 +167,14 @@ func NewCreateAccountsProcessor(cp *CurrencyPool) GetNewProcessor {
 	}
 }
 
+func (opp *CreateAccountsProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *CreateAccountsProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *CreateAccountsProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
@@ -131,13 +187,19 @@ func (opp *CreateAccountsProcessor) PreProcess(
 
 	if required, err := opp.calculateItemsFee(); err != nil {
 		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
-	} else if sb, err := CheckEnoughBalance(fact.sender, required, getState); err != nil {
+	} else if sb, err := CheckEnoughBalance(opp.cp, fact.sender, required, getState); err != nil {
 		return nil, err
 	} else {
 		opp.required = required
 		opp.sb = sb
 	}
 
+	if ss, err := checkSpendingLimits(fact.sender, opp.height, opp.required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
 	ns := make([]*CreateAccountsItemProcessor, len(fact.items))
 	for i := range fact.items {
 		c := &CreateAccountsItemProcessor{cp: opp.cp, h: opp.Hash(), item: fact.items[i]}
@@ -148,7 +210,7 @@ func (opp *CreateAccountsProcessor) PreProcess(
 		ns[i] = c
 	}
 
-	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
 	}
 
@@ -177,6 +239,8 @@ func (opp *CreateAccountsProcessor) Process( // nolint:dupl
 		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
 	}
 
+	sts = append(sts, opp.ss...)
+
 	return setState(fact.Hash(), sts...)
 }
 
@@ -229,7 +293,11 @@ func CalculateItemsFee(cp *CurrencyPool, items []AmountsItem) (map[CurrencyID][2
 	return required, nil
 }
 
+// CheckEnoughBalance checks the balances of holder are enough for the required
+// amounts; the remaining balance should not be under the min balance of
+// currency policy.
 func CheckEnoughBalance(
+	cp *CurrencyPool,
 	holder base.Address,
 	required map[CurrencyID][2]Big,
 	getState func(key string) (state.State, bool, error),
@@ -256,6 +324,8 @@ func CheckEnoughBalance(
 		if am.Big().Compare(rq[0]) < 0 {
 			return nil, operation.NewBaseReasonError(
 				"insufficient balance of sender, %s; %d !> %d", holder.String(), am.Big(), rq[0])
+		} else if err := checkMinBalance(cp, cid, am.Big(), rq[0]); err != nil {
+			return nil, operation.NewBaseReasonError("insufficient balance of sender, %s: %w", holder.String(), err)
 		} else {
 			sb[cid] = NewAmountState(st, cid)
 		}
@@ -263,3 +333,24 @@ func CheckEnoughBalance(
 
 	return sb, nil
 }
+
+// checkMinBalance checks the balance after spending is not under the min
+// balance of currency policy.
+func checkMinBalance(cp *CurrencyPool, cid CurrencyID, balance, spent Big) error {
+	if cp == nil {
+		return nil
+	}
+
+	var min Big
+	if po, found := cp.Policy(cid); !found {
+		return nil
+	} else if min = po.MinBalance(); !min.OverZero() {
+		return nil
+	}
+
+	if remain := balance.Sub(spent); remain.Compare(min) < 0 {
+		return xerrors.Errorf("under min balance of currency, %q; %d < %d", cid, remain, min)
+	}
+
+	return nil
+}
@@ -566,8 +566,16 @@ func (t *testCreateAccountsOperation) TestSameSenders() {
 		t.True(addresses[i].Equal(raddresses[i]))
 	}
 
-	err = opr.Process(ca1)
-	t.Contains(err.Error(), "violates only one sender")
+	t.NoError(opr.Process(ca1))
+
+	var nam Amount
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, cid) {
+			nam, _ = StateBalanceValue(st.GetState())
+		}
+	}
+
+	t.Equal(NewBig(31), nam.Big())
 }
 
 func (t *testCreateAccountsOperation) TestSameSendersWithInvalidOperation() {
@@ -606,8 +614,7 @@ func (t *testCreateAccountsOperation) TestSameSendersWithInvalidOperation() {
 	items = []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na1.Keys(), []Amount{NewAmount(NewBig(1), cid)})}
 	ca1 := t.newOperation(sa.Address, items, sa.Privs())
 
-	err := opr.Process(ca1)
-	t.Contains(err.Error(), "violates only one sender")
+	t.NoError(opr.Process(ca1))
 }
 
 func (t *testCreateAccountsOperation) TestSameAddress() {
@@ -651,6 +658,66 @@ func (t *testCreateAccountsOperation) TestSameAddressMultipleOperations() {
 	t.Contains(err.Error(), "new address already processed")
 }
 
+func (t *testCreateAccountsOperation) TestSaltedWithExistingKeys() {
+	cid := CurrencyID("SHOWME")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+	na, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(3), cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), sa.Address, feeer)))
+
+	opr := t.processor(cp, pool)
+
+	it0 := NewCreateAccountsItemSalted(na.Keys(), []Amount{NewAmount(NewBig(1), cid)}, "sales")
+	it1 := NewCreateAccountsItemSalted(na.Keys(), []Amount{NewAmount(NewBig(2), cid)}, "dev")
+	ca := t.newOperation(sa.Address, []CreateAccountsItem{it0, it1}, sa.Privs())
+
+	t.NoError(opr.Process(ca))
+
+	a0, err := it0.Address()
+	t.NoError(err)
+	a1, err := it1.Address()
+	t.NoError(err)
+
+	t.False(a0.Equal(na.Address))
+	t.False(a1.Equal(na.Address))
+	t.False(a0.Equal(a1))
+
+	accounts := map[string]Account{}
+	for _, stu := range pool.Updates() {
+		if IsStateAccountKey(stu.Key()) {
+			ac, err := LoadStateAccountValue(stu.GetState())
+			t.NoError(err)
+
+			accounts[ac.Address().String()] = ac
+		}
+	}
+
+	t.Equal(2, len(accounts))
+	for _, a := range []base.Address{a0, a1} {
+		ac, found := accounts[a.String()]
+		t.True(found)
+		t.True(ac.Keys().Hash().Equal(na.Keys().Hash()))
+	}
+}
+
+func (t *testCreateAccountsOperation) TestSameSalt() {
+	cid := CurrencyID("SHOWME")
+
+	sa, _ := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+	na, _ := t.newAccount(false, nil)
+
+	it0 := NewCreateAccountsItemSalted(na.Keys(), []Amount{NewAmount(NewBig(1), cid)}, "sales")
+	it1 := NewCreateAccountsItemSalted(na.Keys(), []Amount{NewAmount(NewBig(1), cid)}, "sales")
+	items := []CreateAccountsItem{it0, it1}
+
+	t.Panicsf(func() { t.newOperation(sa.Address, items, sa.Privs()) }, "duplicated acocunt Keys found")
+}
+
 func TestCreateAccountsOperation(t *testing.T) {
 	suite.Run(t, new(testCreateAccountsOperation))
 }
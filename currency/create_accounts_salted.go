@@ -0,0 +1,64 @@
+package currency
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var MaxCreateAccountsItemSaltLength = 64
+
+var (
+	CreateAccountsItemSaltedType   = hint.MustNewType(0xa0, 0x66, "mitum-currency-create-accounts-salted")
+	CreateAccountsItemSaltedHint   = hint.MustHint(CreateAccountsItemSaltedType, "0.0.1")
+	CreateAccountsItemSaltedHinter = CreateAccountsItemSalted{
+		BaseCreateAccountsItem: BaseCreateAccountsItem{hint: CreateAccountsItemSaltedHint},
+	}
+)
+
+// CreateAccountsItemSalted creates the account, whose address is derived from
+// keys and salt. With the different salts, the same keys can own the several
+// accounts.
+type CreateAccountsItemSalted struct {
+	BaseCreateAccountsItem
+}
+
+func NewCreateAccountsItemSalted(keys Keys, amounts []Amount, salt string) CreateAccountsItemSalted {
+	it := NewBaseCreateAccountsItem(CreateAccountsItemSaltedHint, keys, amounts)
+	it.salt = salt
+
+	return CreateAccountsItemSalted{
+		BaseCreateAccountsItem: it,
+	}
+}
+
+func (it CreateAccountsItemSalted) IsValid([]byte) error {
+	if err := it.BaseCreateAccountsItem.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := len(it.amounts); n > maxCurenciesCreateAccountsItemMultiAmounts {
+		return xerrors.Errorf("amounts over allowed; %d > %d", n, maxCurenciesCreateAccountsItemMultiAmounts)
+	}
+
+	return nil
+}
+
+func isValidCreateAccountsItemSalt(salt string) error {
+	switch n := len(salt); {
+	case n < 1 || len(strings.TrimSpace(salt)) != n:
+		return xerrors.Errorf("invalid salt, %q", salt)
+	case n > MaxCreateAccountsItemSaltLength:
+		return xerrors.Errorf("salt too long; %d > %d", n, MaxCreateAccountsItemSaltLength)
+	default:
+		return nil
+	}
+}
+
+func (it CreateAccountsItemSalted) Rebuild() CreateAccountsItem {
+	it.BaseCreateAccountsItem = it.BaseCreateAccountsItem.Rebuild().(BaseCreateAccountsItem)
+
+	return it
+}
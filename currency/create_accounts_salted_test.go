@@ -0,0 +1,174 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testCreateAccountsSalted struct {
+	baseTest
+}
+
+func (t *testCreateAccountsSalted) newKeys() Keys {
+	k, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := NewKeys([]Key{k}, 100)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testCreateAccountsSalted) TestNew() {
+	keys := t.newKeys()
+	sender, _ := NewAddressFromKeys(t.newKeys())
+
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+
+	it0 := NewCreateAccountsItemSalted(keys, ams, "sales")
+	it1 := NewCreateAccountsItemSalted(keys, ams, "dev")
+	t.NoError(it0.IsValid(nil))
+	t.NoError(it1.IsValid(nil))
+
+	plain, err := NewAddressFromKeys(keys)
+	t.NoError(err)
+
+	a0, err := it0.Address()
+	t.NoError(err)
+	a1, err := it1.Address()
+	t.NoError(err)
+
+	t.False(a0.Equal(plain))
+	t.False(a1.Equal(plain))
+	t.False(a0.Equal(a1))
+
+	b0, err := NewAddressFromKeysWithSalt(keys, "sales")
+	t.NoError(err)
+	t.True(a0.Equal(b0))
+
+	fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{it0, it1})
+	t.NoError(fact.IsValid(nil))
+}
+
+func (t *testCreateAccountsSalted) TestEmptySaltSameWithPlain() {
+	keys := t.newKeys()
+
+	a, err := NewAddressFromKeysWithSalt(keys, "")
+	t.NoError(err)
+
+	b, err := NewAddressFromKeys(keys)
+	t.NoError(err)
+
+	t.True(a.Equal(b))
+}
+
+func (t *testCreateAccountsSalted) TestInvalidSalt() {
+	keys := t.newKeys()
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+
+	err := NewCreateAccountsItemSalted(keys, ams, "").IsValid(nil)
+	t.Contains(err.Error(), "invalid salt")
+
+	err = NewCreateAccountsItemSalted(keys, ams, " sales").IsValid(nil)
+	t.Contains(err.Error(), "invalid salt")
+
+	err = NewCreateAccountsItemSalted(keys, ams, strings.Repeat("a", MaxCreateAccountsItemSaltLength+1)).IsValid(nil)
+	t.Contains(err.Error(), "salt too long")
+}
+
+func (t *testCreateAccountsSalted) TestSaltByHint() {
+	keys := t.newKeys()
+	ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+
+	// NOTE the salt of salted item is checked by BaseCreateAccountsItem.
+	err := NewCreateAccountsItemSalted(keys, ams, " sales").BaseCreateAccountsItem.IsValid(nil)
+	t.Contains(err.Error(), "invalid salt")
+
+	it := NewBaseCreateAccountsItem(CreateAccountsItemSingleAmountHint, keys, ams)
+	t.NoError(it.IsValid(nil))
+
+	it.salt = "sales"
+	err = it.IsValid(nil)
+	t.Contains(err.Error(), "salt not allowed")
+}
+
+func TestCreateAccountsSalted(t *testing.T) {
+	suite.Run(t, new(testCreateAccountsSalted))
+}
+
+func testCreateAccountsSaltedEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		skey, err := NewKey(pk.Publickey(), 100)
+		t.NoError(err)
+		skeys, err := NewKeys([]Key{skey}, 100)
+		t.NoError(err)
+
+		sender, _ := NewAddressFromKeys(skeys)
+
+		ams := []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}
+
+		item := NewCreateAccountsItemSalted(skeys, ams, util.UUID().String())
+		fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, []CreateAccountsItem{item})
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		ca, err := NewCreateAccounts(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return ca
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(CreateAccounts)
+		cb := b.(CreateAccounts)
+
+		fact := ca.Fact().(CreateAccountsFact)
+		ufact := cb.Fact().(CreateAccountsFact)
+
+		t.True(fact.Hash().Equal(ufact.Hash()))
+		t.Equal(len(fact.Items()), len(ufact.Items()))
+
+		for i := range fact.Items() {
+			a := fact.Items()[i]
+			b := ufact.Items()[i]
+
+			_, ok := b.(CreateAccountsItemSalted)
+			t.True(ok)
+
+			t.True(a.Hint().Equal(b.Hint()))
+			t.True(a.Keys().Hash().Equal(b.Keys().Hash()))
+			t.Equal(a.Salt(), b.Salt())
+
+			aa, err := a.Address()
+			t.NoError(err)
+			ba, err := b.Address()
+			t.NoError(err)
+			t.True(aa.Equal(ba))
+		}
+	}
+
+	return t
+}
+
+func TestCreateAccountsSaltedEncodeJSON(t *testing.T) {
+	suite.Run(t, testCreateAccountsSaltedEncode(jsonenc.NewEncoder()))
+}
+
+func TestCreateAccountsSaltedEncodeBSON(t *testing.T) {
+	suite.Run(t, testCreateAccountsSaltedEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,231 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CreateClaimableBalanceFactType = hint.MustNewType(0xa0, 0x38, "mitum-currency-create-claimable-balance-operation-fact")
+	CreateClaimableBalanceFactHint = hint.MustHint(CreateClaimableBalanceFactType, "0.0.1")
+	CreateClaimableBalanceType     = hint.MustNewType(0xa0, 0x39, "mitum-currency-create-claimable-balance-operation")
+	CreateClaimableBalanceHint     = hint.MustHint(CreateClaimableBalanceType, "0.0.1")
+)
+
+var (
+	CreateClaimableBalanceFactExpiringHint   = hint.MustHint(CreateClaimableBalanceFactType, "0.0.2")
+	CreateClaimableBalanceFactExpiringHinter = CreateClaimableBalanceFact{expiringFact: expiringFact{ht: CreateClaimableBalanceFactExpiringHint}}
+)
+
+// CreateClaimableBalanceFact parks the amounts under the address of keys, which
+// is not yet created. After expire height, sender can reclaim the unclaimed
+// amounts.
+type CreateClaimableBalanceFact struct {
+	expiringFact
+	h       valuehash.Hash
+	token   []byte
+	sender  base.Address
+	keys    Keys
+	amounts []Amount
+	expire  base.Height
+}
+
+func NewCreateClaimableBalanceFact(
+	token []byte,
+	sender base.Address,
+	keys Keys,
+	amounts []Amount,
+	expire base.Height,
+) CreateClaimableBalanceFact {
+	fact := CreateClaimableBalanceFact{
+		token:   token,
+		sender:  sender,
+		keys:    keys,
+		amounts: amounts,
+		expire:  expire,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CreateClaimableBalanceFact) WithExpiry(ex Expiry) CreateClaimableBalanceFact {
+	fact.expiringFact = newExpiringFact(CreateClaimableBalanceFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CreateClaimableBalanceFact) Hint() hint.Hint {
+	return fact.factHint(CreateClaimableBalanceFactHint)
+}
+
+func (fact CreateClaimableBalanceFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CreateClaimableBalanceFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateClaimableBalanceFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	bs := make([][]byte, len(fact.amounts)+4)
+	bs[0] = fact.token
+	bs[1] = fact.sender.Bytes()
+	bs[2] = fact.keys.Bytes()
+	bs[3] = fact.expire.Bytes()
+
+	for i := range fact.amounts {
+		bs[i+4] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(append(bs, ext)...)
+}
+
+func (fact CreateClaimableBalanceFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for CreateClaimableBalanceFact")
+	} else if n := len(fact.amounts); n < 1 {
+		return xerrors.Errorf("empty amounts")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.keys,
+		fact.expire,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	founds := map[CurrencyID]struct{}{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return xerrors.Errorf("duplicated currency found, %q", am.Currency())
+		} else {
+			founds[am.Currency()] = struct{}{}
+		}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return xerrors.Errorf("amount should be over zero")
+		}
+	}
+
+	if a, err := fact.Target(); err != nil {
+		return err
+	} else if fact.sender.Equal(a) {
+		return xerrors.Errorf("target address is same with sender, %q", fact.sender)
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CreateClaimableBalanceFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CreateClaimableBalanceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateClaimableBalanceFact) Keys() Keys {
+	return fact.keys
+}
+
+func (fact CreateClaimableBalanceFact) Amounts() []Amount {
+	return fact.amounts
+}
+
+func (fact CreateClaimableBalanceFact) Expire() base.Height {
+	return fact.expire
+}
+
+// Target returns the future address of keys.
+func (fact CreateClaimableBalanceFact) Target() (base.Address, error) {
+	return NewAddressFromKeys(fact.keys)
+}
+
+func (fact CreateClaimableBalanceFact) Addresses() ([]base.Address, error) {
+	if a, err := fact.Target(); err != nil {
+		return nil, err
+	} else {
+		return []base.Address{fact.sender, a}, nil
+	}
+}
+
+type CreateClaimableBalance struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewCreateClaimableBalance(
+	fact CreateClaimableBalanceFact,
+	fs []operation.FactSign,
+	memo string,
+) (CreateClaimableBalance, error) {
+	if bo, err := operation.NewBaseOperationFromFact(CreateClaimableBalanceHint, fact, fs); err != nil {
+		return CreateClaimableBalance{}, err
+	} else {
+		op := CreateClaimableBalance{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op CreateClaimableBalance) Hint() hint.Hint {
+	return CreateClaimableBalanceHint
+}
+
+func (op CreateClaimableBalance) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op CreateClaimableBalance) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op CreateClaimableBalance) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,82 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact CreateClaimableBalanceFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"hash":    fact.h,
+		"token":   fact.token,
+		"sender":  fact.sender,
+		"keys":    fact.keys,
+		"amounts": fact.amounts,
+		"expire":  fact.expire,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
+}
+
+type CreateClaimableBalanceFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	KS bson.Raw            `bson:"keys"`
+	AM []bson.Raw          `bson:"amounts"`
+	EX base.Height         `bson:"expire"`
+	XP *Expiry             `bson:"expiry,omitempty"`
+}
+
+func (fact *CreateClaimableBalanceFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact CreateClaimableBalanceFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ufact.AM))
+	for i := range ufact.AM {
+		ams[i] = ufact.AM[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, ufact.KS, ams, ufact.EX, ufact.XP)
+}
+
+func (op CreateClaimableBalance) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *CreateClaimableBalance) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CreateClaimableBalance{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *CreateClaimableBalanceFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bSender base.AddressDecoder,
+	bks []byte,
+	bams [][]byte,
+	expire base.Height,
+	ex *Expiry,
+) error {
+	var sender base.Address
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		sender = a
+	}
+
+	var keys Keys
+	if hinter, err := enc.DecodeByHint(bks); err != nil {
+		return err
+	} else if k, ok := hinter.(Keys); !ok {
+		return xerrors.Errorf("not Keys: %T", hinter)
+	} else {
+		keys = k
+	}
+
+	ams := make([]Amount, len(bams))
+	for i := range bams {
+		if j, err := DecodeAmount(enc, bams[i]); err != nil {
+			return err
+		} else {
+			ams[i] = j
+		}
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, CreateClaimableBalanceFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.keys = keys
+	fact.amounts = ams
+	fact.expire = expire
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type CreateClaimableBalanceFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	KS Keys           `json:"keys"`
+	AM []Amount       `json:"amounts"`
+	EX base.Height    `json:"expire"`
+	XP *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact CreateClaimableBalanceFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(CreateClaimableBalanceFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		KS:         fact.keys,
+		AM:         fact.amounts,
+		EX:         fact.expire,
+		XP:         ex,
+	})
+}
+
+type CreateClaimableBalanceFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	KS json.RawMessage     `json:"keys"`
+	AM []json.RawMessage   `json:"amounts"`
+	EX base.Height         `json:"expire"`
+	XP *Expiry             `json:"expiry,omitempty"`
+}
+
+func (fact *CreateClaimableBalanceFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact CreateClaimableBalanceFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ufact.AM))
+	for i := range ufact.AM {
+		ams[i] = ufact.AM[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, ufact.KS, ams, ufact.EX, ufact.XP)
+}
+
+func (op CreateClaimableBalance) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *CreateClaimableBalance) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = CreateClaimableBalance{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
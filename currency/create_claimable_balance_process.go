@@ -0,0 +1,177 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var MaxClaimableBalances = 100
+
+func (op CreateClaimableBalance) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type CreateClaimableBalanceProcessor struct {
+	cp *CurrencyPool
+	CreateClaimableBalance
+	height   base.Height
+	sb       map[CurrencyID]AmountState
+	cs       state.State
+	cbs      []ClaimableBalance
+	required map[CurrencyID][2]Big
+	ss       []state.State
+}
+
+func NewCreateClaimableBalanceProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(CreateClaimableBalance); !ok {
+			return nil, xerrors.Errorf("not CreateClaimableBalance, %T", op)
+		} else {
+			return &CreateClaimableBalanceProcessor{
+				cp:                     cp,
+				CreateClaimableBalance: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *CreateClaimableBalanceProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *CreateClaimableBalanceProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CreateClaimableBalanceProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CreateClaimableBalanceFact)
+
+	if fact.expire <= opp.height {
+		return nil, operation.NewBaseReasonError("expire height, %v should be over current height, %v",
+			fact.expire, opp.height)
+	}
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	var target base.Address
+	if a, err := fact.Target(); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else {
+		target = a
+	}
+
+	if _, err := notExistsState(StateKeyAccount(target), "target account", getState); err != nil {
+		return nil, err
+	} else if err := checkNotClosedAccount(target, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkTransferAmounts(opp.cp, fact.amounts); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, []AmountsItem{fact}); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckEnoughBalance(opp.cp, fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if ss, err := checkSpendingLimits(fact.sender, opp.height, opp.required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	if st, cbs, err := loadClaimableBalances(target, getState); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else if len(cbs) >= MaxClaimableBalances {
+		return nil, operation.NewBaseReasonError("too many claimable balances of target, %d", len(cbs))
+	} else {
+		opp.cs = st
+		opp.cbs = cbs
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *CreateClaimableBalanceProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CreateClaimableBalanceFact)
+
+	cbs := make([]ClaimableBalance, len(opp.cbs)+1)
+	copy(cbs, opp.cbs)
+	cbs[len(opp.cbs)] = NewClaimableBalance(fact.Hash(), fact.sender, fact.amounts, fact.expire)
+
+	var sts []state.State // nolint:prealloc
+	if st, err := SetStateClaimableBalancesValue(opp.cs, cbs); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	sts = append(sts, opp.ss...)
+
+	return setState(fact.Hash(), sts...)
+}
+
+func loadClaimableBalances(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, []ClaimableBalance, error) {
+	switch st, found, err := getState(StateKeyClaimableBalance(a)); {
+	case err != nil:
+		return nil, nil, err
+	case !found || st.Value() == nil:
+		return st, nil, nil
+	default:
+		if cbs, err := StateClaimableBalancesValue(st); err != nil {
+			return nil, nil, err
+		} else {
+			return st, cbs, nil
+		}
+	}
+}
+
+func sumClaimableBalances(cbs []ClaimableBalance) map[CurrencyID]Big {
+	m := map[CurrencyID]Big{}
+	for i := range cbs {
+		ams := cbs[i].Amounts()
+		for j := range ams {
+			am := ams[j]
+			if k, found := m[am.Currency()]; found {
+				m[am.Currency()] = k.Add(am.Big())
+			} else {
+				m[am.Currency()] = am.Big()
+			}
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,506 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testClaimableBalanceOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testClaimableBalanceOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(CreateClaimableBalance{}, NewCreateClaimableBalanceProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(ClaimBalance{}, NewClaimBalanceProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(ReclaimBalance{}, NewReclaimBalanceProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(CreateAccounts{}, NewCreateAccountsProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testClaimableBalanceOperations) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testClaimableBalanceOperations) newCreate(
+	sender base.Address, keys Keys, amounts []Amount, expire base.Height, pks []key.Privatekey,
+) CreateClaimableBalance {
+	fact := NewCreateClaimableBalanceFact(util.UUID().Bytes(), sender, keys, amounts, expire)
+
+	op, err := NewCreateClaimableBalance(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testClaimableBalanceOperations) newClaimableState(a base.Address, cbs []ClaimableBalance) state.State {
+	st, err := state.NewStateV0(StateKeyClaimableBalance(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateClaimableBalancesValue(st, cbs)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testClaimableBalanceOperations) updated(pool *storage.Statepool) (map[string]Amount, []ClaimableBalance, bool) {
+	balances := map[string]Amount{}
+	var cbs []ClaimableBalance
+	var foundClaimable bool
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+		switch {
+		case IsStateBalanceKey(st.Key()):
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			balances[st.Key()] = am
+		case IsStateClaimableBalanceKey(st.Key()):
+			i, err := StateClaimableBalancesValue(st)
+			t.NoError(err)
+			cbs = i
+			foundClaimable = true
+		}
+	}
+
+	return balances, cbs, foundClaimable
+}
+
+func (t *testClaimableBalanceOperations) TestCreate() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	op := t.newCreate(sa.Address, na.Keys(), []Amount{am}, base.Height(10), sa.Privs())
+	t.NoError(opr.Process(op))
+
+	balances, cbs, found := t.updated(pool)
+	t.True(found)
+	t.Equal(1, len(cbs))
+	t.True(cbs[0].Fact().Equal(op.Fact().Hash()))
+	t.True(cbs[0].Sender().Equal(sa.Address))
+	t.Equal(base.Height(10), cbs[0].Expire())
+	t.True(cbs[0].Amounts()[0].Equal(am))
+
+	t.True(NewBig(33).Sub(am.Big()).Sub(fee).Equal(balances[StateKeyBalance(sa.Address, t.cid)].Big()))
+}
+
+func (t *testClaimableBalanceOperations) TestCreateTransferLimits() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+
+	pool, _ := t.statepool(st)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, ZeroBig)).WithTransferLimits(NewBig(5), NewBig(10))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	for _, c := range []struct {
+		big int64
+		err string
+	}{
+		{4, "under min transfer amount"},
+		{11, "over max transfer amount"},
+		{5, ""},
+		{10, ""},
+	} {
+		na, _ := t.newAccount(false, nil)
+
+		am := NewAmount(NewBig(c.big), t.cid)
+		err := opr.Process(t.newCreate(sa.Address, na.Keys(), []Amount{am}, base.Height(10), sa.Privs()))
+
+		if len(c.err) < 1 {
+			t.NoError(err, "amount=%d", c.big)
+
+			continue
+		}
+
+		var oper operation.ReasonError
+		t.True(xerrors.As(err, &oper))
+		t.Contains(err.Error(), c.err, "amount=%d", c.big)
+	}
+}
+
+func (t *testClaimableBalanceOperations) TestCreateExistingTarget() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, nst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st, nst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCreate(sa.Address, na.Keys(), []Amount{NewAmount(NewBig(10), t.cid)}, base.Height(10), sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "target account already exists")
+}
+
+func (t *testClaimableBalanceOperations) TestCreateExpired() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCreate(sa.Address, na.Keys(), []Amount{NewAmount(NewBig(10), t.cid)}, pool.Height(), sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "should be over current height")
+}
+
+func (t *testClaimableBalanceOperations) TestCreateAccountsMovesClaimable() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	cid := CurrencyID("FINDME")
+	cb := NewClaimableBalance(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		[]Amount{NewAmount(NewBig(5), t.cid), NewAmount(NewBig(7), cid)},
+		base.Height(10),
+	)
+
+	pool, _ := t.statepool(st, []state.State{t.newClaimableState(na.Address, []ClaimableBalance{cb})})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewCreateAccountsFact(util.UUID().Bytes(), sa.Address, []CreateAccountsItem{
+		NewCreateAccountsItemSingleAmount(na.Keys(), NewAmount(NewBig(10), t.cid)),
+	})
+	op, err := NewCreateAccounts(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	balances, cbs, found := t.updated(pool)
+	t.True(found)
+	t.Empty(cbs)
+
+	t.True(NewBig(15).Equal(balances[StateKeyBalance(na.Address, t.cid)].Big()))
+	t.True(NewBig(7).Equal(balances[StateKeyBalance(na.Address, cid)].Big()))
+}
+
+func (t *testClaimableBalanceOperations) newClaim(keys Keys, pks []key.Privatekey) ClaimBalance {
+	fact := NewClaimBalanceFact(util.UUID().Bytes(), keys)
+
+	op, err := NewClaimBalance(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testClaimableBalanceOperations) TestClaim() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	cb := NewClaimableBalance(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		[]Amount{NewAmount(NewBig(5), t.cid)},
+		base.Height(10),
+	)
+
+	pool, _ := t.statepool(st, []state.State{t.newClaimableState(na.Address, []ClaimableBalance{cb, cb})})
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newClaim(na.Keys(), na.Privs())))
+
+	balances, cbs, found := t.updated(pool)
+	t.True(found)
+	t.Empty(cbs)
+
+	t.True(NewBig(10 - 1).Equal(balances[StateKeyBalance(na.Address, t.cid)].Big()))
+
+	var nkeys Keys
+	for _, stu := range pool.Updates() {
+		if st := stu.GetState(); st.Key() == StateKeyAccount(na.Address) {
+			ac, err := LoadStateAccountValue(st)
+			t.NoError(err)
+			nkeys = ac.Keys()
+		}
+	}
+
+	t.True(na.Keys().Equal(nkeys))
+}
+
+func (t *testClaimableBalanceOperations) TestClaimUnderNewAccountMinBalance() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	cb := NewClaimableBalance(
+		valuehash.RandomSHA256(),
+		NewTestAddress(),
+		[]Amount{NewAmount(NewBig(5), t.cid)},
+		base.Height(10),
+	)
+
+	pool, _ := t.statepool(st, []state.State{t.newClaimableState(na.Address, []ClaimableBalance{cb})})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), sa.Address,
+		NewCurrencyPolicy(NewBig(5), NewFixedFeeer(sa.Address, NewBig(1))))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(na.Keys(), na.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "amount should be over minimum balance, 4 < 5")
+	t.Empty(pool.Updates())
+}
+
+func (t *testClaimableBalanceOperations) TestClaimEmpty() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(na.Keys(), na.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "claimable balance of owner does not exist")
+}
+
+func (t *testClaimableBalanceOperations) TestClaimExistingAccount() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	cb := NewClaimableBalance(
+		valuehash.RandomSHA256(), NewTestAddress(), []Amount{NewAmount(NewBig(5), t.cid)}, base.Height(10),
+	)
+
+	pool, _ := t.statepool(st, []state.State{t.newClaimableState(sa.Address, []ClaimableBalance{cb})})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(sa.Keys(), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "keys of owner already exists")
+}
+
+func (t *testClaimableBalanceOperations) TestClaimWrongSigns() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	cb := NewClaimableBalance(
+		valuehash.RandomSHA256(), NewTestAddress(), []Amount{NewAmount(NewBig(5), t.cid)}, base.Height(10),
+	)
+
+	pool, _ := t.statepool(st, []state.State{t.newClaimableState(na.Address, []ClaimableBalance{cb})})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(na.Keys(), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testClaimableBalanceOperations) TestCreateAndClaim() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	pool, _ := t.statepool(st)
+
+	opr := t.processor(cp, pool)
+	t.NoError(opr.Process(
+		t.newCreate(sa.Address, na.Keys(), []Amount{NewAmount(NewBig(10), t.cid)}, base.Height(10), sa.Privs()),
+	))
+
+	// NOTE the next block starts from the states of the previous block.
+	var sts []state.State
+	for _, stu := range pool.Updates() {
+		sts = append(sts, stu.GetState())
+	}
+
+	npool, _ := t.statepool(st, sts)
+
+	nopr := t.processor(cp, npool)
+	t.NoError(nopr.Process(t.newClaim(na.Keys(), na.Privs())))
+
+	balances, cbs, found := t.updated(npool)
+	t.True(found)
+	t.Empty(cbs)
+	t.True(NewBig(10).Equal(balances[StateKeyBalance(na.Address, t.cid)].Big()))
+
+	var created bool
+	for _, stu := range npool.Updates() {
+		if stu.Key() == StateKeyAccount(na.Address) {
+			created = true
+		}
+	}
+
+	t.True(created)
+}
+
+func (t *testClaimableBalanceOperations) TestReclaim() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	target := NewTestAddress()
+
+	expired := NewClaimableBalance(
+		valuehash.RandomSHA256(), sa.Address, []Amount{NewAmount(NewBig(5), t.cid)}, base.Height(0),
+	)
+	notExpired := NewClaimableBalance(
+		valuehash.RandomSHA256(), sa.Address, []Amount{NewAmount(NewBig(6), t.cid)}, base.Height(10),
+	)
+	others := NewClaimableBalance(
+		valuehash.RandomSHA256(), NewTestAddress(), []Amount{NewAmount(NewBig(7), t.cid)}, base.Height(0),
+	)
+
+	pool, _ := t.statepool(st, []state.State{
+		t.newClaimableState(target, []ClaimableBalance{expired, notExpired, others}),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewReclaimBalanceFact(util.UUID().Bytes(), sa.Address, target)
+	op, err := NewReclaimBalance(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	balances, cbs, found := t.updated(pool)
+	t.True(found)
+	t.Equal(2, len(cbs))
+	t.True(cbs[0].Fact().Equal(notExpired.Fact()))
+	t.True(cbs[1].Fact().Equal(others.Fact()))
+
+	t.True(NewBig(38).Equal(balances[StateKeyBalance(sa.Address, t.cid)].Big()))
+}
+
+func (t *testClaimableBalanceOperations) TestReclaimNotExpired() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	target := NewTestAddress()
+
+	cb := NewClaimableBalance(
+		valuehash.RandomSHA256(), sa.Address, []Amount{NewAmount(NewBig(6), t.cid)}, base.Height(10),
+	)
+
+	pool, _ := t.statepool(st, []state.State{t.newClaimableState(target, []ClaimableBalance{cb})})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewReclaimBalanceFact(util.UUID().Bytes(), sa.Address, target)
+	op, err := NewReclaimBalance(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "expired claimable balance of sender does not exist")
+}
+
+func (t *testClaimableBalanceOperations) TestDuplicatedTargetInProposal() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st, stb)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op0 := t.newCreate(sa.Address, na.Keys(), []Amount{NewAmount(NewBig(1), t.cid)}, base.Height(10), sa.Privs())
+	op1 := t.newCreate(sb.Address, na.Keys(), []Amount{NewAmount(NewBig(1), t.cid)}, base.Height(10), sb.Privs())
+
+	t.NoError(opr.Process(op0))
+
+	err := opr.Process(op1)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "already processed")
+}
+
+func TestClaimableBalanceOperations(t *testing.T) {
+	suite.Run(t, new(testClaimableBalanceOperations))
+}
@@ -0,0 +1,143 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testCreateClaimableBalance struct {
+	baseTest
+}
+
+func (t *testCreateClaimableBalance) newFact(amounts []Amount) (CreateClaimableBalanceFact, key.Privatekey) {
+	spk := key.MustNewBTCPrivatekey()
+	skey, err := NewKey(spk.Publickey(), 100)
+	t.NoError(err)
+	skeys, err := NewKeys([]Key{skey}, 100)
+	t.NoError(err)
+	sender, err := NewAddressFromKeys(skeys)
+	t.NoError(err)
+
+	nkey, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	return NewCreateClaimableBalanceFact(util.UUID().Bytes(), sender, nkeys, amounts, base.Height(33)), spk
+}
+
+func (t *testCreateClaimableBalance) TestNew() {
+	fact, spk := t.newFact([]Amount{NewAmount(NewBig(10), t.cid)})
+
+	sig, err := operation.NewFactSignature(spk, fact, nil)
+	t.NoError(err)
+	fs := []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}
+
+	op, err := NewCreateClaimableBalance(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	target, err := fact.Target()
+	t.NoError(err)
+
+	expected, err := NewAddressFromKeys(fact.Keys())
+	t.NoError(err)
+	t.True(expected.Equal(target))
+}
+
+func (t *testCreateClaimableBalance) TestDuplicatedCurrency() {
+	fact, _ := t.newFact([]Amount{NewAmount(NewBig(10), t.cid), NewAmount(NewBig(11), t.cid)})
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated currency found")
+}
+
+func (t *testCreateClaimableBalance) TestZeroAmount() {
+	fact, _ := t.newFact([]Amount{NewAmount(NewBig(0), t.cid)})
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func TestCreateClaimableBalance(t *testing.T) {
+	suite.Run(t, new(testCreateClaimableBalance))
+}
+
+func testCreateClaimableBalanceEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		spk := key.MustNewBTCPrivatekey()
+		skey, err := NewKey(spk.Publickey(), 100)
+		t.NoError(err)
+		skeys, err := NewKeys([]Key{skey}, 100)
+		t.NoError(err)
+		sender, err := NewAddressFromKeys(skeys)
+		t.NoError(err)
+
+		nkey, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+		t.NoError(err)
+		nkeys, err := NewKeys([]Key{nkey}, 100)
+		t.NoError(err)
+
+		fact := NewCreateClaimableBalanceFact(
+			util.UUID().Bytes(),
+			sender,
+			nkeys,
+			[]Amount{NewAmount(NewBig(10), CurrencyID("SEEME"))},
+			base.Height(33),
+		)
+		sig, err := operation.NewFactSignature(spk, fact, nil)
+		t.NoError(err)
+		fs := []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}
+
+		op, err := NewCreateClaimableBalance(fact, fs, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(CreateClaimableBalance)
+		cb := b.(CreateClaimableBalance)
+
+		t.Equal(ca.Memo, cb.Memo)
+
+		fact := ca.Fact().(CreateClaimableBalanceFact)
+		ufact := cb.Fact().(CreateClaimableBalanceFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.keys.Equal(ufact.keys))
+		t.Equal(fact.expire, ufact.expire)
+		t.Equal(len(fact.amounts), len(ufact.amounts))
+		for i := range fact.amounts {
+			t.True(fact.amounts[i].Equal(ufact.amounts[i]))
+		}
+	}
+
+	return t
+}
+
+func TestCreateClaimableBalanceEncodeJSON(t *testing.T) {
+	suite.Run(t, testCreateClaimableBalanceEncode(jsonenc.NewEncoder()))
+}
+
+func TestCreateClaimableBalanceEncodeBSON(t *testing.T) {
+	suite.Run(t, testCreateClaimableBalanceEncode(bsonenc.NewEncoder()))
+}
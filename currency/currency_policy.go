@@ -1,31 +1,92 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
 	"golang.org/x/xerrors"
 )
 
 var (
-	CurrencyPolicyType = hint.MustNewType(0xa0, 0x36, "mitum-currency-currency-policy")
-	CurrencyPolicyHint = hint.MustHint(CurrencyPolicyType, "0.0.1")
+	CurrencyPolicyType        = hint.MustNewType(0xa0, 0x36, "mitum-currency-currency-policy")
+	CurrencyPolicyHint        = hint.MustHint(CurrencyPolicyType, "0.0.1")
+	PendingCurrencyPolicyType = hint.MustNewType(0xa0, 0x6e, "mitum-currency-pending-currency-policy")
+	PendingCurrencyPolicyHint = hint.MustHint(PendingCurrencyPolicyType, "0.0.1")
+)
+
+var (
+	CurrencyPolicyMinBalanceHint   = hint.MustHint(CurrencyPolicyType, "0.0.2")
+	CurrencyPolicyMinBalanceHinter = CurrencyPolicy{hint: CurrencyPolicyMinBalanceHint}
+)
+
+var (
+	CurrencyPolicyTransferLimitsHint   = hint.MustHint(CurrencyPolicyType, "0.0.3")
+	CurrencyPolicyTransferLimitsHinter = CurrencyPolicy{hint: CurrencyPolicyTransferLimitsHint}
 )
 
 type CurrencyPolicy struct {
+	hint                 hint.Hint
 	newAccountMinBalance Big
 	feeer                Feeer
+	minBalance           Big
+	minTransferAmount    Big
+	maxTransferAmount    Big
 }
 
 func NewCurrencyPolicy(newAccountMinBalance Big, feeer Feeer) CurrencyPolicy {
 	return CurrencyPolicy{newAccountMinBalance: newAccountMinBalance, feeer: feeer}
 }
 
+// WithMinBalance returns the CurrencyPolicy with the minimum retained balance;
+// the balance of account can not be spent under it, except by AccountMerge.
+func (po CurrencyPolicy) WithMinBalance(b Big) CurrencyPolicy {
+	if !po.HasTransferLimits() {
+		po.hint = CurrencyPolicyMinBalanceHint
+	}
+
+	po.minBalance = b
+
+	return po
+}
+
+// WithTransferLimits returns the CurrencyPolicy with the min and max amount of
+// transfer; zero means no limit. The min balance is kept.
+func (po CurrencyPolicy) WithTransferLimits(min, max Big) CurrencyPolicy {
+	po.hint = CurrencyPolicyTransferLimitsHint
+	po.minBalance = po.MinBalance()
+	po.minTransferAmount = min
+	po.maxTransferAmount = max
+
+	return po
+}
+
 func (po CurrencyPolicy) Hint() hint.Hint {
-	return CurrencyPolicyHint
+	switch {
+	case po.hint.Equal(CurrencyPolicyTransferLimitsHint):
+		return CurrencyPolicyTransferLimitsHint
+	case po.hint.Equal(CurrencyPolicyMinBalanceHint):
+		return CurrencyPolicyMinBalanceHint
+	default:
+		return CurrencyPolicyHint
+	}
 }
 
 func (po CurrencyPolicy) Bytes() []byte {
-	return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes())
+	var ex []byte
+	switch ht := po.Hint(); {
+	case ht.Equal(CurrencyPolicyTransferLimitsHint):
+		ex = util.ConcatBytesSlice(
+			ht.Bytes(),
+			po.minBalance.Bytes(),
+			po.minTransferAmount.Bytes(),
+			po.maxTransferAmount.Bytes(),
+		)
+	case ht.Equal(CurrencyPolicyMinBalanceHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), po.minBalance.Bytes())
+	}
+
+	return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes(), ex)
 }
 
 func (po CurrencyPolicy) IsValid([]byte) error {
@@ -33,6 +94,21 @@ func (po CurrencyPolicy) IsValid([]byte) error {
 		return xerrors.Errorf("NewAccountMinBalance under zero")
 	}
 
+	if po.HasMinBalance() && !po.minBalance.OverNil() {
+		return xerrors.Errorf("MinBalance under zero")
+	}
+
+	if po.HasTransferLimits() {
+		switch {
+		case !po.minTransferAmount.OverNil():
+			return xerrors.Errorf("MinTransferAmount under zero")
+		case !po.maxTransferAmount.OverNil():
+			return xerrors.Errorf("MaxTransferAmount under zero")
+		case po.maxTransferAmount.OverZero() && po.minTransferAmount.Compare(po.maxTransferAmount) > 0:
+			return xerrors.Errorf("MinTransferAmount over MaxTransferAmount")
+		}
+	}
+
 	if err := po.feeer.IsValid(nil); err != nil {
 		return err
 	}
@@ -47,3 +123,132 @@ func (po CurrencyPolicy) NewAccountMinBalance() Big {
 func (po CurrencyPolicy) Feeer() Feeer {
 	return po.feeer
 }
+
+// MinBalance returns the minimum retained balance; without it, ZeroBig is
+// returned.
+func (po CurrencyPolicy) MinBalance() Big {
+	if !po.HasMinBalance() || po.minBalance.Int == nil {
+		return ZeroBig
+	}
+
+	return po.minBalance
+}
+
+func (po CurrencyPolicy) HasMinBalance() bool {
+	ht := po.Hint()
+
+	return ht.Equal(CurrencyPolicyMinBalanceHint) || ht.Equal(CurrencyPolicyTransferLimitsHint)
+}
+
+// MinTransferAmount returns the min amount of transfer; ZeroBig means no
+// limit.
+func (po CurrencyPolicy) MinTransferAmount() Big {
+	if !po.HasTransferLimits() || po.minTransferAmount.Int == nil {
+		return ZeroBig
+	}
+
+	return po.minTransferAmount
+}
+
+// MaxTransferAmount returns the max amount of transfer; ZeroBig means no
+// limit.
+func (po CurrencyPolicy) MaxTransferAmount() Big {
+	if !po.HasTransferLimits() || po.maxTransferAmount.Int == nil {
+		return ZeroBig
+	}
+
+	return po.maxTransferAmount
+}
+
+func (po CurrencyPolicy) HasTransferLimits() bool {
+	return po.Hint().Equal(CurrencyPolicyTransferLimitsHint)
+}
+
+// CheckTransferAmount checks the amount of transfer is in the transfer limits.
+func (po CurrencyPolicy) CheckTransferAmount(big Big) error {
+	if min := po.MinTransferAmount(); min.OverZero() && big.Compare(min) < 0 {
+		return xerrors.Errorf("amount under min transfer amount; %d < %d", big, min)
+	}
+
+	if max := po.MaxTransferAmount(); max.OverZero() && big.Compare(max) > 0 {
+		return xerrors.Errorf("amount over max transfer amount; %d > %d", big, max)
+	}
+
+	return nil
+}
+
+// PendingCurrencyPolicy is the CurrencyPolicy scheduled by
+// CurrencyPolicyUpdater; it replaces the policy of currency design from the
+// height. After it is applied, it is kept with the applied mark.
+type PendingCurrencyPolicy struct {
+	cid     CurrencyID
+	policy  CurrencyPolicy
+	height  base.Height
+	applied bool
+}
+
+func NewPendingCurrencyPolicy(cid CurrencyID, policy CurrencyPolicy, height base.Height) PendingCurrencyPolicy {
+	return PendingCurrencyPolicy{cid: cid, policy: policy, height: height}
+}
+
+func (pp PendingCurrencyPolicy) Hint() hint.Hint {
+	return PendingCurrencyPolicyHint
+}
+
+func (pp PendingCurrencyPolicy) Bytes() []byte {
+	var applied byte
+	if pp.applied {
+		applied = 1
+	}
+
+	return util.ConcatBytesSlice(
+		pp.cid.Bytes(),
+		pp.policy.Bytes(),
+		pp.height.Bytes(),
+		[]byte{applied},
+	)
+}
+
+func (pp PendingCurrencyPolicy) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(pp.Bytes())
+}
+
+func (pp PendingCurrencyPolicy) IsValid([]byte) error {
+	if err := pp.cid.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid PendingCurrencyPolicy: %w", err)
+	}
+
+	if err := pp.policy.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid PendingCurrencyPolicy: %w", err)
+	}
+
+	return pp.height.IsValid(nil)
+}
+
+func (pp PendingCurrencyPolicy) Currency() CurrencyID {
+	return pp.cid
+}
+
+func (pp PendingCurrencyPolicy) Policy() CurrencyPolicy {
+	return pp.policy
+}
+
+func (pp PendingCurrencyPolicy) Height() base.Height {
+	return pp.height
+}
+
+func (pp PendingCurrencyPolicy) IsApplied() bool {
+	return pp.applied
+}
+
+// IsActivated returns true when the policy is not yet applied and it can be
+// applied at the given height.
+func (pp PendingCurrencyPolicy) IsActivated(height base.Height) bool {
+	return !pp.applied && height >= pp.height
+}
+
+func (pp PendingCurrencyPolicy) SetApplied() PendingCurrencyPolicy {
+	pp.applied = true
+
+	return pp
+}
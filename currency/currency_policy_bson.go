@@ -3,29 +3,74 @@ package currency
 import (
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/spikeekips/mitum/base"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 )
 
 func (po CurrencyPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bsonenc.MergeBSONM(
-		bsonenc.NewHintedDoc(po.Hint()),
-		bson.M{
-			"new_account_min_balance": po.newAccountMinBalance,
-			"feeer":                   po.feeer,
-		}),
-	)
+	m := bson.M{
+		"new_account_min_balance": po.newAccountMinBalance,
+		"feeer":                   po.feeer,
+	}
+
+	if po.HasMinBalance() {
+		m["min_balance"] = po.minBalance
+	}
+
+	if po.HasTransferLimits() {
+		m["min_transfer_amount"] = po.minTransferAmount
+		m["max_transfer_amount"] = po.maxTransferAmount
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(po.Hint()), m))
 }
 
 type CurrencyPolicyBSONUnpacker struct {
 	MN Big      `bson:"new_account_min_balance"`
 	FE bson.Raw `bson:"feeer"`
+	MB Big      `bson:"min_balance,omitempty"`
+	MI Big      `bson:"min_transfer_amount,omitempty"`
+	MX Big      `bson:"max_transfer_amount,omitempty"`
 }
 
 func (po *CurrencyPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var upo CurrencyPolicyBSONUnpacker
 	if err := enc.Unmarshal(b, &upo); err != nil {
 		return err
 	}
 
-	return po.unpack(enc, upo.MN, upo.FE)
+	return po.unpack(enc, ht.H, upo.MN, upo.FE, upo.MB, upo.MI, upo.MX)
+}
+
+func (pp PendingCurrencyPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(pp.Hint()),
+		bson.M{
+			"currency": pp.cid,
+			"policy":   pp.policy,
+			"height":   pp.height,
+			"applied":  pp.applied,
+		}),
+	)
+}
+
+type PendingCurrencyPolicyBSONUnpacker struct {
+	CI string      `bson:"currency"`
+	PO bson.Raw    `bson:"policy"`
+	HT base.Height `bson:"height"`
+	AP bool        `bson:"applied"`
+}
+
+func (pp *PendingCurrencyPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upp PendingCurrencyPolicyBSONUnpacker
+	if err := enc.Unmarshal(b, &upp); err != nil {
+		return err
+	}
+
+	return pp.unpack(enc, upp.CI, upp.PO, upp.HT, upp.AP)
 }
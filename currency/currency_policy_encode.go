@@ -1,10 +1,20 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
-func (po *CurrencyPolicy) unpack(enc encoder.Encoder, mn Big, bfe []byte) error {
+func (po *CurrencyPolicy) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	mn Big,
+	bfe []byte,
+	mb Big,
+	mint Big,
+	maxt Big,
+) error {
 	if i, err := DecodeFeeer(enc, bfe); err != nil {
 		return err
 	} else {
@@ -13,5 +23,37 @@ func (po *CurrencyPolicy) unpack(enc encoder.Encoder, mn Big, bfe []byte) error
 
 	po.newAccountMinBalance = mn
 
+	switch {
+	case ht.Equal(CurrencyPolicyTransferLimitsHint):
+		po.hint = ht
+		po.minBalance = mb
+		po.minTransferAmount = mint
+		po.maxTransferAmount = maxt
+	case ht.Equal(CurrencyPolicyMinBalanceHint):
+		po.hint = ht
+		po.minBalance = mb
+	}
+
+	return nil
+}
+
+func (pp *PendingCurrencyPolicy) unpack(
+	enc encoder.Encoder,
+	scid string,
+	bpo []byte,
+	height base.Height,
+	applied bool,
+) error {
+	pp.cid = CurrencyID(scid)
+
+	if i, err := DecodeCurrencyPolicy(enc, bpo); err != nil {
+		return err
+	} else {
+		pp.policy = i
+	}
+
+	pp.height = height
+	pp.applied = applied
+
 	return nil
 }
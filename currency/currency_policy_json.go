@@ -3,6 +3,7 @@ package currency
 import (
 	"encoding/json"
 
+	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 )
 
@@ -10,26 +11,85 @@ type CurrencyPolicyJSONPacker struct {
 	jsonenc.HintedHead
 	MN Big   `json:"new_account_min_balance"`
 	FE Feeer `json:"feeer"`
+	MB *Big  `json:"min_balance,omitempty"`
+	MI *Big  `json:"min_transfer_amount,omitempty"`
+	MX *Big  `json:"max_transfer_amount,omitempty"`
 }
 
 func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
+	var mb *Big
+	if po.HasMinBalance() {
+		mb = &po.minBalance
+	}
+
+	var mint, maxt *Big
+	if po.HasTransferLimits() {
+		mint = &po.minTransferAmount
+		maxt = &po.maxTransferAmount
+	}
+
 	return jsonenc.Marshal(CurrencyPolicyJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(po.Hint()),
 		MN:         po.newAccountMinBalance,
 		FE:         po.feeer,
+		MB:         mb,
+		MI:         mint,
+		MX:         maxt,
 	})
 }
 
 type CurrencyPolicyJSONUnpacker struct {
 	MN Big             `json:"new_account_min_balance"`
 	FE json.RawMessage `json:"feeer"`
+	MB Big             `json:"min_balance"`
+	MI Big             `json:"min_transfer_amount"`
+	MX Big             `json:"max_transfer_amount"`
 }
 
 func (po *CurrencyPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var upo CurrencyPolicyJSONUnpacker
 	if err := enc.Unmarshal(b, &upo); err != nil {
 		return err
 	}
 
-	return po.unpack(enc, upo.MN, upo.FE)
+	return po.unpack(enc, ht.H, upo.MN, upo.FE, upo.MB, upo.MI, upo.MX)
+}
+
+type PendingCurrencyPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	CI CurrencyID     `json:"currency"`
+	PO CurrencyPolicy `json:"policy"`
+	HT base.Height    `json:"height"`
+	AP bool           `json:"applied"`
+}
+
+func (pp PendingCurrencyPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(PendingCurrencyPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(pp.Hint()),
+		CI:         pp.cid,
+		PO:         pp.policy,
+		HT:         pp.height,
+		AP:         pp.applied,
+	})
+}
+
+type PendingCurrencyPolicyJSONUnpacker struct {
+	CI string          `json:"currency"`
+	PO json.RawMessage `json:"policy"`
+	HT base.Height     `json:"height"`
+	AP bool            `json:"applied"`
+}
+
+func (pp *PendingCurrencyPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upp PendingCurrencyPolicyJSONUnpacker
+	if err := enc.Unmarshal(b, &upp); err != nil {
+		return err
+	}
+
+	return pp.unpack(enc, upp.CI, upp.PO, upp.HT, upp.AP)
 }
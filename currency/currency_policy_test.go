@@ -3,6 +3,7 @@ package currency
 import (
 	"testing"
 
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
@@ -25,6 +26,65 @@ func (t *testCurrencyPolicy) TestInValidNewAccountMinBalance() {
 	t.Contains(err.Error(), "NewAccountMinBalance under zero")
 }
 
+func (t *testCurrencyPolicy) TestMinBalance() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+	t.False(po.HasMinBalance())
+	t.True(ZeroBig.Equal(po.MinBalance()))
+
+	mpo := po.WithMinBalance(NewBig(33))
+	t.NoError(mpo.IsValid(nil))
+	t.True(mpo.HasMinBalance())
+	t.True(mpo.Hint().Equal(CurrencyPolicyMinBalanceHint))
+	t.True(NewBig(33).Equal(mpo.MinBalance()))
+	t.NotEqual(po.Bytes(), mpo.Bytes())
+}
+
+func (t *testCurrencyPolicy) TestInValidMinBalance() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).WithMinBalance(NewBig(-1))
+	err := po.IsValid(nil)
+	t.Contains(err.Error(), "MinBalance under zero")
+}
+
+func (t *testCurrencyPolicy) TestTransferLimits() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).WithMinBalance(NewBig(3))
+	t.False(po.HasTransferLimits())
+	t.NoError(po.CheckTransferAmount(NewBig(1)))
+
+	lpo := po.WithTransferLimits(NewBig(10), NewBig(100))
+	t.NoError(lpo.IsValid(nil))
+	t.True(lpo.Hint().Equal(CurrencyPolicyTransferLimitsHint))
+	t.True(lpo.HasMinBalance())
+	t.True(NewBig(3).Equal(lpo.MinBalance()))
+	t.True(NewBig(10).Equal(lpo.MinTransferAmount()))
+	t.True(NewBig(100).Equal(lpo.MaxTransferAmount()))
+
+	// NOTE min balance does not change the hint
+	t.True(lpo.WithMinBalance(NewBig(4)).Hint().Equal(CurrencyPolicyTransferLimitsHint))
+
+	t.NoError(lpo.CheckTransferAmount(NewBig(10)))
+	t.NoError(lpo.CheckTransferAmount(NewBig(100)))
+	t.Contains(lpo.CheckTransferAmount(NewBig(9)).Error(), "under min transfer amount")
+	t.Contains(lpo.CheckTransferAmount(NewBig(101)).Error(), "over max transfer amount")
+
+	// NOTE zero max means no limit
+	npo := po.WithTransferLimits(NewBig(10), ZeroBig)
+	t.NoError(npo.IsValid(nil))
+	t.NoError(npo.CheckTransferAmount(NewBig(1000000)))
+}
+
+func (t *testCurrencyPolicy) TestInValidTransferLimits() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+
+	err := po.WithTransferLimits(NewBig(-1), ZeroBig).IsValid(nil)
+	t.Contains(err.Error(), "MinTransferAmount under zero")
+
+	err = po.WithTransferLimits(ZeroBig, NewBig(-1)).IsValid(nil)
+	t.Contains(err.Error(), "MaxTransferAmount under zero")
+
+	err = po.WithTransferLimits(NewBig(11), NewBig(10)).IsValid(nil)
+	t.Contains(err.Error(), "MinTransferAmount over MaxTransferAmount")
+}
+
 func TestCurrencyPolicy(t *testing.T) {
 	suite.Run(t, new(testCurrencyPolicy))
 }
@@ -56,3 +116,91 @@ func TestCurrencyPolicyEncodeJSON(t *testing.T) {
 func TestCurrencyPolicyEncodeBSON(t *testing.T) {
 	suite.Run(t, testCurrencyPolicyEncode(bsonenc.NewEncoder()))
 }
+
+func testCurrencyPolicyMinBalanceEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(33))).
+			WithMinBalance(NewBig(44))
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(CurrencyPolicy)
+		cb := b.(CurrencyPolicy)
+
+		t.Equal(ca, cb)
+		t.True(cb.Hint().Equal(CurrencyPolicyMinBalanceHint))
+		t.Equal(ca.Bytes(), cb.Bytes())
+	}
+
+	return t
+}
+
+func TestCurrencyPolicyMinBalanceEncodeJSON(t *testing.T) {
+	suite.Run(t, testCurrencyPolicyMinBalanceEncode(jsonenc.NewEncoder()))
+}
+
+func TestCurrencyPolicyMinBalanceEncodeBSON(t *testing.T) {
+	suite.Run(t, testCurrencyPolicyMinBalanceEncode(bsonenc.NewEncoder()))
+}
+
+func testCurrencyPolicyTransferLimitsEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(33))).
+			WithMinBalance(NewBig(44)).
+			WithTransferLimits(NewBig(10), NewBig(100))
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(CurrencyPolicy)
+		cb := b.(CurrencyPolicy)
+
+		t.Equal(ca, cb)
+		t.True(cb.Hint().Equal(CurrencyPolicyTransferLimitsHint))
+		t.Equal(ca.Bytes(), cb.Bytes())
+	}
+
+	return t
+}
+
+func TestCurrencyPolicyTransferLimitsEncodeJSON(t *testing.T) {
+	suite.Run(t, testCurrencyPolicyTransferLimitsEncode(jsonenc.NewEncoder()))
+}
+
+func TestCurrencyPolicyTransferLimitsEncodeBSON(t *testing.T) {
+	suite.Run(t, testCurrencyPolicyTransferLimitsEncode(bsonenc.NewEncoder()))
+}
+
+func testPendingCurrencyPolicyEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(33)))
+
+		return NewPendingCurrencyPolicy(CurrencyID("SHOWME"), po, base.Height(33)).SetApplied()
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(PendingCurrencyPolicy)
+		cb := b.(PendingCurrencyPolicy)
+
+		t.Equal(ca, cb)
+		t.True(ca.Hash().Equal(cb.Hash()))
+	}
+
+	return t
+}
+
+func TestPendingCurrencyPolicyEncodeJSON(t *testing.T) {
+	suite.Run(t, testPendingCurrencyPolicyEncode(jsonenc.NewEncoder()))
+}
+
+func TestPendingCurrencyPolicyEncodeBSON(t *testing.T) {
+	suite.Run(t, testPendingCurrencyPolicyEncode(bsonenc.NewEncoder()))
+}
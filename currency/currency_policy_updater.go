@@ -3,6 +3,7 @@ package currency
 import (
 	"golang.org/x/xerrors"
 
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
@@ -17,11 +18,24 @@ var (
 	CurrencyPolicyUpdaterHint     = hint.MustHint(CurrencyPolicyUpdaterType, "0.0.1")
 )
 
+var (
+	CurrencyPolicyUpdaterFactScheduledHint   = hint.MustHint(CurrencyPolicyUpdaterFactType, "0.0.2")
+	CurrencyPolicyUpdaterFactScheduledHinter = CurrencyPolicyUpdaterFact{hint: CurrencyPolicyUpdaterFactScheduledHint}
+)
+
+var (
+	CurrencyPolicyUpdaterFactExpiringHint   = hint.MustHint(CurrencyPolicyUpdaterFactType, "0.0.3")
+	CurrencyPolicyUpdaterFactExpiringHinter = CurrencyPolicyUpdaterFact{hint: CurrencyPolicyUpdaterFactExpiringHint}
+)
+
 type CurrencyPolicyUpdaterFact struct {
+	hint   hint.Hint
 	h      valuehash.Hash
 	token  []byte
 	cid    CurrencyID
 	policy CurrencyPolicy
+	height base.Height
+	expiry Expiry
 }
 
 func NewCurrencyPolicyUpdaterFact(token []byte, cid CurrencyID, policy CurrencyPolicy) CurrencyPolicyUpdaterFact {
@@ -36,8 +50,38 @@ func NewCurrencyPolicyUpdaterFact(token []byte, cid CurrencyID, policy CurrencyP
 	return fact
 }
 
+// WithActivation returns the scheduled CurrencyPolicyUpdaterFact; the policy is
+// kept as pending and it replaces the current policy from the height. The
+// Expiry is kept.
+func (fact CurrencyPolicyUpdaterFact) WithActivation(height base.Height) CurrencyPolicyUpdaterFact {
+	if !fact.IsExpiring() {
+		fact.hint = CurrencyPolicyUpdaterFactScheduledHint
+	}
+
+	fact.height = height
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// WithExpiry sets the Expiry; the activation height is kept.
+func (fact CurrencyPolicyUpdaterFact) WithExpiry(ex Expiry) CurrencyPolicyUpdaterFact {
+	fact.hint = CurrencyPolicyUpdaterFactExpiringHint
+	fact.expiry = ex
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact CurrencyPolicyUpdaterFact) Hint() hint.Hint {
-	return CurrencyPolicyUpdaterFactHint
+	switch {
+	case fact.hint.Equal(CurrencyPolicyUpdaterFactExpiringHint):
+		return CurrencyPolicyUpdaterFactExpiringHint
+	case fact.hint.Equal(CurrencyPolicyUpdaterFactScheduledHint):
+		return CurrencyPolicyUpdaterFactScheduledHint
+	default:
+		return CurrencyPolicyUpdaterFactHint
+	}
 }
 
 func (fact CurrencyPolicyUpdaterFact) Hash() valuehash.Hash {
@@ -45,10 +89,19 @@ func (fact CurrencyPolicyUpdaterFact) Hash() valuehash.Hash {
 }
 
 func (fact CurrencyPolicyUpdaterFact) Bytes() []byte {
+	var ex []byte
+	switch ht := fact.Hint(); {
+	case ht.Equal(CurrencyPolicyUpdaterFactExpiringHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), fact.height.Bytes(), fact.expiry.Bytes())
+	case ht.Equal(CurrencyPolicyUpdaterFactScheduledHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), fact.height.Bytes())
+	}
+
 	return util.ConcatBytesSlice(
 		fact.token,
 		fact.cid.Bytes(),
 		fact.policy.Bytes(),
+		ex,
 	)
 }
 
@@ -65,6 +118,20 @@ func (fact CurrencyPolicyUpdaterFact) IsValid([]byte) error {
 		return xerrors.Errorf("invalid fact: %w", err)
 	}
 
+	if fact.Hint().Equal(CurrencyPolicyUpdaterFactScheduledHint) && fact.height < 1 {
+		return xerrors.Errorf("activation height should be over zero, %v", fact.height)
+	}
+
+	if fact.IsExpiring() {
+		if fact.height < 0 {
+			return xerrors.Errorf("activation height should not be under zero, %v", fact.height)
+		}
+
+		if err := fact.expiry.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	if !fact.h.Equal(fact.GenerateHash()) {
 		return isvalid.InvalidError.Errorf("wrong Fact hash")
 	}
@@ -88,6 +155,22 @@ func (fact CurrencyPolicyUpdaterFact) Policy() CurrencyPolicy {
 	return fact.policy
 }
 
+func (fact CurrencyPolicyUpdaterFact) ActivationHeight() base.Height {
+	return fact.height
+}
+
+func (fact CurrencyPolicyUpdaterFact) IsScheduled() bool {
+	return fact.Hint().Equal(CurrencyPolicyUpdaterFactScheduledHint) || (fact.IsExpiring() && fact.height > 0)
+}
+
+func (fact CurrencyPolicyUpdaterFact) Expiry() Expiry {
+	return fact.expiry
+}
+
+func (fact CurrencyPolicyUpdaterFact) IsExpiring() bool {
+	return fact.Hint().Equal(CurrencyPolicyUpdaterFactExpiringHint)
+}
+
 type CurrencyPolicyUpdater struct {
 	operation.BaseOperation
 	Memo string
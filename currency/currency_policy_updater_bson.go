@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -8,15 +9,22 @@ import (
 )
 
 func (fact CurrencyPolicyUpdaterFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":     fact.h,
-				"token":    fact.token,
-				"currency": fact.cid,
-				"policy":   fact.policy,
-			}),
-	)
+	m := bson.M{
+		"hash":     fact.h,
+		"token":    fact.token,
+		"currency": fact.cid,
+		"policy":   fact.policy,
+	}
+
+	if fact.IsScheduled() {
+		m["height"] = fact.height
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type CurrencyPolicyUpdaterFactBSONUnpacker struct {
@@ -24,15 +32,22 @@ type CurrencyPolicyUpdaterFactBSONUnpacker struct {
 	TK []byte          `bson:"token"`
 	CI string          `bson:"currency"`
 	PO bson.Raw        `bson:"policy"`
+	HT base.Height     `bson:"height,omitempty"`
+	EX *Expiry         `bson:"expiry,omitempty"`
 }
 
 func (fact *CurrencyPolicyUpdaterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact CurrencyPolicyUpdaterFactBSONUnpacker
 	if err := enc.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.CI, ufact.PO)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.CI, ufact.PO, ufact.HT, ufact.EX)
 }
 
 func (op CurrencyPolicyUpdater) MarshalBSON() ([]byte, error) {
@@ -1,17 +1,23 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (fact *CurrencyPolicyUpdaterFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	token []byte,
 	scid string,
 	bpo []byte,
+	height base.Height,
+	ex *Expiry,
 ) error {
+	fact.hint = ht
 	fact.h = h
 	fact.token = token
 
@@ -23,5 +29,11 @@ func (fact *CurrencyPolicyUpdaterFact) unpack(
 		fact.policy = i
 	}
 
+	fact.height = height
+
+	if ex != nil {
+		fact.expiry = *ex
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package currency
 import (
 	"encoding/json"
 
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -14,15 +15,24 @@ type CurrencyPolicyUpdaterFactJSONPacker struct {
 	TK []byte         `json:"token"`
 	CI CurrencyID     `json:"currency"`
 	PO CurrencyPolicy `json:"policy"`
+	HT base.Height    `json:"height,omitempty"`
+	EX *Expiry        `json:"expiry,omitempty"`
 }
 
 func (fact CurrencyPolicyUpdaterFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
 	return jsonenc.Marshal(CurrencyPolicyUpdaterFactJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
 		H:          fact.h,
 		TK:         fact.token,
 		CI:         fact.cid,
 		PO:         fact.policy,
+		HT:         fact.height,
+		EX:         ex,
 	})
 }
 
@@ -31,15 +41,22 @@ type CurrencyPolicyUpdaterFactJSONUnpacker struct {
 	TK []byte          `json:"token"`
 	CI string          `json:"currency"`
 	PO json.RawMessage `json:"policy"`
+	HT base.Height     `json:"height,omitempty"`
+	EX *Expiry         `json:"expiry,omitempty"`
 }
 
 func (fact *CurrencyPolicyUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact CurrencyPolicyUpdaterFactJSONUnpacker
 	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.CI, ufact.PO)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.CI, ufact.PO, ufact.HT, ufact.EX)
 }
 
 func (op CurrencyPolicyUpdater) MarshalJSON() ([]byte, error) {
@@ -4,7 +4,6 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/spikeekips/mitum/base"
-	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
@@ -19,17 +18,17 @@ func (op CurrencyPolicyUpdater) Process(
 
 type CurrencyPolicyUpdaterProcessor struct {
 	CurrencyPolicyUpdater
-	cp        *CurrencyPool
-	pubs      []key.Publickey
-	threshold base.Threshold
-	st        state.State
-	de        CurrencyDesign
+	cp           *CurrencyPool
+	suffrageKeys SuffrageKeys
+	height       base.Height
+	st           state.State
+	de           CurrencyDesign
+	pst          state.State
 }
 
 func NewCurrencyPolicyUpdaterProcessor(
 	cp *CurrencyPool,
-	pubs []key.Publickey,
-	threshold base.Threshold,
+	suffrageKeys SuffrageKeys,
 ) GetNewProcessor {
 	return func(op state.Processor) (state.Processor, error) {
 		if i, ok := op.(CurrencyPolicyUpdater); !ok {
@@ -38,20 +37,25 @@ func NewCurrencyPolicyUpdaterProcessor(
 			return &CurrencyPolicyUpdaterProcessor{
 				CurrencyPolicyUpdater: i,
 				cp:                    cp,
-				pubs:                  pubs,
-				threshold:             threshold,
+				suffrageKeys:          suffrageKeys,
 			}, nil
 		}
 	}
 }
 
+func (opp *CurrencyPolicyUpdaterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *CurrencyPolicyUpdaterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
 ) (state.Processor, error) {
-	if len(opp.pubs) < 1 {
-		return nil, xerrors.Errorf("empty publickeys for operation signs")
-	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+	if err := checkFactSignsBySuffrage(opp.suffrageKeys, opp.height, opp.Signs()); err != nil {
 		return nil, err
 	}
 
@@ -84,6 +88,20 @@ func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 		}
 	}
 
+	if fact.IsScheduled() {
+		// NOTE the pending policy is swapped in by the block before the
+		// activation height, so it can not be activated by the next block.
+		if h := fact.ActivationHeight(); h <= opp.height+1 {
+			return nil, xerrors.Errorf("activation height, %v should be over next height, %v", h, opp.height+1)
+		}
+
+		if st, _, err := getState(StateKeyPendingCurrencyPolicy(fact.Currency())); err != nil {
+			return nil, err
+		} else {
+			opp.pst = st
+		}
+	}
+
 	return opp, nil
 }
 
@@ -93,6 +111,15 @@ func (opp *CurrencyPolicyUpdaterProcessor) Process(
 ) error {
 	fact := opp.Fact().(CurrencyPolicyUpdaterFact)
 
+	if fact.IsScheduled() {
+		pp := NewPendingCurrencyPolicy(fact.Currency(), fact.Policy(), fact.ActivationHeight())
+		if i, err := SetStatePendingCurrencyPolicyValue(opp.pst, pp); err != nil {
+			return err
+		} else {
+			return setState(fact.Hash(), i)
+		}
+	}
+
 	if i, err := SetStateCurrencyDesignValue(opp.st, opp.de.SetPolicy(fact.Policy())); err != nil {
 		return err
 	} else {
@@ -22,9 +22,10 @@ func (t *testCurrencyPolicyUpdaterOperations) SetupSuite() {
 }
 
 func (t *testCurrencyPolicyUpdaterOperations) newOperation(keys []key.Privatekey, cid CurrencyID, po CurrencyPolicy) CurrencyPolicyUpdater {
-	token := util.UUID().Bytes()
-	fact := NewCurrencyPolicyUpdaterFact(token, cid, po)
+	return t.newOperationFromFact(keys, NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), cid, po))
+}
 
+func (t *testCurrencyPolicyUpdaterOperations) newOperationFromFact(keys []key.Privatekey, fact CurrencyPolicyUpdaterFact) CurrencyPolicyUpdater {
 	var fs []operation.FactSign
 	for _, pk := range keys {
 		sig, err := operation.NewFactSignature(pk, fact, nil)
@@ -55,7 +56,7 @@ func (t *testCurrencyPolicyUpdaterOperations) processor(n int) ([]key.Privatekey
 	t.NoError(err)
 
 	opr := NewOperationProcessor(nil)
-	_, err = opr.SetProcessor(CurrencyPolicyUpdater{}, NewCurrencyPolicyUpdaterProcessor(nil, pubs, threshold))
+	_, err = opr.SetProcessor(CurrencyPolicyUpdater{}, NewCurrencyPolicyUpdaterProcessor(nil, NewFixedSuffrageKeys(pubs, threshold)))
 	t.NoError(err)
 
 	return privs, opr
@@ -177,6 +178,203 @@ func (t *testCurrencyPolicyUpdaterOperations) TestNotEnoughSigns() {
 	t.Contains(err.Error(), "not enough suffrage signs")
 }
 
+func (t *testCurrencyPolicyUpdaterOperations) TestSuffrageKeysByProposal() {
+	newKeys := func(n int) ([]key.Privatekey, []key.Publickey) {
+		privs := make([]key.Privatekey, n)
+		pubs := make([]key.Publickey, n)
+		for i := 0; i < n; i++ {
+			privs[i] = key.MustNewBTCPrivatekey()
+			pubs[i] = privs[i].Publickey()
+		}
+
+		return privs, pubs
+	}
+
+	oldPrivs, oldPubs := newKeys(2)
+	newPrivs, newPubs := newKeys(3)
+
+	pubs := oldPubs
+	var heights []base.Height
+
+	copr := NewOperationProcessor(nil)
+	_, err := copr.SetProcessor(CurrencyPolicyUpdater{}, NewCurrencyPolicyUpdaterProcessor(nil,
+		func(height base.Height) ([]key.Publickey, base.Threshold, error) {
+			heights = append(heights, height)
+
+			threshold, err := base.NewThreshold(uint(len(pubs)), 100)
+
+			return pubs, threshold, err
+		},
+	))
+	t.NoError(err)
+
+	ga, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44)))
+
+	pool, _ := t.statepool(sts, t.designStates(de))
+	t.NoError(copr.New(pool).Process(t.newOperation(oldPrivs, t.cid, po)))
+	t.Equal([]base.Height{pool.Height()}, heights)
+
+	// NOTE suffrage keys are changed
+	pubs = newPubs
+
+	pool, _ = t.statepool(sts, t.designStates(de))
+	opr := copr.New(pool)
+
+	err = opr.Process(t.newOperation(oldPrivs, t.cid, po))
+	t.Contains(err.Error(), "not enough suffrage signs")
+
+	t.NoError(opr.Process(t.newOperation(newPrivs, t.cid, po)))
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) designStates(de CurrencyDesign) []state.State {
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(de.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	return []state.State{nst}
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) pendingState(pp PendingCurrencyPolicy) state.State {
+	st, err := state.NewStateV0(StateKeyPendingCurrencyPolicy(pp.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStatePendingCurrencyPolicyValue(st, pp)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestScheduled() {
+	privs, copr := t.processor(3)
+
+	ga, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+
+	pool, _ := t.statepool(sts, t.designStates(de))
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44)))
+	fact := NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), t.cid, po).WithActivation(pool.Height() + 2)
+	t.NoError(opr.Process(t.newOperationFromFact(privs, fact)))
+
+	var pp PendingCurrencyPolicy
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyCurrencyDesign(t.cid):
+			t.Fail("currency design should not be updated")
+		case StateKeyPendingCurrencyPolicy(t.cid):
+			i, err := StatePendingCurrencyPolicyValue(st.GetState())
+			t.NoError(err)
+
+			pp = i
+		}
+	}
+
+	t.Equal(t.cid, pp.Currency())
+	t.Equal(po, pp.Policy())
+	t.Equal(pool.Height()+2, pp.Height())
+	t.False(pp.IsApplied())
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestScheduledTooEarly() {
+	privs, copr := t.processor(3)
+
+	ga, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+
+	pool, _ := t.statepool(sts, t.designStates(de))
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44)))
+	fact := NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), t.cid, po).WithActivation(pool.Height() + 1)
+
+	err := opr.Process(t.newOperationFromFact(privs, fact))
+	t.Contains(err.Error(), "should be over next height")
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestActivatePending() {
+	ga, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+	dsts := t.designStates(de)
+
+	pool, _ := t.statepool(sts, dsts)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44)))
+	pst := t.pendingState(NewPendingCurrencyPolicy(t.cid, po, pool.Height()+1))
+
+	pool, _ = t.statepool(sts, dsts, []state.State{pst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dsts[0]))
+	t.NoError(cp.Set(pst))
+
+	i, found := cp.PendingPolicy(t.cid)
+	t.True(found)
+	t.Equal(po, i.Policy())
+
+	opr := NewOperationProcessor(cp).New(pool)
+	t.NoError(opr.Close())
+
+	var ude CurrencyDesign
+	var upp PendingCurrencyPolicy
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyCurrencyDesign(t.cid):
+			i, err := StateCurrencyDesignValue(st.GetState())
+			t.NoError(err)
+
+			ude = i
+		case StateKeyPendingCurrencyPolicy(t.cid):
+			i, err := StatePendingCurrencyPolicyValue(st.GetState())
+			t.NoError(err)
+
+			upp = i
+		}
+	}
+
+	t.Equal(po, ude.Policy())
+	t.True(upp.IsApplied())
+	t.Equal(1, len(pool.AddedOperations()))
+
+	ncp := NewCurrencyPool()
+	for _, st := range pool.Updates() {
+		t.NoError(ncp.Set(st.GetState()))
+	}
+
+	_, found = ncp.PendingPolicy(t.cid)
+	t.False(found)
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestNotYetActivated() {
+	ga, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+	dsts := t.designStates(de)
+
+	pool, _ := t.statepool(sts, dsts)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44)))
+	pst := t.pendingState(NewPendingCurrencyPolicy(t.cid, po, pool.Height()+2))
+
+	pool, _ = t.statepool(sts, dsts, []state.State{pst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dsts[0]))
+	t.NoError(cp.Set(pst))
+
+	opr := NewOperationProcessor(cp).New(pool)
+	t.NoError(opr.Close())
+
+	t.Empty(pool.Updates())
+	t.Empty(pool.AddedOperations())
+}
+
 func TestCurrencyPolicyUpdaterOperations(t *testing.T) {
 	suite.Run(t, new(testCurrencyPolicyUpdaterOperations))
 }
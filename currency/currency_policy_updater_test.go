@@ -76,6 +76,23 @@ func (t *testCurrencyPolicyUpdater) TestWithInvalidPolicy() {
 	t.Contains(err.Error(), "fixed feeer amount under zero")
 }
 
+func (t *testCurrencyPolicyUpdater) TestScheduled() {
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(44)))
+
+	fact := NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), t.cid, po)
+	t.False(fact.IsScheduled())
+
+	sfact := fact.WithActivation(base.Height(33))
+	t.True(sfact.IsScheduled())
+	t.True(sfact.Hint().Equal(CurrencyPolicyUpdaterFactScheduledHint))
+	t.Equal(base.Height(33), sfact.ActivationHeight())
+	t.False(fact.Hash().Equal(sfact.Hash()))
+	t.NoError(sfact.IsValid(nil))
+
+	err := fact.WithActivation(base.Height(0)).IsValid(nil)
+	t.Contains(err.Error(), "activation height should be over zero")
+}
+
 func TestCurrencyPolicyUpdater(t *testing.T) {
 	suite.Run(t, new(testCurrencyPolicyUpdater))
 }
@@ -89,7 +106,7 @@ func testCurrencyPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 		po := NewCurrencyPolicy(NewBig(44), NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(44)))
 		t.NoError(po.IsValid(nil))
 
-		fact := NewCurrencyPolicyUpdaterFact(token, CurrencyID("FINDME"), po)
+		fact := NewCurrencyPolicyUpdaterFact(token, CurrencyID("FINDME"), po).WithActivation(base.Height(33))
 
 		var fs []operation.FactSign
 
@@ -123,6 +140,8 @@ func testCurrencyPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		t.Equal(fact.cid, ufact.cid)
 		t.Equal(fact.policy, ufact.policy)
+		t.True(fact.Hint().Equal(ufact.Hint()))
+		t.Equal(fact.height, ufact.height)
 	}
 
 	return t
@@ -1,22 +1,39 @@
 package currency
 
 import (
+	"sort"
 	"sync"
 
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
 )
 
+// CurrencyPool keeps the currency designs in effect at the next block of the
+// last height; the pending policy activated at the next block already replaces
+// the policy, even if the block does not have currency operations. The states
+// of currency are also kept by height, so the currency designs at the given
+// height can be found; see At. The full history is kept unless the retention
+// is set by SetRetention.
 type CurrencyPool struct {
 	sync.RWMutex
-	demap  map[CurrencyID]CurrencyDesign
-	stsmap map[CurrencyID]state.State
-	cids   []CurrencyID
+	height    base.Height
+	retention base.Height
+	demap     map[CurrencyID]CurrencyDesign
+	stsmap    map[CurrencyID]state.State
+	cids      []CurrencyID
+	fees      map[CurrencyID]Big
+	pendings  map[CurrencyID]PendingCurrencyPolicy
+	records   map[string][]state.State
 }
 
 func NewCurrencyPool() *CurrencyPool {
 	return &CurrencyPool{
-		demap:  map[CurrencyID]CurrencyDesign{},
-		stsmap: map[CurrencyID]state.State{},
+		height:   base.NilHeight,
+		demap:    map[CurrencyID]CurrencyDesign{},
+		stsmap:   map[CurrencyID]state.State{},
+		fees:     map[CurrencyID]Big{},
+		pendings: map[CurrencyID]PendingCurrencyPolicy{},
+		records:  map[string][]state.State{},
 	}
 }
 
@@ -27,12 +44,86 @@ func (cp *CurrencyPool) Clear() {
 	cp.demap = nil
 	cp.stsmap = nil
 	cp.cids = nil
+	cp.fees = nil
+	cp.pendings = nil
+	cp.records = nil
 }
 
+// Set keeps the state of currency; the state older than the last one of same
+// key is only kept for the lookups by height.
 func (cp *CurrencyPool) Set(st state.State) error {
 	cp.Lock()
 	defer cp.Unlock()
 
+	if err := cp.set(st, cp.record(st)); err != nil {
+		return err
+	}
+
+	if st.Height() > cp.height {
+		cp.height = st.Height()
+	}
+
+	cp.resolve()
+
+	return nil
+}
+
+// SetHeight sets the height of the last block. The blocks without currency
+// operations do not update the states of currency, but the pending policies
+// are activated and the base fees fall by them. With the retention, the states,
+// which are no longer in effect within the retention, are pruned.
+func (cp *CurrencyPool) SetHeight(height base.Height) {
+	cp.Lock()
+	defer cp.Unlock()
+
+	if cp.retention > 0 {
+		cp.prune(height - cp.retention)
+	}
+
+	if height > cp.height {
+		cp.height = height
+	}
+
+	cp.resolve()
+}
+
+// SetRetention sets the number of blocks, which the history of states is kept
+// for behind the last height; the designs and policies older than it can not be
+// found by DesignAt, PolicyAt and At. Zero keeps the full history.
+func (cp *CurrencyPool) SetRetention(retention base.Height) *CurrencyPool {
+	cp.Lock()
+	defer cp.Unlock()
+
+	cp.retention = retention
+
+	return cp
+}
+
+// Height returns the height of the last block.
+func (cp *CurrencyPool) Height() base.Height {
+	cp.RLock()
+	defer cp.RUnlock()
+
+	return cp.height
+}
+
+func (cp *CurrencyPool) set(st state.State, last bool) error {
+	if IsStateBaseFeeKey(st.Key()) {
+		_, err := StateBaseFeeValue(st)
+
+		return err
+	}
+
+	if IsStatePendingCurrencyPolicyKey(st.Key()) {
+		if pp, err := StatePendingCurrencyPolicyValue(st); err != nil {
+			return err
+		} else if last {
+			cp.pendings[pp.Currency()] = pp
+		}
+
+		return nil
+	}
+
 	var de CurrencyDesign
 	if i, err := StateCurrencyDesignValue(st); err != nil {
 		return err
@@ -40,13 +131,179 @@ func (cp *CurrencyPool) Set(st state.State) error {
 		de = i
 	}
 
-	cp.demap[de.Currency()] = de
+	if !last {
+		return nil
+	}
+
+	if _, found := cp.stsmap[de.Currency()]; !found {
+		cp.cids = append(cp.cids, de.Currency())
+	}
+
 	cp.stsmap[de.Currency()] = st
-	cp.cids = append(cp.cids, de.Currency())
 
 	return nil
 }
 
+// resolve sets the currency designs and the base fees in effect at the next
+// block of the last height. The activated pending policy replaces the policy
+// of currency design and the base fee of DynamicFeeer falls by the empty
+// blocks after the base fee state.
+func (cp *CurrencyPool) resolve() {
+	height := cp.height + 1
+
+	for cid := range cp.stsmap {
+		de, err := StateCurrencyDesignValue(cp.stsmap[cid])
+		if err != nil {
+			continue
+		}
+
+		if pp, found := cp.pendings[cid]; found && pp.IsActivated(height) {
+			de = de.SetPolicy(pp.Policy())
+		}
+
+		cp.demap[cid] = de
+
+		sts := cp.records[StateKeyBaseFee(cid)]
+		if len(sts) < 1 {
+			continue
+		}
+
+		last := sts[len(sts)-1]
+
+		am, err := StateBaseFeeValue(last)
+		if err != nil {
+			continue
+		}
+
+		b := am.Big()
+		if feeer, ok := de.Policy().Feeer().(DynamicFeeer); ok {
+			if empty := height - last.Height() - 1; empty > 0 {
+				b = feeer.WithBaseFee(b).DecayedBaseFee(uint64(empty))
+			}
+		}
+
+		cp.fees[cid] = b
+	}
+}
+
+// record inserts the state by height and returns true if the state is the last
+// one of the key.
+func (cp *CurrencyPool) record(st state.State) bool {
+	sts := cp.records[st.Key()]
+
+	i := sort.Search(len(sts), func(i int) bool {
+		return sts[i].Height() >= st.Height()
+	})
+
+	switch {
+	case i < len(sts) && sts[i].Height() == st.Height():
+		sts[i] = st
+	default:
+		sts = append(sts, nil)
+		copy(sts[i+1:], sts[i:])
+		sts[i] = st
+	}
+
+	cp.records[st.Key()] = sts
+
+	return i == len(sts)-1
+}
+
+// prune removes the states, which are replaced before the next block of the
+// height; the last state of each key at the height is kept, so the lookups
+// after the height still work.
+func (cp *CurrencyPool) prune(height base.Height) {
+	for key := range cp.records {
+		sts := cp.records[key]
+
+		i := sort.Search(len(sts), func(i int) bool {
+			return sts[i].Height() > height
+		})
+		if i < 2 {
+			continue
+		}
+
+		cp.records[key] = append([]state.State(nil), sts[i-1:]...)
+	}
+}
+
+// recordAt returns the last state of the key, which is stored before the
+// height.
+func (cp *CurrencyPool) recordAt(key string, height base.Height) (state.State, bool) {
+	sts := cp.records[key]
+
+	i := sort.Search(len(sts), func(i int) bool {
+		return sts[i].Height() >= height
+	})
+	if i < 1 {
+		return nil, false
+	}
+
+	return sts[i-1], true
+}
+
+// At returns the new CurrencyPool, which has the currency designs in effect at
+// the height; the pending policy activated at the height replaces the policy
+// of currency design. The base fee of DynamicFeeer falls by the empty blocks
+// after the base fee state.
+func (cp *CurrencyPool) At(height base.Height) *CurrencyPool {
+	cp.RLock()
+	defer cp.RUnlock()
+
+	ncp := NewCurrencyPool()
+	for key := range cp.records {
+		if st, found := cp.recordAt(key, height); found {
+			_ = ncp.set(st, ncp.record(st))
+		}
+	}
+
+	ncp.height = height - 1
+	ncp.resolve()
+
+	sort.Slice(ncp.cids, func(i, j int) bool {
+		return ncp.cids[i] < ncp.cids[j]
+	})
+
+	return ncp
+}
+
+// DesignAt returns the currency design in effect at the height.
+func (cp *CurrencyPool) DesignAt(cid CurrencyID, height base.Height) (CurrencyDesign, bool) {
+	cp.RLock()
+	defer cp.RUnlock()
+
+	return cp.designAt(cid, height)
+}
+
+func (cp *CurrencyPool) designAt(cid CurrencyID, height base.Height) (CurrencyDesign, bool) {
+	st, found := cp.recordAt(StateKeyCurrencyDesign(cid), height)
+	if !found {
+		return CurrencyDesign{}, false
+	}
+
+	de, err := StateCurrencyDesignValue(st)
+	if err != nil {
+		return CurrencyDesign{}, false
+	}
+
+	if pst, found := cp.recordAt(StateKeyPendingCurrencyPolicy(cid), height); found {
+		if pp, err := StatePendingCurrencyPolicyValue(pst); err == nil && pp.IsActivated(height) {
+			de = de.SetPolicy(pp.Policy())
+		}
+	}
+
+	return de, true
+}
+
+// PolicyAt returns the CurrencyPolicy in effect at the height.
+func (cp *CurrencyPool) PolicyAt(cid CurrencyID, height base.Height) (CurrencyPolicy, bool) {
+	if de, found := cp.DesignAt(cid, height); !found {
+		return CurrencyPolicy{}, false
+	} else {
+		return de.Policy(), true
+	}
+}
+
 func (cp *CurrencyPool) CIDs() []CurrencyID {
 	cp.RLock()
 	defer cp.RUnlock()
@@ -86,11 +343,46 @@ func (cp *CurrencyPool) Policy(cid CurrencyID) (CurrencyPolicy, bool) {
 	}
 }
 
+// Feeer returns the Feeer of currency; the base fee in effect at the next block
+// is set to DynamicFeeer.
 func (cp *CurrencyPool) Feeer(cid CurrencyID) (Feeer, bool) {
-	if i, found := cp.Get(cid); !found {
+	i, found := cp.Get(cid)
+	if !found {
 		return nil, false
+	}
+
+	feeer := i.Policy().Feeer()
+	if j, ok := feeer.(DynamicFeeer); ok {
+		if b, found := cp.BaseFee(cid); found {
+			return j.WithBaseFee(b), true
+		}
+	}
+
+	return feeer, true
+}
+
+// BaseFee returns the base fee of DynamicFeeer in effect at the next block.
+func (cp *CurrencyPool) BaseFee(cid CurrencyID) (Big, bool) {
+	cp.RLock()
+	defer cp.RUnlock()
+
+	if i, found := cp.fees[cid]; !found {
+		return Big{}, false
 	} else {
-		return i.Policy().Feeer(), true
+		return i, true
+	}
+}
+
+// PendingPolicy returns the scheduled CurrencyPolicy, which is not yet in
+// effect at the next block.
+func (cp *CurrencyPool) PendingPolicy(cid CurrencyID) (PendingCurrencyPolicy, bool) {
+	cp.RLock()
+	defer cp.RUnlock()
+
+	if i, found := cp.pendings[cid]; !found || i.IsApplied() || i.IsActivated(cp.height+1) {
+		return PendingCurrencyPolicy{}, false
+	} else {
+		return i, true
 	}
 }
 
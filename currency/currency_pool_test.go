@@ -0,0 +1,234 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCurrencyPool struct {
+	baseTestOperationProcessor
+}
+
+func (t *testCurrencyPool) designState(height base.Height, feeer Feeer) state.State {
+	de := NewCurrencyDesign(NewAmount(NewBig(99), t.cid), NewTestAddress(), NewCurrencyPolicy(ZeroBig, feeer))
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(t.cid), nil, height)
+	t.NoError(err)
+
+	nst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testCurrencyPool) TestDesignAt() {
+	receiver := NewTestAddress()
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.designState(base.Height(30), NewFixedFeeer(receiver, NewBig(3)))))
+	t.NoError(cp.Set(t.designState(base.Height(10), NewFixedFeeer(receiver, NewBig(1)))))
+	t.NoError(cp.Set(t.designState(base.Height(20), NewFixedFeeer(receiver, NewBig(2)))))
+
+	// NOTE the last one is kept, though it is set before
+	i, found := cp.Feeer(t.cid)
+	t.True(found)
+	t.Equal(NewBig(3), i.Min())
+	t.Equal(1, len(cp.CIDs()))
+
+	_, found = cp.DesignAt(t.cid, base.Height(10))
+	t.False(found)
+
+	for _, c := range []struct {
+		height base.Height
+		fee    int64
+	}{
+		{11, 1},
+		{20, 1},
+		{21, 2},
+		{31, 3},
+		{100, 3},
+	} {
+		po, found := cp.PolicyAt(t.cid, c.height)
+		t.True(found)
+		t.Equal(NewBig(c.fee), po.Feeer().Min(), "height=%d", c.height)
+
+		feeer, found := cp.At(c.height).Feeer(t.cid)
+		t.True(found)
+		t.Equal(NewBig(c.fee), feeer.Min(), "height=%d", c.height)
+	}
+
+	t.False(cp.At(base.Height(10)).Exists(t.cid))
+}
+
+func (t *testCurrencyPool) TestPendingPolicyAt() {
+	receiver := NewTestAddress()
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.designState(base.Height(10), NewFixedFeeer(receiver, NewBig(1)))))
+
+	pp := NewPendingCurrencyPolicy(t.cid, NewCurrencyPolicy(ZeroBig, NewFixedFeeer(receiver, NewBig(2))), base.Height(30))
+
+	st, err := state.NewStateV0(StateKeyPendingCurrencyPolicy(t.cid), nil, base.Height(20))
+	t.NoError(err)
+	pst, err := SetStatePendingCurrencyPolicyValue(st, pp)
+	t.NoError(err)
+	t.NoError(cp.Set(pst))
+
+	po, found := cp.PolicyAt(t.cid, base.Height(29))
+	t.True(found)
+	t.Equal(NewBig(1), po.Feeer().Min())
+
+	// NOTE the pending policy is in effect from the activation height, even
+	// if it is not yet swapped in.
+	po, found = cp.PolicyAt(t.cid, base.Height(30))
+	t.True(found)
+	t.Equal(NewBig(2), po.Feeer().Min())
+
+	feeer, found := cp.At(base.Height(30)).Feeer(t.cid)
+	t.True(found)
+	t.Equal(NewBig(2), feeer.Min())
+
+	// NOTE the blocks without currency operations activate the pending policy
+	// by height.
+	cp.SetHeight(base.Height(28))
+
+	po, found = cp.Policy(t.cid)
+	t.True(found)
+	t.Equal(NewBig(1), po.Feeer().Min())
+
+	_, found = cp.PendingPolicy(t.cid)
+	t.True(found)
+
+	cp.SetHeight(base.Height(29))
+
+	po, found = cp.Policy(t.cid)
+	t.True(found)
+	t.Equal(NewBig(2), po.Feeer().Min())
+
+	de, found := cp.Get(t.cid)
+	t.True(found)
+	t.Equal(NewBig(2), de.Policy().Feeer().Min())
+	t.Equal(NewBig(2), cp.Designs()[t.cid].Policy().Feeer().Min())
+
+	_, found = cp.PendingPolicy(t.cid)
+	t.False(found)
+}
+
+func (t *testCurrencyPool) TestBaseFeeAt() {
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.designState(base.Height(10), NewDynamicFeeer(NewTestAddress(), NewBig(1), UnlimitedMaxFeeAmount, 2, 8))))
+
+	for _, c := range []struct {
+		height base.Height
+		fee    int64
+	}{
+		{20, 5},
+		{30, 7},
+		{40, 800},
+	} {
+		st, err := state.NewStateV0(StateKeyBaseFee(t.cid), nil, c.height)
+		t.NoError(err)
+		nst, err := SetStateBaseFeeValue(st, NewAmount(NewBig(c.fee), t.cid))
+		t.NoError(err)
+		t.NoError(cp.Set(nst))
+	}
+
+	for _, c := range []struct {
+		height base.Height
+		fee    int64
+	}{
+		{15, 1},
+		{21, 5},
+		{25, 5},
+		{31, 7},
+		{41, 800},
+		{43, 613}, // NOTE 2 empty blocks
+	} {
+		feeer, found := cp.At(c.height).Feeer(t.cid)
+		t.True(found)
+		t.Equal(NewBig(c.fee), feeer.(DynamicFeeer).BaseFee(), "height=%d", c.height)
+	}
+
+	// NOTE base fee of the next block after the empty blocks
+	feeer, found := cp.Feeer(t.cid)
+	t.True(found)
+	t.Equal(NewBig(800), feeer.(DynamicFeeer).BaseFee())
+
+	cp.SetHeight(base.Height(42))
+
+	feeer, found = cp.Feeer(t.cid)
+	t.True(found)
+	t.Equal(NewBig(613), feeer.(DynamicFeeer).BaseFee())
+}
+
+func (t *testCurrencyPool) TestHistoryAfterSetHeight() {
+	receiver := NewTestAddress()
+
+	cp := NewCurrencyPool()
+	for _, h := range []int64{10, 20, 30, 40} {
+		t.NoError(cp.Set(t.designState(base.Height(h), NewFixedFeeer(receiver, NewBig(h)))))
+	}
+
+	cp.SetHeight(base.Height(50))
+
+	// NOTE without retention, the full history is kept
+	t.Equal(4, len(cp.records[StateKeyCurrencyDesign(t.cid)]))
+
+	for _, c := range []struct {
+		height base.Height
+		fee    int64
+	}{
+		{11, 10},
+		{21, 20},
+		{31, 30},
+		{51, 40},
+	} {
+		de, found := cp.DesignAt(t.cid, c.height)
+		t.True(found, "height=%d", c.height)
+		t.Equal(NewBig(c.fee), de.Policy().Feeer().Min(), "height=%d", c.height)
+
+		feeer, found := cp.At(c.height).Feeer(t.cid)
+		t.True(found, "height=%d", c.height)
+		t.Equal(NewBig(c.fee), feeer.Min(), "height=%d", c.height)
+	}
+}
+
+func (t *testCurrencyPool) TestPrune() {
+	receiver := NewTestAddress()
+
+	cp := NewCurrencyPool().SetRetention(base.Height(5))
+	for _, h := range []int64{10, 20, 30, 40} {
+		t.NoError(cp.Set(t.designState(base.Height(h), NewFixedFeeer(receiver, NewBig(h)))))
+	}
+
+	t.Equal(4, len(cp.records[StateKeyCurrencyDesign(t.cid)]))
+
+	cp.SetHeight(base.Height(35))
+
+	// NOTE the state at 30 is still in effect at the next block
+	sts := cp.records[StateKeyCurrencyDesign(t.cid)]
+	t.Equal(2, len(sts))
+	t.Equal(base.Height(30), sts[0].Height())
+
+	po, found := cp.PolicyAt(t.cid, base.Height(36))
+	t.True(found)
+	t.Equal(NewBig(30), po.Feeer().Min())
+
+	po, found = cp.PolicyAt(t.cid, base.Height(41))
+	t.True(found)
+	t.Equal(NewBig(40), po.Feeer().Min())
+
+	cp.SetHeight(base.Height(50))
+	t.Equal(1, len(cp.records[StateKeyCurrencyDesign(t.cid)]))
+
+	feeer, found := cp.At(base.Height(51)).Feeer(t.cid)
+	t.True(found)
+	t.Equal(NewBig(40), feeer.Min())
+}
+
+func TestCurrencyPool(t *testing.T) {
+	suite.Run(t, new(testCurrencyPool))
+}
@@ -17,7 +17,13 @@ var (
 	CurrencyRegisterHint     = hint.MustHint(CurrencyRegisterType, "0.0.1")
 )
 
+var (
+	CurrencyRegisterFactExpiringHint   = hint.MustHint(CurrencyRegisterFactType, "0.0.2")
+	CurrencyRegisterFactExpiringHinter = CurrencyRegisterFact{expiringFact: expiringFact{ht: CurrencyRegisterFactExpiringHint}}
+)
+
 type CurrencyRegisterFact struct {
+	expiringFact
 	h        valuehash.Hash
 	token    []byte
 	currency CurrencyDesign
@@ -34,8 +40,15 @@ func NewCurrencyRegisterFact(token []byte, de CurrencyDesign) CurrencyRegisterFa
 	return fact
 }
 
+func (fact CurrencyRegisterFact) WithExpiry(ex Expiry) CurrencyRegisterFact {
+	fact.expiringFact = newExpiringFact(CurrencyRegisterFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact CurrencyRegisterFact) Hint() hint.Hint {
-	return CurrencyRegisterFactHint
+	return fact.factHint(CurrencyRegisterFactHint)
 }
 
 func (fact CurrencyRegisterFact) Hash() valuehash.Hash {
@@ -43,7 +56,9 @@ func (fact CurrencyRegisterFact) Hash() valuehash.Hash {
 }
 
 func (fact CurrencyRegisterFact) Bytes() []byte {
-	return util.ConcatBytesSlice(fact.token, fact.currency.Bytes())
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(fact.token, fact.currency.Bytes(), ext)
 }
 
 func (fact CurrencyRegisterFact) IsValid([]byte) error {
@@ -62,6 +77,10 @@ func (fact CurrencyRegisterFact) IsValid([]byte) error {
 		return xerrors.Errorf("empty genesis account")
 	}
 
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
 	if !fact.h.Equal(fact.GenerateHash()) {
 		return isvalid.InvalidError.Errorf("wrong Fact hash")
 	}
@@ -8,29 +8,38 @@ import (
 )
 
 func (fact CurrencyRegisterFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":     fact.h,
-				"token":    fact.token,
-				"currency": fact.currency,
-			}),
-	)
+	m := bson.M{
+		"hash":     fact.h,
+		"token":    fact.token,
+		"currency": fact.currency,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type CurrencyRegisterFactBSONUnpacker struct {
 	H  valuehash.Bytes `bson:"hash"`
 	TK []byte          `bson:"token"`
 	CR bson.Raw        `bson:"currency"`
+	EX *Expiry         `bson:"expiry,omitempty"`
 }
 
 func (fact *CurrencyRegisterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact CurrencyRegisterFactBSONUnpacker
 	if err := enc.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.CR)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.CR, ufact.EX)
 }
 
 func (op CurrencyRegister) MarshalBSON() ([]byte, error) {
@@ -2,15 +2,19 @@ package currency
 
 import (
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (fact *CurrencyRegisterFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	token []byte,
 	bcr []byte,
+	ex *Expiry,
 ) error {
+	fact.expiringFact = unpackExpiringFact(ht, CurrencyRegisterFactExpiringHint, ex)
 	fact.h = h
 	fact.token = token
 
@@ -13,14 +13,21 @@ type CurrencyRegisterFactJSONPacker struct {
 	H  valuehash.Hash `json:"hash"`
 	TK []byte         `json:"token"`
 	CR CurrencyDesign `json:"currency"`
+	EX *Expiry        `json:"expiry,omitempty"`
 }
 
 func (fact CurrencyRegisterFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
 	return jsonenc.Marshal(CurrencyRegisterFactJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
 		H:          fact.h,
 		TK:         fact.token,
 		CR:         fact.currency,
+		EX:         ex,
 	})
 }
 
@@ -28,15 +35,21 @@ type CurrencyRegisterFactJSONUnpacker struct {
 	H  valuehash.Bytes `json:"hash"`
 	TK []byte          `json:"token"`
 	CR json.RawMessage `json:"currency"`
+	EX *Expiry         `json:"expiry,omitempty"`
 }
 
 func (fact *CurrencyRegisterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact CurrencyRegisterFactJSONUnpacker
 	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.CR)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.CR, ufact.EX)
 }
 
 func (op CurrencyRegister) MarshalJSON() ([]byte, error) {
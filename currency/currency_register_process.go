@@ -4,7 +4,6 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/spikeekips/mitum/base"
-	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
@@ -19,14 +18,14 @@ func (op CurrencyRegister) Process(
 
 type CurrencyRegisterProcessor struct {
 	CurrencyRegister
-	cp        *CurrencyPool
-	pubs      []key.Publickey
-	threshold base.Threshold
-	ga        AmountState
-	de        state.State
+	cp           *CurrencyPool
+	suffrageKeys SuffrageKeys
+	height       base.Height
+	ga           AmountState
+	de           state.State
 }
 
-func NewCurrencyRegisterProcessor(cp *CurrencyPool, pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+func NewCurrencyRegisterProcessor(cp *CurrencyPool, suffrageKeys SuffrageKeys) GetNewProcessor {
 	return func(op state.Processor) (state.Processor, error) {
 		if i, ok := op.(CurrencyRegister); !ok {
 			return nil, xerrors.Errorf("not CurrencyRegister, %T", op)
@@ -34,20 +33,25 @@ func NewCurrencyRegisterProcessor(cp *CurrencyPool, pubs []key.Publickey, thresh
 			return &CurrencyRegisterProcessor{
 				CurrencyRegister: i,
 				cp:               cp,
-				pubs:             pubs,
-				threshold:        threshold,
+				suffrageKeys:     suffrageKeys,
 			}, nil
 		}
 	}
 }
 
+func (opp *CurrencyRegisterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *CurrencyRegisterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *CurrencyRegisterProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
 ) (state.Processor, error) {
-	if len(opp.pubs) < 1 {
-		return nil, xerrors.Errorf("empty publickeys for operation signs")
-	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+	if err := checkFactSignsBySuffrage(opp.suffrageKeys, opp.height, opp.Signs()); err != nil {
 		return nil, err
 	}
 
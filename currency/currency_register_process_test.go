@@ -55,7 +55,7 @@ func (t *testCurrencyRegisterOperations) processor(n int) ([]key.Privatekey, *Op
 	t.NoError(err)
 
 	opr := NewOperationProcessor(nil)
-	_, err = opr.SetProcessor(CurrencyRegister{}, NewCurrencyRegisterProcessor(nil, pubs, threshold))
+	_, err = opr.SetProcessor(CurrencyRegister{}, NewCurrencyRegisterProcessor(nil, NewFixedSuffrageKeys(pubs, threshold)))
 	t.NoError(err)
 
 	return privs, opr
@@ -54,6 +54,18 @@ func DecodeCurrencyPolicy(enc encoder.Encoder, b []byte) (CurrencyPolicy, error)
 	}
 }
 
+func DecodeNetworkPolicy(enc encoder.Encoder, b []byte) (NetworkPolicy, error) {
+	if i, err := enc.DecodeByHint(b); err != nil {
+		return NetworkPolicy{}, err
+	} else if i == nil {
+		return NetworkPolicy{}, nil
+	} else if v, ok := i.(NetworkPolicy); !ok {
+		return NetworkPolicy{}, hint.InvalidTypeError.Errorf("not NetworkPolicy; type=%T", i)
+	} else {
+		return v, nil
+	}
+}
+
 func DecodeFeeer(enc encoder.Encoder, b []byte) (Feeer, error) {
 	if i, err := enc.DecodeByHint(b); err != nil {
 		return nil, err
@@ -0,0 +1,149 @@
+package currency
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/localtime"
+)
+
+// ExpiringFact is the fact, which can be expired. The operation of the expired
+// fact is rejected by OperationProcessor.
+type ExpiringFact interface {
+	Expiry() Expiry
+}
+
+// expiringFact is embedded by the facts, which can be expired. WithExpiry of
+// fact sets it with the expiring hint of fact, and the operation of fact is
+// rejected after the Expiry. The expiring hint and the Expiry are added to the
+// bytes of fact, so the expiring fact has the different hash from the plain
+// one.
+type expiringFact struct {
+	ht     hint.Hint
+	expiry Expiry
+}
+
+func newExpiringFact(ht hint.Hint, ex Expiry) expiringFact {
+	return expiringFact{ht: ht, expiry: ex}
+}
+
+// unpackExpiringFact returns the expiringFact of the decoded fact; the plain
+// fact, which does not have the expiring hint, returns the empty one.
+func unpackExpiringFact(ht, expiringHint hint.Hint, ex *Expiry) expiringFact {
+	if !ht.Equal(expiringHint) {
+		return expiringFact{}
+	}
+
+	ef := expiringFact{ht: ht}
+	if ex != nil {
+		ef.expiry = *ex
+	}
+
+	return ef
+}
+
+func (ef expiringFact) Expiry() Expiry {
+	return ef.expiry
+}
+
+func (ef expiringFact) IsExpiring() bool {
+	return !ef.ht.Equal(hint.Hint{})
+}
+
+// factHint returns the expiring hint; if not expiring, the plain hint is
+// returned.
+func (ef expiringFact) factHint(plain hint.Hint) hint.Hint {
+	if ef.IsExpiring() {
+		return ef.ht
+	}
+
+	return plain
+}
+
+func (ef expiringFact) expiryBytes() []byte {
+	if !ef.IsExpiring() {
+		return nil
+	}
+
+	return util.ConcatBytesSlice(ef.ht.Bytes(), ef.expiry.Bytes())
+}
+
+func (ef expiringFact) isValidExpiry() error {
+	if !ef.IsExpiring() {
+		return nil
+	}
+
+	return ef.expiry.IsValid(nil)
+}
+
+// Expiry is the valid period of operation. The operation is valid until the
+// height and the time; the zero height or the zero time means no limit. The
+// time is compared with the confirmed time of the last block, so every node
+// gets the same result.
+type Expiry struct {
+	height base.Height
+	t      time.Time
+}
+
+func NewExpiry(height base.Height, t time.Time) Expiry {
+	if !t.IsZero() {
+		t = localtime.Normalize(t)
+	}
+
+	return Expiry{height: height, t: t}
+}
+
+func (ex Expiry) Bytes() []byte {
+	var bt []byte
+	if !ex.t.IsZero() {
+		bt = localtime.NewTime(ex.t).Bytes()
+	}
+
+	return util.ConcatBytesSlice(ex.height.Bytes(), bt)
+}
+
+func (ex Expiry) IsValid([]byte) error {
+	if ex.IsEmpty() {
+		return xerrors.Errorf("empty expiry")
+	}
+
+	if ex.height < 0 {
+		return xerrors.Errorf("invalid height of expiry, %d", ex.height)
+	}
+
+	return nil
+}
+
+func (ex Expiry) Height() base.Height {
+	return ex.height
+}
+
+func (ex Expiry) Time() time.Time {
+	return ex.t
+}
+
+func (ex Expiry) IsEmpty() bool {
+	return ex.height < 1 && ex.t.IsZero()
+}
+
+// IsExpired checks the height of proposal and the confirmed time of the last
+// block. The zero t is ignored.
+func (ex Expiry) IsExpired(height base.Height, t time.Time) error {
+	if ex.height > 0 && height > ex.height {
+		return xerrors.Errorf("operation expired at height, %d < %d", ex.height, height)
+	}
+
+	if t.IsZero() || ex.t.IsZero() {
+		return nil
+	}
+
+	if t = localtime.Normalize(t); t.After(ex.t) {
+		return xerrors.Errorf("operation expired at time, %s < %s", localtime.RFC3339(ex.t), localtime.RFC3339(t))
+	}
+
+	return nil
+}
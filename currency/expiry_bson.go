@@ -0,0 +1,39 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/localtime"
+)
+
+type ExpiryBSONPacker struct {
+	HT base.Height     `bson:"height"`
+	TM *localtime.Time `bson:"time,omitempty"`
+}
+
+func (ex Expiry) MarshalBSON() ([]byte, error) {
+	p := ExpiryBSONPacker{HT: ex.height}
+	if !ex.t.IsZero() {
+		t := localtime.NewTime(ex.t)
+		p.TM = &t
+	}
+
+	return bsonenc.Marshal(p)
+}
+
+func (ex *Expiry) UnmarshalBSON(b []byte) error {
+	var uex ExpiryBSONPacker
+	if err := bson.Unmarshal(b, &uex); err != nil {
+		return err
+	}
+
+	if uex.TM == nil {
+		*ex = NewExpiry(uex.HT, localtime.Time{}.Time)
+	} else {
+		*ex = NewExpiry(uex.HT, uex.TM.Time)
+	}
+
+	return nil
+}
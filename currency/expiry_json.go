@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+)
+
+type ExpiryJSONPacker struct {
+	HT base.Height     `json:"height"`
+	TM *localtime.Time `json:"time,omitempty"`
+}
+
+func (ex Expiry) MarshalJSON() ([]byte, error) {
+	p := ExpiryJSONPacker{HT: ex.height}
+	if !ex.t.IsZero() {
+		t := localtime.NewTime(ex.t)
+		p.TM = &t
+	}
+
+	return jsonenc.Marshal(p)
+}
+
+func (ex *Expiry) UnmarshalJSON(b []byte) error {
+	var uex ExpiryJSONPacker
+	if err := jsonenc.Unmarshal(b, &uex); err != nil {
+		return err
+	}
+
+	if uex.TM == nil {
+		*ex = NewExpiry(uex.HT, localtime.Time{}.Time)
+	} else {
+		*ex = NewExpiry(uex.HT, uex.TM.Time)
+	}
+
+	return nil
+}
@@ -0,0 +1,346 @@
+package currency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/block"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testExpiry struct {
+	suite.Suite
+}
+
+func (t *testExpiry) TestIsValid() {
+	t.NoError(NewExpiry(33, time.Time{}).IsValid(nil))
+	t.NoError(NewExpiry(0, localtime.UTCNow()).IsValid(nil))
+
+	err := NewExpiry(0, time.Time{}).IsValid(nil)
+	t.Contains(err.Error(), "empty expiry")
+}
+
+func (t *testExpiry) TestIsExpired() {
+	now := localtime.UTCNow()
+
+	ex := NewExpiry(33, now)
+	t.NoError(ex.IsExpired(33, now))
+	t.NoError(ex.IsExpired(32, now.Add(-time.Second)))
+	t.NoError(ex.IsExpired(32, time.Time{}))
+
+	err := ex.IsExpired(34, now)
+	t.Contains(err.Error(), "expired at height")
+
+	err = ex.IsExpired(33, now.Add(time.Second))
+	t.Contains(err.Error(), "expired at time")
+}
+
+func (t *testExpiry) TestBytes() {
+	now := localtime.UTCNow()
+
+	a := NewExpiry(33, now)
+	b := NewExpiry(33, now.Add(time.Second))
+	c := NewExpiry(34, now)
+	t.NotEqual(a.Bytes(), b.Bytes())
+	t.NotEqual(a.Bytes(), c.Bytes())
+	t.Equal(a.Bytes(), NewExpiry(33, now).Bytes())
+}
+
+func TestExpiry(t *testing.T) {
+	suite.Run(t, new(testExpiry))
+}
+
+type lastManifestDatabase struct {
+	storage.Database
+	m block.Manifest
+}
+
+func (st lastManifestDatabase) LastManifest() (block.Manifest, bool, error) {
+	return st.m, true, nil
+}
+
+type testExpiryOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testExpiryOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+// statepoolAt returns the Statepool and OperationProcessor after the block of
+// the given height and confirmed time.
+func (t *testExpiryOperations) statepoolAt(
+	height base.Height,
+	confirmedAt time.Time,
+	s ...[]state.State,
+) (*storage.Statepool, prprocessor.OperationProcessor) {
+	blk, err := block.NewBlockV0(
+		block.SuffrageInfoV0{}, height, base.Round(0),
+		valuehash.RandomSHA256(), valuehash.RandomSHA256(), nil, nil, confirmedAt,
+	)
+	t.NoError(err)
+
+	st := lastManifestDatabase{Database: t.Database(nil, nil), m: blk.Manifest()}
+
+	bs := map[string]state.State{}
+	for _, l := range s {
+		for _, i := range l {
+			bs[i.Key()] = i
+		}
+	}
+
+	pool, err := storage.NewStatepoolWithBase(st, bs)
+	t.NoError(err)
+
+	copr, err := NewOperationProcessor(nil).
+		SetLastManifest(st.LastManifest).
+		SetProcessor(Transfers{}, NewTransfersProcessor(nil))
+	t.NoError(err)
+
+	_, err = copr.(*OperationProcessor).SetProcessor(Approve{}, NewApproveProcessor(nil))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(AccountMerge{}, NewAccountMergeProcessor(nil))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Batch{}, NewBatchProcessor(nil))
+	t.NoError(err)
+
+	return pool, copr.New(pool)
+}
+
+func (t *testExpiryOperations) factSigns(sender *account, fact base.Fact) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range sender.Privs() {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testExpiryOperations) newTransfer(sender *account, receiver base.Address, ex Expiry) Transfers {
+	items := []TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(NewBig(1), t.cid))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sender.Address, items)
+	if !ex.IsEmpty() {
+		fact = fact.WithExpiry(ex)
+	}
+
+	tf, err := NewTransfers(fact, t.factSigns(sender, fact), "")
+	t.NoError(err)
+	t.NoError(tf.IsValid(nil))
+
+	return tf
+}
+
+func (t *testExpiryOperations) TestNotExpired() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	confirmedAt := localtime.UTCNow()
+	pool, opr := t.statepoolAt(32, confirmedAt, st0, st1)
+	t.Equal(base.Height(33), pool.Height())
+
+	tf := t.newTransfer(sa, ra.Address, NewExpiry(33, confirmedAt.Add(time.Second)))
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+	t.NotEmpty(pool.Updates())
+}
+
+func (t *testExpiryOperations) TestWithoutExpiry() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, opr := t.statepoolAt(32, localtime.UTCNow(), st0, st1)
+
+	tf := t.newTransfer(sa, ra.Address, Expiry{})
+	t.True(tf.Fact().Hint().Equal(TransfersFactHint))
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+	t.NotEmpty(pool.Updates())
+}
+
+func (t *testExpiryOperations) TestExpiredByHeight() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	_, opr := t.statepoolAt(32, localtime.UTCNow(), st0, st1)
+
+	tf := t.newTransfer(sa, ra.Address, NewExpiry(32, time.Time{}))
+
+	err := opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "expired at height")
+}
+
+func (t *testExpiryOperations) TestExpiredByTime() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	confirmedAt := localtime.UTCNow()
+	_, opr := t.statepoolAt(32, confirmedAt, st0, st1)
+
+	tf := t.newTransfer(sa, ra.Address, NewExpiry(0, confirmedAt.Add(time.Second*-1)))
+
+	err := opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "expired at time")
+}
+
+func (t *testExpiryOperations) TestExpiredFacts() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	_, opr := t.statepoolAt(32, localtime.UTCNow(), st0, st1)
+
+	ex := NewExpiry(32, time.Time{})
+
+	afact := NewApproveFact(util.UUID().Bytes(), sa.Address, ra.Address, []Amount{NewAmount(NewBig(1), t.cid)}).
+		WithExpiry(ex)
+	ap, err := NewApprove(afact, t.factSigns(sa, afact), "")
+	t.NoError(err)
+
+	mfact := NewAccountMergeFact(util.UUID().Bytes(), sa.Address, ra.Address).WithExpiry(ex)
+	am, err := NewAccountMerge(mfact, t.factSigns(sa, mfact), "")
+	t.NoError(err)
+
+	// NOTE the operation inside Batch is not expired, but Batch is.
+	bfact := NewBatchFact(util.UUID().Bytes(), []operation.Operation{t.newTransfer(sa, ra.Address, Expiry{})}).
+		WithExpiry(ex)
+	bt, err := NewBatch(bfact, t.factSigns(sa, bfact), "")
+	t.NoError(err)
+
+	for _, op := range []operation.Operation{ap, am, bt} {
+		t.NoError(op.IsValid(nil))
+		t.Implements((*ExpiringFact)(nil), op.Fact())
+
+		err := opr.Process(op.(state.Processor))
+
+		var oper operation.ReasonError
+		t.True(xerrors.As(err, &oper), "%T", op)
+		t.Contains(err.Error(), "expired at height", "%T", op)
+	}
+}
+
+func TestExpiryOperations(t *testing.T) {
+	suite.Run(t, new(testExpiryOperations))
+}
+
+func testExpiryEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		skey, err := NewKey(pk.Publickey(), 100)
+		t.NoError(err)
+		skeys, err := NewKeys([]Key{skey}, 100)
+		t.NoError(err)
+
+		sender, _ := NewAddressFromKeys(skeys)
+		receiver := NewTestAddress()
+
+		items := []TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(NewBig(11), CurrencyID("SHOWME")))}
+		fact := NewTransfersFact(util.UUID().Bytes(), sender, items).
+			WithExpiry(NewExpiry(33, localtime.UTCNow()))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		tf, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return tf
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(Transfers)
+		tb := b.(Transfers)
+
+		fact := ta.Fact().(TransfersFact)
+		ufact := tb.Fact().(TransfersFact)
+
+		t.True(ufact.Hint().Equal(TransfersFactExpiringHint))
+		t.True(fact.Hash().Equal(ufact.Hash()))
+		t.Equal(fact.Expiry().Height(), ufact.Expiry().Height())
+		t.True(localtime.Equal(fact.Expiry().Time(), ufact.Expiry().Time()))
+		t.NoError(ufact.IsValid(nil))
+	}
+
+	return t
+}
+
+func TestExpiryEncodeJSON(t *testing.T) {
+	suite.Run(t, testExpiryEncode(jsonenc.NewEncoder()))
+}
+
+func TestExpiryEncodeBSON(t *testing.T) {
+	suite.Run(t, testExpiryEncode(bsonenc.NewEncoder()))
+}
+
+func testExpiryCurrencyPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+		fact := NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), CurrencyID("SHOWME"), po).
+			WithActivation(base.Height(44)).
+			WithExpiry(NewExpiry(33, localtime.UTCNow()))
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewCurrencyPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(CurrencyPolicyUpdater).Fact().(CurrencyPolicyUpdaterFact)
+		ufact := b.(CurrencyPolicyUpdater).Fact().(CurrencyPolicyUpdaterFact)
+
+		t.True(ufact.Hint().Equal(CurrencyPolicyUpdaterFactExpiringHint))
+		t.True(fact.Hash().Equal(ufact.Hash()))
+		t.True(ufact.IsScheduled())
+		t.Equal(base.Height(44), ufact.ActivationHeight())
+		t.Equal(fact.Expiry().Height(), ufact.Expiry().Height())
+		t.True(localtime.Equal(fact.Expiry().Time(), ufact.Expiry().Time()))
+		t.NoError(ufact.IsValid(nil))
+	}
+
+	return t
+}
+
+func TestExpiryCurrencyPolicyUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testExpiryCurrencyPolicyUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestExpiryCurrencyPolicyUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testExpiryCurrencyPolicyUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,112 @@
+package currency
+
+import (
+	"sort"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"golang.org/x/xerrors"
+)
+
+// OperationFee returns the fee offered by the operation. The fee of amount is
+// calculated by the Feeer of currency and the fees of the different currencies
+// are summed up; the operation without amounts offers the fee of the zero
+// amount.
+func OperationFee(cp *CurrencyPool, op operation.Operation) (Big, error) {
+	return factFee(cp, op.Fact())
+}
+
+func factFee(cp *CurrencyPool, fact base.Fact) (Big, error) {
+	if cp == nil {
+		return ZeroBig, nil
+	}
+
+	var ams []Amount
+	switch t := fact.(type) {
+	case BatchFact:
+		fee := ZeroBig
+		for _, op := range t.Operations() {
+			if i, err := factFee(cp, op.Fact()); err != nil {
+				return ZeroBig, err
+			} else {
+				fee = fee.Add(i)
+			}
+		}
+
+		return fee, nil
+	case TransfersFact:
+		for _, it := range t.Items() {
+			ams = append(ams, it.Amounts()...)
+		}
+	case CreateAccountsFact:
+		for _, it := range t.Items() {
+			ams = append(ams, it.Amounts()...)
+		}
+	case MultiTransfersFact:
+		for _, it := range t.Items() {
+			ams = append(ams, it.Amounts()...)
+		}
+	case AmountsItem:
+		ams = t.Amounts()
+	case interface{ Currency() CurrencyID }:
+		ams = []Amount{NewZeroAmount(t.Currency())}
+	default:
+		return ZeroBig, nil
+	}
+
+	fee := ZeroBig
+	for i := range ams {
+		am := ams[i]
+
+		if feeer, found := cp.Feeer(am.Currency()); !found {
+			return ZeroBig, xerrors.Errorf("unknown currency id found, %q", am.Currency())
+		} else if k, err := feeer.Fee(am.Big()); err != nil {
+			return ZeroBig, err
+		} else {
+			fee = fee.Add(k)
+		}
+	}
+
+	return fee, nil
+}
+
+// SortOperationsByFee orders the operations by the offered fee, the higher fee
+// first. The operations of same fee and the operations, whose fee can not be
+// calculated, keep the original order after them.
+func SortOperationsByFee(cp *CurrencyPool, ops []operation.Operation) []operation.Operation {
+	fees := make([]Big, len(ops))
+	for i := range ops {
+		if fee, err := OperationFee(cp, ops[i]); err != nil {
+			fees[i] = ZeroBig
+		} else {
+			fees[i] = fee
+		}
+	}
+
+	indices := sortByFee(fees)
+
+	sorted := make([]operation.Operation, len(ops))
+	for i := range indices {
+		sorted[i] = ops[indices[i]]
+	}
+
+	return sorted
+}
+
+// DefaultFeePriorityWindow is the default number of staged operation seals,
+// which are ordered by ProposalDatabase at once.
+var DefaultFeePriorityWindow uint = 1000
+
+// sortByFee returns the indices of fees, ordered by the higher fee first.
+func sortByFee(fees []Big) []int {
+	indices := make([]int, len(fees))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return fees[indices[i]].Compare(fees[indices[j]]) > 0
+	})
+
+	return indices
+}
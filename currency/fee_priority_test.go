@@ -0,0 +1,147 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testFeePriority struct {
+	testTransfersOperations
+}
+
+func (t *testFeePriority) TestOperationFee() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+	rb, _ := t.newAccount(true, nil)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(3)))))
+
+	items := []TransfersItem{
+		t.newTransfersItem(ra.Address, NewBig(1)),
+		t.newTransfersItem(rb.Address, NewBig(1)),
+	}
+
+	fee, err := OperationFee(cp, t.newTransfer(sa.Address, sa.Privs(), items))
+	t.NoError(err)
+	t.Equal(NewBig(6), fee)
+
+	fee, err = OperationFee(nil, t.newTransfer(sa.Address, sa.Privs(), items))
+	t.NoError(err)
+	t.Equal(ZeroBig, fee)
+}
+
+func (t *testFeePriority) TestUnknownCurrency() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))}
+
+	_, err := OperationFee(NewCurrencyPool(), t.newTransfer(sa.Address, sa.Privs(), items))
+	t.Contains(err.Error(), "unknown currency id")
+}
+
+func (t *testFeePriority) TestSortOperationsByFee() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewRatioFeeer(sa.Address, 0.1, ZeroBig, NewBig(100)))))
+
+	newTransfer := func(big int64) operation.Operation {
+		return t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(big))})
+	}
+
+	ops := []operation.Operation{
+		newTransfer(10),
+		newTransfer(100),
+		newTransfer(1),
+		newTransfer(30),
+		newTransfer(2),
+	}
+
+	sorted := SortOperationsByFee(cp, ops)
+	t.Equal(len(ops), len(sorted))
+
+	// NOTE the operations of same fee, 1 and 2 keep the original order
+	expected := []operation.Operation{ops[1], ops[3], ops[0], ops[2], ops[4]}
+	for i := range expected {
+		t.True(expected[i].Hash().Equal(sorted[i].Hash()))
+	}
+}
+
+type dummyStagedDatabase struct {
+	storage.Database
+	seals  []operation.Seal
+	states map[string]state.State
+	known  map[string]struct{}
+}
+
+func (st dummyStagedDatabase) State(key string) (state.State, bool, error) {
+	i, found := st.states[key]
+
+	return i, found, nil
+}
+
+func (st dummyStagedDatabase) HasOperationFact(h valuehash.Hash) (bool, error) {
+	_, found := st.known[h.String()]
+
+	return found, nil
+}
+
+func (st dummyStagedDatabase) StagedOperationSeals(callback func(operation.Seal) (bool, error), _ bool) error {
+	for i := range st.seals {
+		if keep, err := callback(st.seals[i]); err != nil {
+			return err
+		} else if !keep {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (t *testFeePriority) TestProposalDatabaseWindow() {
+	sa, _ := t.newAccount(true, nil)
+	ra, _ := t.newAccount(true, nil)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewRatioFeeer(sa.Address, 0.1, ZeroBig, NewBig(100)))))
+
+	newSeal := func(big int64) operation.Seal {
+		op := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(big))})
+
+		sl, err := operation.NewBaseSeal(sa.Priv, []operation.Operation{op}, nil)
+		t.NoError(err)
+
+		return sl
+	}
+
+	seals := []operation.Seal{newSeal(10), newSeal(30), newSeal(100), newSeal(200)}
+
+	st := NewProposalDatabase(dummyStagedDatabase{seals: seals}, cp).SetFeePriority(3)
+
+	var picked []operation.Seal
+	t.NoError(st.StagedOperationSeals(func(sl operation.Seal) (bool, error) {
+		picked = append(picked, sl)
+
+		return true, nil
+	}, true))
+
+	// NOTE the seals over window are not read.
+	expected := []operation.Seal{seals[2], seals[1], seals[0]}
+	t.Equal(len(expected), len(picked))
+	for i := range expected {
+		t.True(expected[i].Hash().Equal(picked[i].Hash()))
+	}
+}
+
+func TestFeePriority(t *testing.T) {
+	suite.Run(t, new(testFeePriority))
+}
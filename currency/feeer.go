@@ -13,18 +13,21 @@ import (
 )
 
 const (
-	FeeerNil   = "nil"
-	FeeerFixed = "fixed"
-	FeeerRatio = "ratio"
+	FeeerNil     = "nil"
+	FeeerFixed   = "fixed"
+	FeeerRatio   = "ratio"
+	FeeerDynamic = "dynamic"
 )
 
 var (
-	NilFeeerType   = hint.MustNewType(0xa0, 0x31, "mitum-currency-nil-feeer")
-	NilFeeerHint   = hint.MustHint(NilFeeerType, "0.0.1")
-	FixedFeeerType = hint.MustNewType(0xa0, 0x32, "mitum-currency-fixed-feeer")
-	FixedFeeerHint = hint.MustHint(FixedFeeerType, "0.0.1")
-	RatioFeeerType = hint.MustNewType(0xa0, 0x33, "mitum-currency-ratio-feeer")
-	RatioFeeerHint = hint.MustHint(RatioFeeerType, "0.0.1")
+	NilFeeerType     = hint.MustNewType(0xa0, 0x31, "mitum-currency-nil-feeer")
+	NilFeeerHint     = hint.MustHint(NilFeeerType, "0.0.1")
+	FixedFeeerType   = hint.MustNewType(0xa0, 0x32, "mitum-currency-fixed-feeer")
+	FixedFeeerHint   = hint.MustHint(FixedFeeerType, "0.0.1")
+	RatioFeeerType   = hint.MustNewType(0xa0, 0x33, "mitum-currency-ratio-feeer")
+	RatioFeeerHint   = hint.MustHint(RatioFeeerType, "0.0.1")
+	DynamicFeeerType = hint.MustNewType(0xa0, 0x6a, "mitum-currency-dynamic-feeer")
+	DynamicFeeerHint = hint.MustHint(DynamicFeeerType, "0.0.1")
 )
 
 var UnlimitedMaxFeeAmount = NewBig(-1)
@@ -219,6 +222,173 @@ func (fa RatioFeeer) isOne() bool {
 	return fa.ratio == 1
 }
 
+// DynamicFeeer charges the base fee, which is adjusted every block by the
+// utilization of the previous block; the items of the operations of the
+// currency are measured against the capacity of block. If the utilization is
+// over target percent, the base fee rises, and if under, it falls. The change
+// of one block is limited to 1/denominator of the base fee. The base fee is
+// kept in state, not in DynamicFeeer.
+type DynamicFeeer struct {
+	receiver    base.Address
+	min         Big
+	max         Big
+	target      uint
+	denominator uint
+	base        Big
+}
+
+func NewDynamicFeeer(receiver base.Address, min, max Big, target, denominator uint) DynamicFeeer {
+	return DynamicFeeer{
+		receiver:    receiver,
+		min:         min,
+		max:         max,
+		target:      target,
+		denominator: denominator,
+		base:        min,
+	}
+}
+
+func (fa DynamicFeeer) Type() string {
+	return FeeerDynamic
+}
+
+func (fa DynamicFeeer) Hint() hint.Hint {
+	return DynamicFeeerHint
+}
+
+func (fa DynamicFeeer) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fa.receiver.Bytes(),
+		fa.min.Bytes(),
+		fa.max.Bytes(),
+		util.UintToBytes(fa.target),
+		util.UintToBytes(fa.denominator),
+	)
+}
+
+func (fa DynamicFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+func (fa DynamicFeeer) Min() Big {
+	return fa.min
+}
+
+func (fa DynamicFeeer) Max() Big {
+	return fa.max
+}
+
+func (fa DynamicFeeer) Target() uint {
+	return fa.target
+}
+
+func (fa DynamicFeeer) Denominator() uint {
+	return fa.denominator
+}
+
+func (fa DynamicFeeer) Fee(Big) (Big, error) {
+	return fa.BaseFee(), nil
+}
+
+// BaseFee returns the current base fee; without the base fee, min is the base
+// fee.
+func (fa DynamicFeeer) BaseFee() Big {
+	return fa.clamp(fa.base)
+}
+
+func (fa DynamicFeeer) WithBaseFee(b Big) DynamicFeeer {
+	fa.base = fa.clamp(b)
+
+	return fa
+}
+
+// NextBaseFee returns the base fee of the next block by the items of the
+// operations of current block, used, against the capacity of block.
+func (fa DynamicFeeer) NextBaseFee(used, capacity uint) Big {
+	b := fa.BaseFee()
+	if fa.target < 1 || fa.denominator < 1 || capacity < 1 {
+		return b
+	}
+
+	if used > capacity {
+		used = capacity
+	}
+
+	u := uint64(used) * 100
+	t := uint64(fa.target) * uint64(capacity)
+
+	switch {
+	case u == t:
+		return b
+	case u > t:
+		d := b.Mul(NewBig(int64(u - t))).Div(NewBig(int64(t))).Div(NewBig(int64(fa.denominator)))
+		if !d.OverZero() {
+			d = NewBig(1)
+		}
+
+		return fa.clamp(b.Add(d))
+	default:
+		d := b.Mul(NewBig(int64(t - u))).Div(NewBig(int64(t))).Div(NewBig(int64(fa.denominator)))
+
+		return fa.clamp(b.Sub(d))
+	}
+}
+
+// DecayedBaseFee returns the base fee after the empty blocks, which have no
+// operation of the currency.
+func (fa DynamicFeeer) DecayedBaseFee(blocks uint64) Big {
+	b := fa.BaseFee()
+	for i := uint64(0); i < blocks; i++ {
+		n := fa.WithBaseFee(b).NextBaseFee(0, 1)
+		if n.Equal(b) {
+			break
+		}
+
+		b = n
+	}
+
+	return b
+}
+
+func (fa DynamicFeeer) IsValid([]byte) error {
+	if err := fa.receiver.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid receiver for dynamic feeer: %w", err)
+	}
+
+	if fa.target < 1 || fa.target > 100 {
+		return xerrors.Errorf("dynamic feeer target should be 1 <= target <= 100, %d", fa.target)
+	} else if fa.denominator < 1 {
+		return xerrors.Errorf("dynamic feeer denominator should be over zero")
+	}
+
+	if !fa.min.OverNil() {
+		return xerrors.Errorf("dynamic feeer min amount under zero")
+	} else if !fa.max.Equal(UnlimitedMaxFeeAmount) {
+		if !fa.max.OverNil() {
+			return xerrors.Errorf("dynamic feeer max amount under zero")
+		} else if fa.min.Compare(fa.max) > 0 {
+			return xerrors.Errorf("dynamic feeer max should be over min")
+		}
+	}
+
+	return nil
+}
+
+func (fa DynamicFeeer) isUnlimited() bool {
+	return fa.max.Equal(UnlimitedMaxFeeAmount)
+}
+
+func (fa DynamicFeeer) clamp(b Big) Big {
+	switch {
+	case b.Int == nil, b.Compare(fa.min) < 0:
+		return fa.min
+	case !fa.isUnlimited() && b.Compare(fa.max) > 0:
+		return fa.max
+	default:
+		return b
+	}
+}
+
 func NewFeeToken(feeer Feeer, height base.Height) []byte {
 	return util.ConcatBytesSlice(feeer.Bytes(), height.Bytes())
 }
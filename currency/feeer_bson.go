@@ -66,3 +66,33 @@ func (fa *RatioFeeer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.RC, ufa.RA, ufa.MI, ufa.MA)
 }
+
+func (fa DynamicFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(fa.Hint()),
+		bson.M{
+			"receiver":    fa.receiver,
+			"min":         fa.min,
+			"max":         fa.max,
+			"target":      fa.target,
+			"denominator": fa.denominator,
+		}),
+	)
+}
+
+type DynamicFeeerBSONUnpacker struct {
+	RC base.AddressDecoder `bson:"receiver"`
+	MI Big                 `bson:"min"`
+	MA Big                 `bson:"max"`
+	TA uint                `bson:"target"`
+	DE uint                `bson:"denominator"`
+}
+
+func (fa *DynamicFeeer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufa DynamicFeeerBSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return err
+	}
+
+	return fa.unpack(enc, ufa.RC, ufa.MI, ufa.MA, ufa.TA, ufa.DE)
+}
@@ -30,3 +30,24 @@ func (fa *RatioFeeer) unpack(enc encoder.Encoder, brc base.AddressDecoder, ratio
 
 	return nil
 }
+
+func (fa *DynamicFeeer) unpack(
+	enc encoder.Encoder,
+	brc base.AddressDecoder,
+	min, max Big,
+	target, denominator uint,
+) error {
+	if i, err := brc.Encode(enc); err != nil {
+		return err
+	} else {
+		fa.receiver = i
+	}
+
+	fa.min = min
+	fa.max = max
+	fa.target = target
+	fa.denominator = denominator
+	fa.base = min
+
+	return nil
+}
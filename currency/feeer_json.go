@@ -84,3 +84,42 @@ func (fa *RatioFeeer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.RC, ufa.RA, ufa.MI, ufa.MA)
 }
+
+type DynamicFeeerJSONPacker struct {
+	jsonenc.HintedHead
+	TY string       `json:"type"`
+	RC base.Address `json:"receiver"`
+	MI Big          `json:"min"`
+	MA Big          `json:"max"`
+	TA uint         `json:"target"`
+	DE uint         `json:"denominator"`
+}
+
+func (fa DynamicFeeer) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DynamicFeeerJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fa.Hint()),
+		TY:         fa.Type(),
+		RC:         fa.receiver,
+		MI:         fa.min,
+		MA:         fa.max,
+		TA:         fa.target,
+		DE:         fa.denominator,
+	})
+}
+
+type DynamicFeeerJSONUnpacker struct {
+	RC base.AddressDecoder `json:"receiver"`
+	MI Big                 `json:"min"`
+	MA Big                 `json:"max"`
+	TA uint                `json:"target"`
+	DE uint                `json:"denominator"`
+}
+
+func (fa *DynamicFeeer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufa DynamicFeeerJSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return err
+	}
+
+	return fa.unpack(enc, ufa.RC, ufa.MI, ufa.MA, ufa.TA, ufa.DE)
+}
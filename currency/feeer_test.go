@@ -187,6 +187,106 @@ func (t *testFeeer) TestRatioFeeer() {
 	}
 }
 
+func (t *testFeeer) TestDynamicFeeer() {
+	cases := []struct {
+		name   string
+		min    string
+		max    string
+		base   string
+		used   uint
+		result string
+	}{
+		{name: "on target", min: "10", base: "100", used: 10, result: "100"},
+		{name: "double target", min: "10", base: "100", used: 20, result: "112"},
+		{name: "full block", min: "10", base: "100", used: 100, result: "212"},
+		{name: "over capacity", min: "10", base: "100", used: 101, result: "212"},
+		{name: "over target", min: "10", base: "100", used: 15, result: "106"},
+		{name: "empty block", min: "10", base: "100", used: 0, result: "88"},
+		{name: "under min", min: "10", base: "10", used: 0, result: "10"},
+		{name: "at least one", min: "0", base: "3", used: 11, result: "4"},
+		{name: "from zero", min: "0", base: "0", used: 20, result: "1"},
+		{name: "over max", min: "10", max: "105", base: "100", used: 20, result: "105"},
+		{name: "no base", min: "10", used: 20, result: "11"},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				min, err := NewBigFromString(c.min)
+				t.NoError(err)
+
+				var max Big = UnlimitedMaxFeeAmount
+				if len(c.max) > 0 {
+					i, err := NewBigFromString(c.max)
+					t.NoError(err)
+					max = i
+				}
+
+				receiver := MustAddress(util.UUID().String())
+				fa := NewDynamicFeeer(receiver, min, max, 10, 8)
+				t.NoError(fa.IsValid(nil))
+
+				if len(c.base) > 0 {
+					base, err := NewBigFromString(c.base)
+					t.NoError(err)
+
+					fa = fa.WithBaseFee(base)
+
+					fee, err := fa.Fee(NewBig(1000))
+					t.NoError(err)
+					t.Equal(fa.BaseFee(), fee)
+				}
+
+				result := fa.NextBaseFee(c.used, 100)
+				t.Equal(c.result, result.String(), "%d: %v; %v != %v", i, c.name, c.result, result.String())
+			},
+		)
+	}
+}
+
+func (t *testFeeer) TestDynamicFeeerCapacity() {
+	fa := NewDynamicFeeer(MustAddress(util.UUID().String()), NewBig(10), UnlimitedMaxFeeAmount, 50, 8).
+		WithBaseFee(NewBig(100))
+
+	// NOTE same utilization by different capacity
+	t.Equal(NewBig(112), fa.NextBaseFee(10, 10))
+	t.Equal(NewBig(112), fa.NextBaseFee(1000, 1000))
+	t.Equal(NewBig(100), fa.NextBaseFee(500, 1000))
+	t.Equal(NewBig(94), fa.NextBaseFee(250, 1000))
+
+	// NOTE without capacity, base fee is not changed
+	t.Equal(NewBig(100), fa.NextBaseFee(10, 0))
+}
+
+func (t *testFeeer) TestDynamicFeeerDecayed() {
+	fa := NewDynamicFeeer(MustAddress(util.UUID().String()), NewBig(10), UnlimitedMaxFeeAmount, 50, 8).
+		WithBaseFee(NewBig(800))
+
+	t.Equal(NewBig(800), fa.DecayedBaseFee(0))
+	t.Equal(NewBig(700), fa.DecayedBaseFee(1))
+	t.Equal(NewBig(613), fa.DecayedBaseFee(2))
+	t.Equal(NewBig(10), fa.DecayedBaseFee(1000000))
+}
+
+func (t *testFeeer) TestDynamicFeeerInvalid() {
+	receiver := MustAddress(util.UUID().String())
+
+	err := NewDynamicFeeer(receiver, NewBig(10), NewBig(9), 10, 8).IsValid(nil)
+	t.Contains(err.Error(), "max should be over min")
+
+	err = NewDynamicFeeer(receiver, NewBig(10), UnlimitedMaxFeeAmount, 0, 8).IsValid(nil)
+	t.Contains(err.Error(), "target should be 1 <= target <= 100")
+
+	err = NewDynamicFeeer(receiver, NewBig(10), UnlimitedMaxFeeAmount, 101, 8).IsValid(nil)
+	t.Contains(err.Error(), "target should be 1 <= target <= 100")
+
+	err = NewDynamicFeeer(receiver, NewBig(10), UnlimitedMaxFeeAmount, 10, 0).IsValid(nil)
+	t.Contains(err.Error(), "denominator should be over zero")
+}
+
 func TestFeeer(t *testing.T) {
 	suite.Run(t, new(testFeeer))
 }
@@ -253,6 +353,30 @@ func testRatioFeeerEncode(enc encoder.Encoder) suite.TestingSuite {
 	return t
 }
 
+func testDynamicFeeerEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewDynamicFeeer(
+			MustAddress(util.UUID().String()),
+			NewBig(33),
+			NewBig(340),
+			100,
+			8,
+		)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(DynamicFeeer)
+		cb := b.(DynamicFeeer)
+
+		t.Equal(ca, cb)
+	}
+
+	return t
+}
+
 func TestNilFeeerEncodeJSON(t *testing.T) {
 	suite.Run(t, testNilFeeerEncode(jsonenc.NewEncoder()))
 }
@@ -265,6 +389,10 @@ func TestRatioFeeerEncodeJSON(t *testing.T) {
 	suite.Run(t, testRatioFeeerEncode(jsonenc.NewEncoder()))
 }
 
+func TestDynamicFeeerEncodeJSON(t *testing.T) {
+	suite.Run(t, testDynamicFeeerEncode(jsonenc.NewEncoder()))
+}
+
 func TestNilFeeerEncodeBSON(t *testing.T) {
 	suite.Run(t, testNilFeeerEncode(bsonenc.NewEncoder()))
 }
@@ -276,3 +404,7 @@ func TestFixedFeeerEncodeBSON(t *testing.T) {
 func TestRatioFeeerEncodeBSON(t *testing.T) {
 	suite.Run(t, testRatioFeeerEncode(bsonenc.NewEncoder()))
 }
+
+func TestDynamicFeeerEncodeBSON(t *testing.T) {
+	suite.Run(t, testDynamicFeeerEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,166 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	GuardiansType = hint.MustNewType(0xa0, 0x52, "mitum-currency-guardians")
+	GuardiansHint = hint.MustHint(GuardiansType, "0.0.1")
+	RecoveryType  = hint.MustNewType(0xa0, 0x53, "mitum-currency-recovery")
+	RecoveryHint  = hint.MustHint(RecoveryType, "0.0.1")
+)
+
+var MaxGuardians uint = 10
+
+// Guardians is the set of accounts, which can recover the keys of account.
+// When threshold of guardians sign KeyRecovery, the new keys take effect after
+// delay heights. Empty Guardians means the guardians are removed.
+type Guardians struct {
+	guardians []base.Address
+	threshold uint
+	delay     base.Height
+}
+
+func NewGuardians(guardians []base.Address, threshold uint, delay base.Height) Guardians {
+	return Guardians{guardians: guardians, threshold: threshold, delay: delay}
+}
+
+func (gd Guardians) Hint() hint.Hint {
+	return GuardiansHint
+}
+
+func (gd Guardians) Bytes() []byte {
+	bs := make([][]byte, len(gd.guardians)+2)
+	bs[0] = util.UintToBytes(gd.threshold)
+	bs[1] = gd.delay.Bytes()
+
+	for i := range gd.guardians {
+		bs[i+2] = gd.guardians[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (gd Guardians) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(gd.Bytes())
+}
+
+func (gd Guardians) IsValid([]byte) error {
+	n := len(gd.guardians)
+	if n < 1 {
+		if gd.threshold != 0 {
+			return xerrors.Errorf("threshold of empty guardians should be 0, %d", gd.threshold)
+		}
+
+		return nil
+	}
+
+	if uint(n) > MaxGuardians {
+		return xerrors.Errorf("guardians over allowed; %d > %d", n, MaxGuardians)
+	}
+
+	if gd.threshold < 1 || gd.threshold > uint(n) {
+		return xerrors.Errorf("invalid guardians threshold, %d; should be in 1-%d", gd.threshold, n)
+	}
+
+	if gd.delay < 1 {
+		return xerrors.Errorf("invalid recovery delay, %d", gd.delay)
+	}
+
+	foundGuardians := map[string]struct{}{}
+	for i := range gd.guardians {
+		a := gd.guardians[i]
+		if a == nil {
+			return xerrors.Errorf("empty guardian found")
+		}
+
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := foundGuardians[a.String()]; found {
+			return xerrors.Errorf("duplicated guardian found, %s", a)
+		}
+
+		foundGuardians[a.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (gd Guardians) Guardians() []base.Address {
+	return gd.guardians
+}
+
+func (gd Guardians) Threshold() uint {
+	return gd.threshold
+}
+
+func (gd Guardians) Delay() base.Height {
+	return gd.delay
+}
+
+func (gd Guardians) IsEmpty() bool {
+	return len(gd.guardians) < 1
+}
+
+func (gd Guardians) Exists(a base.Address) bool {
+	for i := range gd.guardians {
+		if gd.guardians[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Recovery is the pending key recovery started by guardians. keys replace the
+// keys of account from height.
+type Recovery struct {
+	keys   Keys
+	height base.Height
+}
+
+func NewRecovery(keys Keys, height base.Height) Recovery {
+	return Recovery{keys: keys, height: height}
+}
+
+func (rc Recovery) Hint() hint.Hint {
+	return RecoveryHint
+}
+
+func (rc Recovery) Bytes() []byte {
+	return util.ConcatBytesSlice(rc.keys.Bytes(), rc.height.Bytes())
+}
+
+func (rc Recovery) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(rc.Bytes())
+}
+
+func (rc Recovery) IsValid([]byte) error {
+	if err := rc.keys.IsValid(nil); err != nil {
+		return xerrors.Errorf("invalid Recovery: %w", err)
+	}
+
+	return rc.height.IsValid(nil)
+}
+
+func (rc Recovery) Keys() Keys {
+	return rc.keys
+}
+
+func (rc Recovery) Height() base.Height {
+	return rc.height
+}
+
+// IsActivated returns true when the recovered keys can be applied at the given
+// height.
+func (rc Recovery) IsActivated(height base.Height) bool {
+	return height >= rc.height
+}
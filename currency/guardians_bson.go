@@ -0,0 +1,56 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (gd Guardians) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(gd.Hint()),
+			bson.M{
+				"guardians": gd.guardians,
+				"threshold": gd.threshold,
+				"delay":     gd.delay,
+			}))
+}
+
+type GuardiansBSONUnpacker struct {
+	GD []base.AddressDecoder `bson:"guardians"`
+	TH uint                  `bson:"threshold"`
+	DL base.Height           `bson:"delay"`
+}
+
+func (gd *Guardians) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ugd GuardiansBSONUnpacker
+	if err := enc.Unmarshal(b, &ugd); err != nil {
+		return err
+	}
+
+	return gd.unpack(enc, ugd.GD, ugd.TH, ugd.DL)
+}
+
+func (rc Recovery) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(rc.Hint()),
+			bson.M{
+				"keys":   rc.keys,
+				"height": rc.height,
+			}))
+}
+
+type RecoveryBSONUnpacker struct {
+	KS bson.Raw    `bson:"keys"`
+	HT base.Height `bson:"height"`
+}
+
+func (rc *Recovery) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urc RecoveryBSONUnpacker
+	if err := enc.Unmarshal(b, &urc); err != nil {
+		return err
+	}
+
+	return rc.unpack(enc, urc.KS, urc.HT)
+}
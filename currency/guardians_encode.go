@@ -0,0 +1,44 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (gd *Guardians) unpack(
+	enc encoder.Encoder,
+	bGuardians []base.AddressDecoder,
+	threshold uint,
+	delay base.Height,
+) error {
+	guardians := make([]base.Address, len(bGuardians))
+	for i := range bGuardians {
+		if a, err := bGuardians[i].Encode(enc); err != nil {
+			return err
+		} else {
+			guardians[i] = a
+		}
+	}
+
+	gd.guardians = guardians
+	gd.threshold = threshold
+	gd.delay = delay
+
+	return nil
+}
+
+func (rc *Recovery) unpack(enc encoder.Encoder, bks []byte, height base.Height) error {
+	if hinter, err := enc.DecodeByHint(bks); err != nil {
+		return err
+	} else if k, ok := hinter.(Keys); !ok {
+		return xerrors.Errorf("not Keys: %T", hinter)
+	} else {
+		rc.keys = k
+	}
+
+	rc.height = height
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type GuardiansJSONPacker struct {
+	jsonenc.HintedHead
+	GD []base.Address `json:"guardians"`
+	TH uint           `json:"threshold"`
+	DL base.Height    `json:"delay"`
+}
+
+func (gd Guardians) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(GuardiansJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(gd.Hint()),
+		GD:         gd.guardians,
+		TH:         gd.threshold,
+		DL:         gd.delay,
+	})
+}
+
+type GuardiansJSONUnpacker struct {
+	GD []base.AddressDecoder `json:"guardians"`
+	TH uint                  `json:"threshold"`
+	DL base.Height           `json:"delay"`
+}
+
+func (gd *Guardians) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ugd GuardiansJSONUnpacker
+	if err := enc.Unmarshal(b, &ugd); err != nil {
+		return err
+	}
+
+	return gd.unpack(enc, ugd.GD, ugd.TH, ugd.DL)
+}
+
+type RecoveryJSONPacker struct {
+	jsonenc.HintedHead
+	KS Keys        `json:"keys"`
+	HT base.Height `json:"height"`
+}
+
+func (rc Recovery) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoveryJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(rc.Hint()),
+		KS:         rc.keys,
+		HT:         rc.height,
+	})
+}
+
+type RecoveryJSONUnpacker struct {
+	KS json.RawMessage `json:"keys"`
+	HT base.Height     `json:"height"`
+}
+
+func (rc *Recovery) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var urc RecoveryJSONUnpacker
+	if err := enc.Unmarshal(b, &urc); err != nil {
+		return err
+	}
+
+	return rc.unpack(enc, urc.KS, urc.HT)
+}
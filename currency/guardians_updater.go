@@ -0,0 +1,179 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	GuardiansUpdaterFactType = hint.MustNewType(0xa0, 0x54, "mitum-currency-guardians-updater-operation-fact")
+	GuardiansUpdaterFactHint = hint.MustHint(GuardiansUpdaterFactType, "0.0.1")
+	GuardiansUpdaterType     = hint.MustNewType(0xa0, 0x55, "mitum-currency-guardians-updater-operation")
+	GuardiansUpdaterHint     = hint.MustHint(GuardiansUpdaterType, "0.0.1")
+)
+
+var (
+	GuardiansUpdaterFactExpiringHint   = hint.MustHint(GuardiansUpdaterFactType, "0.0.2")
+	GuardiansUpdaterFactExpiringHinter = GuardiansUpdaterFact{expiringFact: expiringFact{ht: GuardiansUpdaterFactExpiringHint}}
+)
+
+type GuardiansUpdaterFact struct {
+	expiringFact
+	h         valuehash.Hash
+	token     []byte
+	target    base.Address
+	guardians Guardians
+	currency  CurrencyID
+}
+
+func NewGuardiansUpdaterFact(token []byte, target base.Address, guardians Guardians, currency CurrencyID) GuardiansUpdaterFact {
+	fact := GuardiansUpdaterFact{
+		token:     token,
+		target:    target,
+		guardians: guardians,
+		currency:  currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact GuardiansUpdaterFact) WithExpiry(ex Expiry) GuardiansUpdaterFact {
+	fact.expiringFact = newExpiringFact(GuardiansUpdaterFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact GuardiansUpdaterFact) Hint() hint.Hint {
+	return fact.factHint(GuardiansUpdaterFactHint)
+}
+
+func (fact GuardiansUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact GuardiansUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GuardiansUpdaterFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.guardians.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact GuardiansUpdaterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for GuardiansUpdaterFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.guardians,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	if fact.guardians.Exists(fact.target) {
+		return xerrors.Errorf("target can not be guardian of itself")
+	}
+
+	return nil
+}
+
+func (fact GuardiansUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact GuardiansUpdaterFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact GuardiansUpdaterFact) Guardians() Guardians {
+	return fact.guardians
+}
+
+func (fact GuardiansUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact GuardiansUpdaterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type GuardiansUpdater struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewGuardiansUpdater(fact GuardiansUpdaterFact, fs []operation.FactSign, memo string) (GuardiansUpdater, error) {
+	if bo, err := operation.NewBaseOperationFromFact(GuardiansUpdaterHint, fact, fs); err != nil {
+		return GuardiansUpdater{}, err
+	} else {
+		op := GuardiansUpdater{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op GuardiansUpdater) Hint() hint.Hint {
+	return GuardiansUpdaterHint
+}
+
+func (op GuardiansUpdater) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op GuardiansUpdater) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op GuardiansUpdater) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
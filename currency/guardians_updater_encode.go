@@ -0,0 +1,46 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *GuardiansUpdaterFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	btarget base.AddressDecoder,
+	bgd []byte,
+	cr string,
+	ex *Expiry,
+) error {
+	var target base.Address
+	if a, err := btarget.Encode(enc); err != nil {
+		return err
+	} else {
+		target = a
+	}
+
+	var guardians Guardians
+	if hinter, err := enc.DecodeByHint(bgd); err != nil {
+		return err
+	} else if g, ok := hinter.(Guardians); !ok {
+		return xerrors.Errorf("not Guardians: %T", hinter)
+	} else {
+		guardians = g
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, GuardiansUpdaterFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.target = target
+	fact.guardians = guardians
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
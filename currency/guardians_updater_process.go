@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op GuardiansUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type GuardiansUpdaterProcessor struct {
+	cp *CurrencyPool
+	GuardiansUpdater
+	sg     state.State
+	sb     AmountState
+	fee    Big
+	height base.Height
+	ss     []state.State
+}
+
+func NewGuardiansUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(GuardiansUpdater); !ok {
+			return nil, xerrors.Errorf("not GuardiansUpdater, %T", op)
+		} else {
+			return &GuardiansUpdaterProcessor{
+				cp:               cp,
+				GuardiansUpdater: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *GuardiansUpdaterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *GuardiansUpdaterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *GuardiansUpdaterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(GuardiansUpdaterFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.target), getState); err != nil {
+		return nil, err
+	}
+
+	switch st, gd, err := loadGuardians(fact.target, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case gd.IsEmpty() && fact.guardians.IsEmpty():
+		return nil, operation.NewBaseReasonError("guardians of target does not exist")
+	case gd.Hash().Equal(fact.guardians.Hash()):
+		return nil, operation.NewBaseReasonError("same Guardians with the existing")
+	default:
+		opp.sg = st
+	}
+
+	guardians := fact.guardians.Guardians()
+	for i := range guardians {
+		if err := checkExistsState(StateKeyAccount(guardians[i]), getState); err != nil {
+			return nil, err
+		} else if err := checkNotClosedAccount(guardians[i], getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if err := checkFactSignsByState(fact.target, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.target, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *GuardiansUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(GuardiansUpdaterFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := SetStateGuardiansValue(opp.sg, fact.guardians); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), append([]state.State{st, opp.sb}, opp.ss...)...)
+	}
+}
+
+// loadGuardians returns the guardians state of account. If not yet set, empty
+// Guardians is returned.
+func loadGuardians(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, Guardians, error) {
+	switch st, found, err := getState(StateKeyGuardians(a)); {
+	case err != nil:
+		return nil, Guardians{}, err
+	case !found || st.Value() == nil:
+		return st, Guardians{}, nil
+	default:
+		if gd, err := StateGuardiansValue(st); err != nil {
+			return nil, Guardians{}, err
+		} else {
+			return st, gd, nil
+		}
+	}
+}
+
+// checkFeeOfTarget checks the balance of target, which pays the fee of
+// operation without amount.
+func checkFeeOfTarget(cp *CurrencyPool, cid CurrencyID, sb AmountState) (Big, error) {
+	var feeer Feeer
+	if i, found := cp.Feeer(cid); !found {
+		return ZeroBig, operation.NewBaseReasonError("currency, %q not found", cid)
+	} else {
+		feeer = i
+	}
+
+	fee, err := feeer.Fee(ZeroBig)
+	if err != nil {
+		return ZeroBig, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	switch b, err := StateBalanceValue(sb); {
+	case err != nil:
+		return ZeroBig, operation.NewBaseReasonErrorFromError(err)
+	case b.Big().Compare(fee) < 0:
+		return ZeroBig, operation.NewBaseReasonError("insufficient balance with fee")
+	default:
+		if err := checkMinBalance(cp, cid, b.Big(), fee); err != nil {
+			return ZeroBig, operation.NewBaseReasonError("insufficient balance with fee: %w", err)
+		}
+
+		return fee, nil
+	}
+}
@@ -0,0 +1,118 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testGuardiansUpdater struct {
+	baseTest
+}
+
+func (t *testGuardiansUpdater) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	gd := NewGuardians([]base.Address{NewTestAddress(), NewTestAddress()}, 2, 10)
+	fact := NewGuardiansUpdaterFact(util.UUID().Bytes(), NewTestAddress(), gd, t.cid)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewGuardiansUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testGuardiansUpdater) TestEmptyGuardians() {
+	fact := NewGuardiansUpdaterFact(util.UUID().Bytes(), NewTestAddress(), NewGuardians(nil, 0, 0), t.cid)
+	t.NoError(fact.IsValid(nil))
+}
+
+func (t *testGuardiansUpdater) TestSelfGuardian() {
+	a := NewTestAddress()
+	gd := NewGuardians([]base.Address{a}, 1, 10)
+	fact := NewGuardiansUpdaterFact(util.UUID().Bytes(), a, gd, t.cid)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "target can not be guardian of itself")
+}
+
+func (t *testGuardiansUpdater) TestInvalidGuardians() {
+	a := NewTestAddress()
+
+	err := NewGuardians([]base.Address{a, a}, 1, 10).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated guardian found")
+
+	err = NewGuardians([]base.Address{a}, 2, 10).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "invalid guardians threshold")
+
+	err = NewGuardians([]base.Address{a}, 1, 0).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "invalid recovery delay")
+
+	err = NewGuardians(nil, 1, 0).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "threshold of empty guardians should be 0")
+}
+
+func TestGuardiansUpdater(t *testing.T) {
+	suite.Run(t, new(testGuardiansUpdater))
+}
+
+func testGuardiansUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		gd := NewGuardians([]base.Address{NewTestAddress(), NewTestAddress()}, 2, 10)
+		fact := NewGuardiansUpdaterFact(util.UUID().Bytes(), NewTestAddress(), gd, CurrencyID("SHOWME"))
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewGuardiansUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(GuardiansUpdater)
+		tb := b.(GuardiansUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(GuardiansUpdaterFact)
+		ufact := tb.Fact().(GuardiansUpdaterFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.guardians.Hash().Equal(ufact.guardians.Hash()))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestGuardiansUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testGuardiansUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestGuardiansUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testGuardiansUpdaterEncode(bsonenc.NewEncoder()))
+}
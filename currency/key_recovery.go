@@ -0,0 +1,175 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	KeyRecoveryFactType = hint.MustNewType(0xa0, 0x56, "mitum-currency-key-recovery-operation-fact")
+	KeyRecoveryFactHint = hint.MustHint(KeyRecoveryFactType, "0.0.1")
+	KeyRecoveryType     = hint.MustNewType(0xa0, 0x57, "mitum-currency-key-recovery-operation")
+	KeyRecoveryHint     = hint.MustHint(KeyRecoveryType, "0.0.1")
+)
+
+var (
+	KeyRecoveryFactExpiringHint   = hint.MustHint(KeyRecoveryFactType, "0.0.2")
+	KeyRecoveryFactExpiringHinter = KeyRecoveryFact{expiringFact: expiringFact{ht: KeyRecoveryFactExpiringHint}}
+)
+
+type KeyRecoveryFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	keys     Keys
+	currency CurrencyID
+}
+
+func NewKeyRecoveryFact(token []byte, target base.Address, keys Keys, currency CurrencyID) KeyRecoveryFact {
+	fact := KeyRecoveryFact{
+		token:    token,
+		target:   target,
+		keys:     keys,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact KeyRecoveryFact) WithExpiry(ex Expiry) KeyRecoveryFact {
+	fact.expiringFact = newExpiringFact(KeyRecoveryFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact KeyRecoveryFact) Hint() hint.Hint {
+	return fact.factHint(KeyRecoveryFactHint)
+}
+
+func (fact KeyRecoveryFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact KeyRecoveryFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact KeyRecoveryFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.keys.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact KeyRecoveryFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for KeyRecoveryFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.keys,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact KeyRecoveryFact) Token() []byte {
+	return fact.token
+}
+
+func (fact KeyRecoveryFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact KeyRecoveryFact) Keys() Keys {
+	return fact.keys
+}
+
+func (fact KeyRecoveryFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact KeyRecoveryFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type KeyRecovery struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewKeyRecovery(fact KeyRecoveryFact, fs []operation.FactSign, memo string) (KeyRecovery, error) {
+	if bo, err := operation.NewBaseOperationFromFact(KeyRecoveryHint, fact, fs); err != nil {
+		return KeyRecovery{}, err
+	} else {
+		op := KeyRecovery{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op KeyRecovery) Hint() hint.Hint {
+	return KeyRecoveryHint
+}
+
+func (op KeyRecovery) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op KeyRecovery) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op KeyRecovery) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	KeyRecoveryCancelerFactType = hint.MustNewType(0xa0, 0x58, "mitum-currency-key-recovery-canceler-operation-fact")
+	KeyRecoveryCancelerFactHint = hint.MustHint(KeyRecoveryCancelerFactType, "0.0.1")
+	KeyRecoveryCancelerType     = hint.MustNewType(0xa0, 0x59, "mitum-currency-key-recovery-canceler-operation")
+	KeyRecoveryCancelerHint     = hint.MustHint(KeyRecoveryCancelerType, "0.0.1")
+)
+
+var (
+	KeyRecoveryCancelerFactExpiringHint   = hint.MustHint(KeyRecoveryCancelerFactType, "0.0.2")
+	KeyRecoveryCancelerFactExpiringHinter = KeyRecoveryCancelerFact{expiringFact: expiringFact{ht: KeyRecoveryCancelerFactExpiringHint}}
+)
+
+type KeyRecoveryCancelerFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	currency CurrencyID
+}
+
+func NewKeyRecoveryCancelerFact(token []byte, target base.Address, currency CurrencyID) KeyRecoveryCancelerFact {
+	fact := KeyRecoveryCancelerFact{
+		token:    token,
+		target:   target,
+		currency: currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact KeyRecoveryCancelerFact) WithExpiry(ex Expiry) KeyRecoveryCancelerFact {
+	fact.expiringFact = newExpiringFact(KeyRecoveryCancelerFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact KeyRecoveryCancelerFact) Hint() hint.Hint {
+	return fact.factHint(KeyRecoveryCancelerFactHint)
+}
+
+func (fact KeyRecoveryCancelerFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact KeyRecoveryCancelerFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact KeyRecoveryCancelerFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.currency.Bytes(),
+		ext,
+	)
+}
+
+func (fact KeyRecoveryCancelerFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for KeyRecoveryCancelerFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.target,
+		fact.currency,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact KeyRecoveryCancelerFact) Token() []byte {
+	return fact.token
+}
+
+func (fact KeyRecoveryCancelerFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact KeyRecoveryCancelerFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact KeyRecoveryCancelerFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type KeyRecoveryCanceler struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewKeyRecoveryCanceler(fact KeyRecoveryCancelerFact, fs []operation.FactSign, memo string) (KeyRecoveryCanceler, error) {
+	if bo, err := operation.NewBaseOperationFromFact(KeyRecoveryCancelerHint, fact, fs); err != nil {
+		return KeyRecoveryCanceler{}, err
+	} else {
+		op := KeyRecoveryCanceler{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op KeyRecoveryCanceler) Hint() hint.Hint {
+	return KeyRecoveryCancelerHint
+}
+
+func (op KeyRecoveryCanceler) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op KeyRecoveryCanceler) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op KeyRecoveryCanceler) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
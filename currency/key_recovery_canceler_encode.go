@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *KeyRecoveryCancelerFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	btarget base.AddressDecoder,
+	cr string,
+	ex *Expiry,
+) error {
+	var target base.Address
+	if a, err := btarget.Encode(enc); err != nil {
+		return err
+	} else {
+		target = a
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, KeyRecoveryCancelerFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.target = target
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
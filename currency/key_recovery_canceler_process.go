@@ -0,0 +1,107 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op KeyRecoveryCanceler) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type KeyRecoveryCancelerProcessor struct {
+	cp *CurrencyPool
+	KeyRecoveryCanceler
+	sr     state.State
+	sb     AmountState
+	fee    Big
+	height base.Height
+	ss     []state.State
+}
+
+func NewKeyRecoveryCancelerProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(KeyRecoveryCanceler); !ok {
+			return nil, xerrors.Errorf("not KeyRecoveryCanceler, %T", op)
+		} else {
+			return &KeyRecoveryCancelerProcessor{
+				cp:                  cp,
+				KeyRecoveryCanceler: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *KeyRecoveryCancelerProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *KeyRecoveryCancelerProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *KeyRecoveryCancelerProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(KeyRecoveryCancelerFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.target), getState); err != nil {
+		return nil, err
+	}
+
+	switch st, _, found, err := loadRecovery(fact.target, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case !found:
+		return nil, operation.NewBaseReasonError("recovery of target does not exist")
+	default:
+		opp.sr = st
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if err := checkFactSignsByState(fact.target, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.target, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *KeyRecoveryCancelerProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(KeyRecoveryCancelerFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+	if st, err := ClearStateRecoveryValue(opp.sr); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), append([]state.State{st, opp.sb}, opp.ss...)...)
+	}
+}
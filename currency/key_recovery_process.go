@@ -0,0 +1,182 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op KeyRecovery) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+// KeyRecoveryProcessor processes KeyRecovery. The first KeyRecovery signed by
+// the guardians starts the recovery, which is activated after the delay of
+// Guardians. The next KeyRecovery with the same keys after the activation
+// replaces the keys of target.
+type KeyRecoveryProcessor struct {
+	cp *CurrencyPool
+	KeyRecovery
+	height base.Height
+	sa     state.State
+	sr     state.State
+	sb     AmountState
+	fee    Big
+	apply  bool
+	rc     Recovery
+	ss     []state.State
+}
+
+func NewKeyRecoveryProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(KeyRecovery); !ok {
+			return nil, xerrors.Errorf("not KeyRecovery, %T", op)
+		} else {
+			return &KeyRecoveryProcessor{
+				cp:          cp,
+				KeyRecovery: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *KeyRecoveryProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *KeyRecoveryProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *KeyRecoveryProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(KeyRecoveryFact)
+
+	if err := checkNotClosedAccount(fact.target, getState); err != nil {
+		return nil, err
+	}
+
+	if st, err := existsState(StateKeyAccount(fact.target), "target keys", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sa = st
+	}
+
+	if ks, err := StateKeysValue(opp.sa); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else if ks.Equal(fact.Keys()) {
+		return nil, operation.NewBaseReasonError("same Keys with the existing")
+	}
+
+	var gd Guardians
+	switch _, i, err := loadGuardians(fact.target, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case i.IsEmpty():
+		return nil, operation.NewBaseReasonError("guardians of target does not exist")
+	default:
+		gd = i
+	}
+
+	if err := checkFactSignsByGuardians(gd, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	switch st, rc, found, err := loadRecovery(fact.target, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case !found:
+		opp.sr = st
+		opp.rc = NewRecovery(fact.keys, opp.height+gd.Delay())
+	case !rc.Keys().Equal(fact.keys):
+		return nil, operation.NewBaseReasonError("another recovery in progress")
+	case !rc.IsActivated(opp.height):
+		return nil, operation.NewBaseReasonError("recovery not yet activated; will be activated at %d", rc.Height())
+	default:
+		opp.sr = st
+		opp.rc = rc
+		opp.apply = true
+	}
+
+	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
+		return nil, err
+	} else {
+		opp.sb = NewAmountState(st, fact.currency)
+	}
+
+	if fee, err := checkFeeOfTarget(opp.cp, fact.currency, opp.sb); err != nil {
+		return nil, err
+	} else {
+		opp.fee = fee
+	}
+
+	if ss, err := checkFeeSpendingLimits(fact.target, opp.height, fact.currency, opp.fee, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
+	return opp, nil
+}
+
+func (opp *KeyRecoveryProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(KeyRecoveryFact)
+
+	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
+
+	if !opp.apply {
+		if st, err := SetStateRecoveryValue(opp.sr, opp.rc); err != nil {
+			return err
+		} else {
+			return setState(fact.Hash(), append([]state.State{st, opp.sb}, opp.ss...)...)
+		}
+	}
+
+	sts := make([]state.State, 3)
+	if st, err := SetStateKeysValue(opp.sa, opp.rc.Keys()); err != nil {
+		return err
+	} else {
+		sts[0] = st
+	}
+
+	if st, err := ClearStateRecoveryValue(opp.sr); err != nil {
+		return err
+	} else {
+		sts[1] = st
+	}
+
+	sts[2] = opp.sb
+
+	return setState(fact.Hash(), append(sts, opp.ss...)...)
+}
+
+// loadRecovery returns the recovery state of account and the pending Recovery.
+func loadRecovery(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, Recovery, bool, error) {
+	switch st, found, err := getState(StateKeyRecovery(a)); {
+	case err != nil:
+		return nil, Recovery{}, false, err
+	case !found || st.Value() == nil:
+		return st, Recovery{}, false, nil
+	default:
+		if rc, found, err := StateRecoveryValue(st); err != nil {
+			return nil, Recovery{}, false, err
+		} else {
+			return st, rc, found, nil
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testKeyRecoveryOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testKeyRecoveryOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(GuardiansUpdater{}, NewGuardiansUpdaterProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(KeyRecovery{}, NewKeyRecoveryProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(KeyRecoveryCanceler{}, NewKeyRecoveryCancelerProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testKeyRecoveryOperations) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testKeyRecoveryOperations) newGuardiansState(a base.Address, gd Guardians) state.State {
+	st, err := state.NewStateV0(StateKeyGuardians(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateGuardiansValue(st, gd)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testKeyRecoveryOperations) newRecoveryState(a base.Address, rc Recovery) state.State {
+	st, err := state.NewStateV0(StateKeyRecovery(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateRecoveryValue(st, rc)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testKeyRecoveryOperations) newRecovery(
+	target base.Address, keys Keys, pks []key.Privatekey,
+) KeyRecovery {
+	fact := NewKeyRecoveryFact(util.UUID().Bytes(), target, keys, t.cid)
+	op, err := NewKeyRecovery(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+// guardiansOf returns the states of target and 2 guardians, which have the
+// guardians state with threshold 2 and delay 3.
+func (t *testKeyRecoveryOperations) guardiansOf() (*account, *account, *account, []state.State) {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ga, sga := t.newAccount(true, nil)
+	gb, sgb := t.newAccount(true, nil)
+
+	sts := append(sta, sga...)
+	sts = append(sts, sgb...)
+	sts = append(sts, t.newGuardiansState(sa.Address, NewGuardians([]base.Address{ga.Address, gb.Address}, 2, 3)))
+
+	return sa, ga, gb, sts
+}
+
+func (t *testKeyRecoveryOperations) updated(pool *storage.Statepool) map[string]state.State {
+	sts := map[string]state.State{}
+	for _, stu := range pool.Updates() {
+		sts[stu.Key()] = stu.GetState()
+	}
+
+	return sts
+}
+
+func (t *testKeyRecoveryOperations) TestSetGuardians() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ga, sga := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, sga)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), ga.Address, NewFixedFeeer(ga.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	gd := NewGuardians([]base.Address{ga.Address}, 1, 3)
+	fact := NewGuardiansUpdaterFact(util.UUID().Bytes(), sa.Address, gd, t.cid)
+	op, err := NewGuardiansUpdater(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	sts := t.updated(pool)
+
+	ugd, err := StateGuardiansValue(sts[StateKeyGuardians(sa.Address)])
+	t.NoError(err)
+	t.True(gd.Hash().Equal(ugd.Hash()))
+
+	sb := sts[StateKeyBalance(sa.Address, t.cid)]
+	am, err := StateBalanceValue(sb)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(fee).Equal(am.Big()))
+	t.True(fee.Equal(sb.(AmountState).Fee()))
+}
+
+func (t *testKeyRecoveryOperations) TestSetGuardiansUnknownGuardian() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(sta)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	gd := NewGuardians([]base.Address{NewTestAddress()}, 1, 3)
+	fact := NewGuardiansUpdaterFact(util.UUID().Bytes(), sa.Address, gd, t.cid)
+	op, err := NewGuardiansUpdater(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "does not exist")
+}
+
+func (t *testKeyRecoveryOperations) TestStartRecovery() {
+	sa, ga, gb, sts := t.guardiansOf()
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	keys := t.baseTest.newAccount().Keys()
+	t.NoError(opr.Process(t.newRecovery(sa.Address, keys, append(ga.Privs(), gb.Privs()...))))
+
+	usts := t.updated(pool)
+
+	_, found := usts[StateKeyAccount(sa.Address)]
+	t.False(found)
+
+	rc, found, err := StateRecoveryValue(usts[StateKeyRecovery(sa.Address)])
+	t.NoError(err)
+	t.True(found)
+	t.True(keys.Equal(rc.Keys()))
+	t.Equal(pool.Height()+3, rc.Height())
+}
+
+func (t *testKeyRecoveryOperations) TestStartRecoveryNotEnoughGuardians() {
+	sa, ga, _, sts := t.guardiansOf()
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRecovery(sa.Address, t.baseTest.newAccount().Keys(), ga.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not passed threshold of guardians")
+}
+
+func (t *testKeyRecoveryOperations) TestStartRecoveryWithoutGuardians() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ga, sga := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, sga)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRecovery(sa.Address, t.baseTest.newAccount().Keys(), ga.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "guardians of target does not exist")
+}
+
+func (t *testKeyRecoveryOperations) TestApplyRecovery() {
+	sa, ga, gb, sts := t.guardiansOf()
+
+	na := t.baseTest.newAccount()
+	sts = append(sts, t.newRecoveryState(sa.Address, NewRecovery(na.Keys(), base.Height(0))))
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRecovery(sa.Address, na.Keys(), append(ga.Privs(), gb.Privs()...))))
+
+	usts := t.updated(pool)
+
+	ks, err := StateKeysValue(usts[StateKeyAccount(sa.Address)])
+	t.NoError(err)
+	t.True(na.Keys().Equal(ks))
+
+	_, found, err := StateRecoveryValue(usts[StateKeyRecovery(sa.Address)])
+	t.NoError(err)
+	t.False(found)
+}
+
+func (t *testKeyRecoveryOperations) TestRecoveryNotYetActivated() {
+	sa, ga, gb, sts := t.guardiansOf()
+
+	na := t.baseTest.newAccount()
+	sts = append(sts, t.newRecoveryState(sa.Address, NewRecovery(na.Keys(), base.Height(100))))
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRecovery(sa.Address, na.Keys(), append(ga.Privs(), gb.Privs()...)))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not yet activated")
+}
+
+func (t *testKeyRecoveryOperations) TestAnotherRecovery() {
+	sa, ga, gb, sts := t.guardiansOf()
+
+	sts = append(sts, t.newRecoveryState(sa.Address, NewRecovery(t.baseTest.newAccount().Keys(), base.Height(0))))
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRecovery(sa.Address, t.baseTest.newAccount().Keys(), append(ga.Privs(), gb.Privs()...)))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "another recovery in progress")
+}
+
+func (t *testKeyRecoveryOperations) TestCancel() {
+	sa, _, _, sts := t.guardiansOf()
+
+	sts = append(sts, t.newRecoveryState(sa.Address, NewRecovery(t.baseTest.newAccount().Keys(), base.Height(100))))
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewKeyRecoveryCancelerFact(util.UUID().Bytes(), sa.Address, t.cid)
+	op, err := NewKeyRecoveryCanceler(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(op))
+
+	_, found, err := StateRecoveryValue(t.updated(pool)[StateKeyRecovery(sa.Address)])
+	t.NoError(err)
+	t.False(found)
+}
+
+func (t *testKeyRecoveryOperations) TestCancelByGuardians() {
+	sa, ga, gb, sts := t.guardiansOf()
+
+	sts = append(sts, t.newRecoveryState(sa.Address, NewRecovery(t.baseTest.newAccount().Keys(), base.Height(100))))
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewKeyRecoveryCancelerFact(util.UUID().Bytes(), sa.Address, t.cid)
+	op, err := NewKeyRecoveryCanceler(fact, t.factSigns(fact, append(ga.Privs(), gb.Privs()...)), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testKeyRecoveryOperations) TestCancelWithoutRecovery() {
+	sa, _, _, sts := t.guardiansOf()
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	fact := NewKeyRecoveryCancelerFact(util.UUID().Bytes(), sa.Address, t.cid)
+	op, err := NewKeyRecoveryCanceler(fact, t.factSigns(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "recovery of target does not exist")
+}
+
+func TestKeyRecoveryOperations(t *testing.T) {
+	suite.Run(t, new(testKeyRecoveryOperations))
+}
@@ -0,0 +1,148 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testKeyRecovery struct {
+	baseTest
+}
+
+func (t *testKeyRecovery) TestNew() {
+	pk := key.MustNewBTCPrivatekey()
+
+	fact := NewKeyRecoveryFact(util.UUID().Bytes(), NewTestAddress(), t.newAccount().Keys(), t.cid)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewKeyRecovery(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testKeyRecovery) TestNewCanceler() {
+	pk := key.MustNewBTCPrivatekey()
+
+	fact := NewKeyRecoveryCancelerFact(util.UUID().Bytes(), NewTestAddress(), t.cid)
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewKeyRecoveryCanceler(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+}
+
+func (t *testKeyRecovery) TestRecoveryIsActivated() {
+	rc := NewRecovery(t.newAccount().Keys(), base.Height(10))
+	t.NoError(rc.IsValid(nil))
+
+	t.False(rc.IsActivated(base.Height(9)))
+	t.True(rc.IsActivated(base.Height(10)))
+}
+
+func TestKeyRecovery(t *testing.T) {
+	suite.Run(t, new(testKeyRecovery))
+}
+
+func testKeyRecoveryEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		nkey, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+		t.NoError(err)
+		nkeys, err := NewKeys([]Key{nkey}, 100)
+		t.NoError(err)
+
+		fact := NewKeyRecoveryFact(util.UUID().Bytes(), NewTestAddress(), nkeys, CurrencyID("SHOWME"))
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewKeyRecovery(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(KeyRecovery)
+		tb := b.(KeyRecovery)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(KeyRecoveryFact)
+		ufact := tb.Fact().(KeyRecoveryFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.keys.Equal(ufact.keys))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestKeyRecoveryEncodeJSON(t *testing.T) {
+	suite.Run(t, testKeyRecoveryEncode(jsonenc.NewEncoder()))
+}
+
+func TestKeyRecoveryEncodeBSON(t *testing.T) {
+	suite.Run(t, testKeyRecoveryEncode(bsonenc.NewEncoder()))
+}
+
+func testKeyRecoveryCancelerEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		fact := NewKeyRecoveryCancelerFact(util.UUID().Bytes(), NewTestAddress(), CurrencyID("SHOWME"))
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewKeyRecoveryCanceler(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(KeyRecoveryCanceler)
+		tb := b.(KeyRecoveryCanceler)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(KeyRecoveryCancelerFact)
+		ufact := tb.Fact().(KeyRecoveryCancelerFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestKeyRecoveryCancelerEncodeJSON(t *testing.T) {
+	suite.Run(t, testKeyRecoveryCancelerEncode(jsonenc.NewEncoder()))
+}
+
+func TestKeyRecoveryCancelerEncodeBSON(t *testing.T) {
+	suite.Run(t, testKeyRecoveryCancelerEncode(bsonenc.NewEncoder()))
+}
@@ -18,13 +18,22 @@ var (
 	KeyHint  = hint.MustHint(KeyType, "0.0.1")
 	KeysType = hint.MustNewType(0xa0, 0x04, "mitum-currency-keys")
 	KeysHint = hint.MustHint(KeysType, "0.0.1")
+	// KeysWithThresholdsHint is the Keys, which has the thresholds by
+	// operation type.
+	KeysWithThresholdsHint   = hint.MustHint(KeysType, "0.0.2")
+	KeysWithThresholdsHinter = Keys{hint: KeysWithThresholdsHint}
 )
 
+// MaxKeyInKeys is the upper bound of the number of keys, which is checked
+// without the network policy; the actual limit is set by NetworkPolicy.
 var (
 	MaxKeyInKeys int
-	maxKeyInKeys uint = 10
+	maxKeyInKeys uint = 100
 )
 
+// DefaultMaxKeyInKeys is the keys limit of DefaultNetworkPolicy.
+var DefaultMaxKeyInKeys uint = 10
+
 func init() {
 	MaxKeyInKeys = int(maxKeyInKeys)
 }
@@ -81,9 +90,11 @@ func (ky Key) Equal(b Key) bool {
 }
 
 type Keys struct {
-	h         valuehash.Hash
-	keys      []Key
-	threshold uint
+	hint       hint.Hint
+	h          valuehash.Hash
+	keys       []Key
+	threshold  uint
+	thresholds map[hint.Type]uint
 }
 
 func NewKeys(keys []Key, threshold uint) (Keys, error) {
@@ -97,7 +108,25 @@ func NewKeys(keys []Key, threshold uint) (Keys, error) {
 	return ks, ks.IsValid(nil)
 }
 
+// NewKeysWithThresholds returns new Keys with the thresholds by operation
+// type. The operation type, which is not in thresholds, follows the default
+// threshold.
+func NewKeysWithThresholds(keys []Key, threshold uint, thresholds map[hint.Type]uint) (Keys, error) {
+	ks := Keys{hint: KeysWithThresholdsHint, keys: keys, threshold: threshold, thresholds: thresholds}
+	if h, err := ks.GenerateHash(); err != nil {
+		return Keys{}, err
+	} else {
+		ks.h = h
+	}
+
+	return ks, ks.IsValid(nil)
+}
+
 func (ks Keys) Hint() hint.Hint {
+	if ks.hint.Equal(KeysWithThresholdsHint) {
+		return KeysWithThresholdsHint
+	}
+
 	return KeysHint
 }
 
@@ -122,6 +151,13 @@ func (ks Keys) Bytes() []byte {
 
 	bs[len(ks.keys)] = util.UintToBytes(ks.threshold)
 
+	if len(ks.thresholds) > 0 {
+		types := ks.thresholdTypes()
+		for i := range types {
+			bs = append(bs, types[i].Bytes(), util.UintToBytes(ks.thresholds[types[i]]))
+		}
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -161,6 +197,10 @@ func (ks Keys) IsValid([]byte) error {
 		return xerrors.Errorf("sum of weight under threshold, %d < %d", totalWeight, ks.threshold)
 	}
 
+	if err := ks.isValidThresholds(totalWeight); err != nil {
+		return err
+	}
+
 	if h, err := ks.GenerateHash(); err != nil {
 		return err
 	} else if !ks.h.Equal(h) {
@@ -170,10 +210,71 @@ func (ks Keys) IsValid([]byte) error {
 	return nil
 }
 
+func (ks Keys) isValidThresholds(totalWeight uint) error {
+	if !ks.Hint().Equal(KeysWithThresholdsHint) {
+		if len(ks.thresholds) > 0 {
+			return xerrors.Errorf("thresholds by operation type not allowed in %s", ks.Hint())
+		}
+
+		return nil
+	}
+
+	if len(ks.thresholds) < 1 {
+		return xerrors.Errorf("empty thresholds by operation type")
+	}
+
+	for t, th := range ks.thresholds {
+		if err := t.IsValid(nil); err != nil {
+			return err
+		}
+
+		if th < 1 || th > 100 {
+			return xerrors.Errorf("invalid threshold of %s, %d, should be 1 <= threshold <= 100", t.Verbose(), th)
+		}
+
+		if totalWeight < th {
+			return xerrors.Errorf("sum of weight under threshold of %s, %d < %d", t.Verbose(), totalWeight, th)
+		}
+	}
+
+	return nil
+}
+
+func (ks Keys) thresholdTypes() []hint.Type {
+	types := make([]hint.Type, len(ks.thresholds))
+
+	var i int
+	for t := range ks.thresholds {
+		types[i] = t
+		i++
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return bytes.Compare(types[i].Bytes(), types[j].Bytes()) < 0
+	})
+
+	return types
+}
+
 func (ks Keys) Threshold() uint {
 	return ks.threshold
 }
 
+// Thresholds returns the thresholds by operation type.
+func (ks Keys) Thresholds() map[hint.Type]uint {
+	return ks.thresholds
+}
+
+// ThresholdOf returns the threshold for the given operation type. If not set,
+// the default threshold is returned.
+func (ks Keys) ThresholdOf(t hint.Type) uint {
+	if th, found := ks.thresholds[t]; found {
+		return th
+	}
+
+	return ks.threshold
+}
+
 func (ks Keys) Keys() []Key {
 	return ks.keys
 }
@@ -198,6 +299,16 @@ func (ks Keys) Equal(b Keys) bool {
 		return false
 	}
 
+	if len(ks.thresholds) != len(b.thresholds) {
+		return false
+	}
+
+	for t, th := range ks.thresholds {
+		if j, found := b.thresholds[t]; !found || th != j {
+			return false
+		}
+	}
+
 	sort.Slice(ks.keys, func(i, j int) bool {
 		return bytes.Compare(ks.keys[i].Key().Bytes(), ks.keys[j].Key().Bytes()) < 0
 	})
@@ -214,7 +325,7 @@ func (ks Keys) Equal(b Keys) bool {
 	return true
 }
 
-func checkThreshold(fs []operation.FactSign, keys Keys) error {
+func checkThreshold(fs []operation.FactSign, keys Keys, t hint.Type) error {
 	var sum uint
 	for i := range fs {
 		if ky, found := keys.Key(fs[i].Signer()); found {
@@ -224,8 +335,8 @@ func checkThreshold(fs []operation.FactSign, keys Keys) error {
 		}
 	}
 
-	if sum < keys.Threshold() {
-		return xerrors.Errorf("not passed threshold, sum=%d < threshold=%d", sum, keys.Threshold())
+	if th := keys.ThresholdOf(t); sum < th {
+		return xerrors.Errorf("not passed threshold, sum=%d < threshold=%d", sum, th)
 	}
 
 	return nil
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spikeekips/mitum/base/key"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
@@ -32,24 +33,44 @@ func (ky *Key) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 	return ky.unpack(enc, uk.W, uk.K)
 }
 
+type KeysThresholdBSONPacker struct {
+	T  hint.Type `bson:"type"`
+	TH uint      `bson:"threshold"`
+}
+
 func (ks Keys) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bsonenc.MergeBSONM(
-		bsonenc.NewHintedDoc(ks.Hint()),
-		bson.M{
-			"hash":      ks.h,
-			"keys":      ks.keys,
-			"threshold": ks.threshold,
-		},
-	))
+	m := bson.M{
+		"hash":      ks.h,
+		"keys":      ks.keys,
+		"threshold": ks.threshold,
+	}
+
+	if len(ks.thresholds) > 0 {
+		types := ks.thresholdTypes()
+		ths := make([]KeysThresholdBSONPacker, len(types))
+		for i := range types {
+			ths[i] = KeysThresholdBSONPacker{T: types[i], TH: ks.thresholds[types[i]]}
+		}
+
+		m["thresholds"] = ths
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(ks.Hint()), m))
 }
 
 type KeysBSONUnpacker struct {
-	H  valuehash.Bytes `bson:"hash"`
-	KS []bson.Raw      `bson:"keys"`
-	TH uint            `bson:"threshold"`
+	H  valuehash.Bytes           `bson:"hash"`
+	KS []bson.Raw                `bson:"keys"`
+	TH uint                      `bson:"threshold"`
+	TS []KeysThresholdBSONPacker `bson:"thresholds,omitempty"`
 }
 
 func (ks *Keys) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var uks KeysBSONUnpacker
 	if err := bson.Unmarshal(b, &uks); err != nil {
 		return err
@@ -60,5 +81,13 @@ func (ks *Keys) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		bs[i] = uks.KS[i]
 	}
 
-	return ks.unpack(enc, uks.H, bs, uks.TH)
+	var ths map[hint.Type]uint
+	if len(uks.TS) > 0 {
+		ths = map[hint.Type]uint{}
+		for i := range uks.TS {
+			ths[uks.TS[i].T] = uks.TS[i].TH
+		}
+	}
+
+	return ks.unpack(enc, ht.H, uks.H, bs, uks.TH, ths)
 }
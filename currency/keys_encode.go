@@ -5,6 +5,7 @@ import (
 
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
@@ -20,7 +21,15 @@ func (ky *Key) unpack(enc encoder.Encoder, w uint, kd key.PublickeyDecoder) erro
 	return nil
 }
 
-func (ks *Keys) unpack(enc encoder.Encoder, h valuehash.Hash, bkeys [][]byte, th uint) error {
+func (ks *Keys) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	bkeys [][]byte,
+	th uint,
+	ths map[hint.Type]uint,
+) error {
+	ks.hint = ht
 	ks.h = h
 
 	keys := make([]Key, len(bkeys))
@@ -36,6 +45,7 @@ func (ks *Keys) unpack(enc encoder.Encoder, h valuehash.Hash, bkeys [][]byte, th
 
 	ks.keys = keys
 	ks.threshold = th
+	ks.thresholds = ths
 
 	return nil
 }
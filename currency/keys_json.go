@@ -5,6 +5,7 @@ import (
 
 	"github.com/spikeekips/mitum/base/key"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
@@ -36,29 +37,51 @@ func (ky *Key) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	return ky.unpack(enc, uk.W, uk.K)
 }
 
+type KeysThresholdJSONPacker struct {
+	T  hint.Type `json:"type"`
+	TH uint      `json:"threshold"`
+}
+
 type KeysJSONPacker struct {
 	jsonenc.HintedHead
-	H  valuehash.Hash `json:"hash"`
-	KS []Key          `json:"keys"`
-	TH uint           `json:"threshold"`
+	H  valuehash.Hash            `json:"hash"`
+	KS []Key                     `json:"keys"`
+	TH uint                      `json:"threshold"`
+	TS []KeysThresholdJSONPacker `json:"thresholds,omitempty"`
 }
 
 func (ks Keys) MarshalJSON() ([]byte, error) {
+	var ths []KeysThresholdJSONPacker
+	if len(ks.thresholds) > 0 {
+		types := ks.thresholdTypes()
+		ths = make([]KeysThresholdJSONPacker, len(types))
+		for i := range types {
+			ths[i] = KeysThresholdJSONPacker{T: types[i], TH: ks.thresholds[types[i]]}
+		}
+	}
+
 	return jsonenc.Marshal(KeysJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(ks.Hint()),
 		H:          ks.h,
 		KS:         ks.keys,
 		TH:         ks.threshold,
+		TS:         ths,
 	})
 }
 
 type KeysJSONUnpacker struct {
-	H  valuehash.Bytes   `json:"hash"`
-	KS []json.RawMessage `json:"keys"`
-	TH uint              `json:"threshold"`
+	H  valuehash.Bytes           `json:"hash"`
+	KS []json.RawMessage         `json:"keys"`
+	TH uint                      `json:"threshold"`
+	TS []KeysThresholdJSONPacker `json:"thresholds"`
 }
 
 func (ks *Keys) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var uks KeysJSONUnpacker
 	if err := jsonenc.Unmarshal(b, &uks); err != nil {
 		return err
@@ -69,5 +92,13 @@ func (ks *Keys) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		bs[i] = uks.KS[i]
 	}
 
-	return ks.unpack(enc, uks.H, bs, uks.TH)
+	var ths map[hint.Type]uint
+	if len(uks.TS) > 0 {
+		ths = map[hint.Type]uint{}
+		for i := range uks.TS {
+			ths[uks.TS[i].T] = uks.TS[i].TH
+		}
+	}
+
+	return ks.unpack(enc, ht.H, uks.H, bs, uks.TH, ths)
 }
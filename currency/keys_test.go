@@ -10,6 +10,7 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 type testKey struct {
@@ -170,6 +171,39 @@ func (t *testKeys) TestEqual() {
 	t.False(ks0.Equal(ks3))
 }
 
+func (t *testKeys) TestThresholds() {
+	keys := []Key{
+		t.newKey(key.MustNewBTCPrivatekey().Publickey(), 30),
+		t.newKey(key.MustNewBTCPrivatekey().Publickey(), 70),
+	}
+
+	ks, err := NewKeysWithThresholds(keys, 100, map[hint.Type]uint{TransfersType: 30})
+	t.NoError(err)
+	t.True(ks.Hint().Equal(KeysWithThresholdsHint))
+
+	t.Equal(uint(30), ks.ThresholdOf(TransfersType))
+	t.Equal(uint(100), ks.ThresholdOf(KeyUpdaterType))
+
+	dks, err := NewKeys(keys, 100)
+	t.NoError(err)
+	t.True(dks.Hint().Equal(KeysHint))
+	t.Equal(uint(100), dks.ThresholdOf(TransfersType))
+
+	t.False(ks.Equal(dks))
+	t.False(ks.Hash().Equal(dks.Hash()))
+
+	_, err = NewKeysWithThresholds(keys, 100, map[hint.Type]uint{TransfersType: 101})
+	t.Contains(err.Error(), "invalid threshold of")
+
+	_, err = NewKeysWithThresholds(keys, 100, nil)
+	t.Contains(err.Error(), "empty thresholds by operation type")
+
+	_, err = NewKeysWithThresholds(
+		[]Key{t.newKey(key.MustNewBTCPrivatekey().Publickey(), 30)}, 30, map[hint.Type]uint{TransfersType: 31},
+	)
+	t.Contains(err.Error(), "sum of weight under threshold of")
+}
+
 func TestKeys(t *testing.T) {
 	suite.Run(t, new(testKeys))
 }
@@ -186,6 +220,7 @@ func (t *testKeysEncode) SetupSuite() {
 	encs.AddHinter(key.BTCPublickeyHinter)
 	encs.AddHinter(Key{})
 	encs.AddHinter(Keys{})
+	encs.AddHinter(KeysWithThresholdsHinter)
 }
 
 func (t *testKeysEncode) TestMarshal() {
@@ -217,6 +252,30 @@ func (t *testKeysEncode) TestMarshal() {
 	}
 }
 
+func (t *testKeysEncode) TestMarshalWithThresholds() {
+	ak, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 50)
+	t.NoError(err)
+	bk, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 50)
+	t.NoError(err)
+
+	ks, err := NewKeysWithThresholds([]Key{ak, bk}, 100, map[hint.Type]uint{TransfersType: 50, ApproveType: 60})
+	t.NoError(err)
+
+	b, err := t.enc.Marshal(ks)
+	t.NoError(err)
+
+	hinter, err := t.enc.DecodeByHint(b)
+	t.NoError(err)
+	uks, ok := hinter.(Keys)
+	t.True(ok)
+
+	t.NoError(uks.IsValid(nil))
+	t.True(uks.Hint().Equal(KeysWithThresholdsHint))
+	t.True(ks.Hash().Equal(uks.Hash()))
+	t.True(ks.Equal(uks))
+	t.Equal(uint(60), uks.ThresholdOf(ApproveType))
+}
+
 func TestKeysEncodeJSON(t *testing.T) {
 	b := new(testKeysEncode)
 	b.enc = jsonenc.NewEncoder()
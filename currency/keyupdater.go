@@ -18,12 +18,31 @@ var (
 	KeyUpdaterHint     = hint.MustHint(KeyUpdaterType, "0.0.1")
 )
 
+var (
+	KeyUpdaterFactStrictHint   = hint.MustHint(KeyUpdaterFactType, "0.0.2")
+	KeyUpdaterFactStrictHinter = KeyUpdaterFact{hint: KeyUpdaterFactStrictHint}
+)
+
+var (
+	KeyUpdaterFactExpiringHint   = hint.MustHint(KeyUpdaterFactType, "0.0.3")
+	KeyUpdaterFactExpiringHinter = KeyUpdaterFact{hint: KeyUpdaterFactExpiringHint}
+)
+
+var (
+	KeyUpdaterFactSequencedHint   = hint.MustHint(KeyUpdaterFactType, "0.0.4")
+	KeyUpdaterFactSequencedHinter = KeyUpdaterFact{hint: KeyUpdaterFactSequencedHint}
+)
+
 type KeyUpdaterFact struct {
+	hint     hint.Hint
 	h        valuehash.Hash
 	token    []byte
 	target   base.Address
 	keys     Keys
 	currency CurrencyID
+	strict   bool
+	expiry   Expiry
+	sequence uint64
 }
 
 func NewKeyUpdaterFact(token []byte, target base.Address, keys Keys, currency CurrencyID) KeyUpdaterFact {
@@ -38,8 +57,57 @@ func NewKeyUpdaterFact(token []byte, target base.Address, keys Keys, currency Cu
 	return fact
 }
 
+// NewKeyUpdaterFactStrict returns the strict KeyUpdaterFact. The operation of
+// strict fact should be signed by the new keys as well as the existing keys,
+// so the new keys are proved to be possessed.
+func NewKeyUpdaterFactStrict(token []byte, target base.Address, keys Keys, currency CurrencyID) KeyUpdaterFact {
+	fact := KeyUpdaterFact{
+		hint:     KeyUpdaterFactStrictHint,
+		token:    token,
+		target:   target,
+		keys:     keys,
+		currency: currency,
+		strict:   true,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// WithExpiry sets the Expiry; the strictness is kept and the sequenced fact
+// keeps the sequenced hint.
+func (fact KeyUpdaterFact) WithExpiry(ex Expiry) KeyUpdaterFact {
+	if !fact.IsSequenced() {
+		fact.hint = KeyUpdaterFactExpiringHint
+	}
+
+	fact.expiry = ex
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// WithSequence returns the sequenced KeyUpdaterFact; the operation is accepted
+// only with the next sequence of target. The strictness and Expiry are kept.
+func (fact KeyUpdaterFact) WithSequence(sequence uint64) KeyUpdaterFact {
+	fact.hint = KeyUpdaterFactSequencedHint
+	fact.sequence = sequence
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact KeyUpdaterFact) Hint() hint.Hint {
-	return KeyUpdaterFactHint
+	switch {
+	case fact.hint.Equal(KeyUpdaterFactSequencedHint):
+		return KeyUpdaterFactSequencedHint
+	case fact.hint.Equal(KeyUpdaterFactExpiringHint):
+		return KeyUpdaterFactExpiringHint
+	case fact.hint.Equal(KeyUpdaterFactStrictHint):
+		return KeyUpdaterFactStrictHint
+	default:
+		return KeyUpdaterFactHint
+	}
 }
 
 func (fact KeyUpdaterFact) Hash() valuehash.Hash {
@@ -51,11 +119,23 @@ func (fact KeyUpdaterFact) GenerateHash() valuehash.Hash {
 }
 
 func (fact KeyUpdaterFact) Bytes() []byte {
+	var ext []byte
+	switch ht := fact.Hint(); {
+	case ht.Equal(KeyUpdaterFactSequencedHint):
+		ext = util.ConcatBytesSlice(
+			ht.Bytes(), util.BoolToBytes(fact.strict), fact.expiry.Bytes(), util.Uint64ToBytes(fact.sequence))
+	case ht.Equal(KeyUpdaterFactExpiringHint):
+		ext = util.ConcatBytesSlice(ht.Bytes(), util.BoolToBytes(fact.strict), fact.expiry.Bytes())
+	case fact.strict:
+		ext = ht.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.token,
 		fact.target.Bytes(),
 		fact.keys.Bytes(),
 		fact.currency.Bytes(),
+		ext,
 	)
 }
 
@@ -73,6 +153,16 @@ func (fact KeyUpdaterFact) IsValid([]byte) error {
 		return err
 	}
 
+	if fact.IsExpiring() {
+		if err := fact.expiry.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.IsSequenced() && fact.sequence < 1 {
+		return xerrors.Errorf("sequence should be over zero")
+	}
+
 	if !fact.h.Equal(fact.GenerateHash()) {
 		return isvalid.InvalidError.Errorf("wrong Fact hash")
 	}
@@ -96,6 +186,31 @@ func (fact KeyUpdaterFact) Currency() CurrencyID {
 	return fact.currency
 }
 
+// IsStrict returns true when the new keys should sign the operation.
+func (fact KeyUpdaterFact) IsStrict() bool {
+	return fact.strict
+}
+
+func (fact KeyUpdaterFact) Expiry() Expiry {
+	return fact.expiry
+}
+
+func (fact KeyUpdaterFact) IsExpiring() bool {
+	return fact.Hint().Equal(KeyUpdaterFactExpiringHint) || (fact.IsSequenced() && !fact.expiry.IsEmpty())
+}
+
+func (fact KeyUpdaterFact) Sequence() uint64 {
+	return fact.sequence
+}
+
+func (fact KeyUpdaterFact) IsSequenced() bool {
+	return fact.Hint().Equal(KeyUpdaterFactSequencedHint)
+}
+
+func (fact KeyUpdaterFact) SequenceAccount() base.Address {
+	return fact.target
+}
+
 func (fact KeyUpdaterFact) Addresses() ([]base.Address, error) {
 	return []base.Address{fact.target}, nil
 }
@@ -5,16 +5,21 @@ import (
 
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (fact *KeyUpdaterFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	token []byte,
 	btarget base.AddressDecoder,
 	bks []byte,
 	cr string,
+	strict bool,
+	ex *Expiry,
+	sequence uint64,
 ) error {
 	var target base.Address
 	if a, err := btarget.Encode(enc); err != nil {
@@ -32,11 +37,25 @@ func (fact *KeyUpdaterFact) unpack(
 		keys = k
 	}
 
+	fact.hint = ht
 	fact.h = h
 	fact.token = token
 	fact.target = target
 	fact.keys = keys
 	fact.currency = CurrencyID(cr)
 
+	switch {
+	case ht.Equal(KeyUpdaterFactStrictHint):
+		fact.strict = true
+	case ht.Equal(KeyUpdaterFactExpiringHint), ht.Equal(KeyUpdaterFactSequencedHint):
+		fact.strict = strict
+	}
+
+	if ex != nil {
+		fact.expiry = *ex
+	}
+
+	fact.sequence = sequence
+
 	return nil
 }
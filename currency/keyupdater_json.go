@@ -16,17 +16,34 @@ type KeyUpdaterFactJSONPacker struct {
 	TG base.Address   `json:"target"`
 	KS Keys           `json:"keys"`
 	CR CurrencyID     `json:"currency"`
+	ST bool           `json:"strict,omitempty"`
+	EX *Expiry        `json:"expiry,omitempty"`
+	SQ uint64         `json:"sequence,omitempty"`
 }
 
 func (fact KeyUpdaterFact) MarshalJSON() ([]byte, error) {
-	return jsonenc.Marshal(KeyUpdaterFactJSONPacker{
+	p := KeyUpdaterFactJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
 		H:          fact.h,
 		TK:         fact.token,
 		TG:         fact.target,
 		KS:         fact.keys,
 		CR:         fact.currency,
-	})
+	}
+
+	if fact.IsExpiring() || fact.IsSequenced() {
+		p.ST = fact.strict
+	}
+
+	if fact.IsExpiring() {
+		p.EX = &fact.expiry
+	}
+
+	if fact.IsSequenced() {
+		p.SQ = fact.sequence
+	}
+
+	return jsonenc.Marshal(p)
 }
 
 type KeyUpdaterFactJSONUnpacker struct {
@@ -35,15 +52,23 @@ type KeyUpdaterFactJSONUnpacker struct {
 	TG base.AddressDecoder `json:"target"`
 	KS json.RawMessage     `json:"keys"`
 	CR string              `json:"currency"`
+	ST bool                `json:"strict,omitempty"`
+	EX *Expiry             `json:"expiry,omitempty"`
+	SQ uint64              `json:"sequence,omitempty"`
 }
 
 func (fact *KeyUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact KeyUpdaterFactJSONUnpacker
 	if err := enc.Unmarshal(b, &ufact); err != nil {
 		return err
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.CR)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.CR, ufact.ST, ufact.EX, ufact.SQ)
 }
 
 func (op KeyUpdater) MarshalJSON() ([]byte, error) {
@@ -1,8 +1,10 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
 	"golang.org/x/xerrors"
 )
@@ -17,9 +19,11 @@ func (op KeyUpdater) Process(
 type KeyUpdaterProcessor struct {
 	cp *CurrencyPool
 	KeyUpdater
-	sa  state.State
-	sb  AmountState
-	fee Big
+	sa     state.State
+	sb     AmountState
+	fee    Big
+	height base.Height
+	ss     []state.State
 }
 
 func NewKeyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -35,6 +39,14 @@ func NewKeyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
 	}
 }
 
+func (op *KeyUpdaterProcessor) setCurrencyPool(cp *CurrencyPool) {
+	op.cp = cp
+}
+
+func (op *KeyUpdaterProcessor) setProposalHeight(height base.Height) {
+	op.height = height
+}
+
 func (op *KeyUpdaterProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
@@ -47,10 +59,13 @@ func (op *KeyUpdaterProcessor) PreProcess(
 		op.sa = st
 	}
 
+	var oks Keys
 	if ks, err := StateKeysValue(op.sa); err != nil {
 		return nil, operation.NewBaseReasonErrorFromError(err)
 	} else if ks.Equal(fact.Keys()) {
 		return nil, operation.NewBaseReasonError("same Keys with the existing")
+	} else {
+		oks = ks
 	}
 
 	if st, err := existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState); err != nil {
@@ -59,7 +74,16 @@ func (op *KeyUpdaterProcessor) PreProcess(
 		op.sb = NewAmountState(st, fact.currency)
 	}
 
-	if err := checkFactSignsByState(fact.target, op.Signs(), getState); err != nil {
+	fs := op.Signs()
+	if fact.IsStrict() {
+		if i, err := checkFactSignsOfNewKeys(oks, fact.keys, op.Hint().Type(), fs); err != nil {
+			return nil, err
+		} else {
+			fs = i
+		}
+	}
+
+	if err := checkFactSignsByState(fact.target, op.Hint().Type(), fs, getState); err != nil {
 		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
 	}
 
@@ -79,10 +103,20 @@ func (op *KeyUpdaterProcessor) PreProcess(
 		case b.Big().Compare(fee) < 0:
 			return nil, operation.NewBaseReasonError("insufficient balance with fee")
 		default:
+			if err := checkMinBalance(op.cp, fact.currency, b.Big(), fee); err != nil {
+				return nil, operation.NewBaseReasonError("insufficient balance with fee: %w", err)
+			}
+
 			op.fee = fee
 		}
 	}
 
+	if ss, err := checkFeeSpendingLimits(fact.target, op.height, fact.currency, op.fee, getState); err != nil {
+		return nil, err
+	} else {
+		op.ss = ss
+	}
+
 	return op, nil
 }
 
@@ -96,6 +130,34 @@ func (op *KeyUpdaterProcessor) Process(
 	if st, err := SetStateKeysValue(op.sa, fact.keys); err != nil {
 		return err
 	} else {
-		return setState(fact.Hash(), st, op.sb)
+		return setState(fact.Hash(), append([]state.State{st, op.sb}, op.ss...)...)
+	}
+}
+
+// checkFactSignsOfNewKeys checks the threshold of the new keys by the fact signs
+// of the new keys. The fact signs of the existing keys are returned to check
+// the threshold of the existing keys.
+func checkFactSignsOfNewKeys(oks, nks Keys, t hint.Type, fs []operation.FactSign) ([]operation.FactSign, error) {
+	var ofs, nfs []operation.FactSign
+	for i := range fs {
+		_, inOld := oks.Key(fs[i].Signer())
+		_, inNew := nks.Key(fs[i].Signer())
+		if !inOld && !inNew {
+			return nil, operation.NewBaseReasonError("invalid signing: unknown key found, %s", fs[i].Signer())
+		}
+
+		if inOld {
+			ofs = append(ofs, fs[i])
+		}
+
+		if inNew {
+			nfs = append(nfs, fs[i])
+		}
 	}
+
+	if err := checkThreshold(nfs, nks, t); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing of new keys: %w", err)
+	}
+
+	return ofs, nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 type testKeyUpdaterOperation struct {
@@ -32,8 +33,10 @@ func (t *testKeyUpdaterOperation) processor(cp *CurrencyPool, pool *storage.Stat
 }
 
 func (t *testKeyUpdaterOperation) newOperation(target base.Address, keys Keys, pks []key.Privatekey, cid CurrencyID) KeyUpdater {
-	token := util.UUID().Bytes()
-	fact := NewKeyUpdaterFact(token, target, keys, cid)
+	return t.newOperationFromFact(NewKeyUpdaterFact(util.UUID().Bytes(), target, keys, cid), pks)
+}
+
+func (t *testKeyUpdaterOperation) newOperationFromFact(fact KeyUpdaterFact, pks []key.Privatekey) KeyUpdater {
 
 	var fs []operation.FactSign
 	for _, pk := range pks {
@@ -200,6 +203,127 @@ func (t *testKeyUpdaterOperation) TestSameKeys() {
 	t.Contains(err.Error(), "same Keys")
 }
 
+func (t *testKeyUpdaterOperation) TestThresholdByOperationType() {
+	hpk := key.MustNewBTCPrivatekey()
+	cpk := key.MustNewBTCPrivatekey()
+
+	keys, err := NewKeysWithThresholds(
+		[]Key{t.newKey(hpk.Publickey(), 30), t.newKey(cpk.Publickey(), 70)},
+		100,
+		map[hint.Type]uint{TransfersType: 30},
+	)
+	t.NoError(err)
+
+	target, _ := NewAddressFromKeys(keys)
+
+	pool, _ := t.statepool([]state.State{
+		t.newStateBalance(target, NewBig(33), t.cid),
+		t.newStateKeys(target, keys),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	nkeys, err := NewKeys([]Key{t.newKey(key.MustNewBTCPrivatekey().Publickey(), 100)}, 100)
+	t.NoError(err)
+
+	// NOTE hot key is not enough for KeyUpdater
+	err = opr.Process(t.newOperation(target, nkeys, []key.Privatekey{hpk}, t.cid))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not passed threshold")
+
+	t.NoError(opr.Process(t.newOperation(target, nkeys, []key.Privatekey{hpk, cpk}, t.cid)))
+}
+
+func (t *testKeyUpdaterOperation) TestStrict() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, st := t.newAccount(true, []Amount{am})
+
+	pool, _ := t.statepool(st)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkeys, err := NewKeys([]Key{t.newKey(npk.Publickey(), 100)}, 100)
+	t.NoError(err)
+
+	fact := NewKeyUpdaterFactStrict(util.UUID().Bytes(), sa.Address, nkeys, t.cid)
+	op := t.newOperationFromFact(fact, append(sa.Privs(), npk))
+
+	t.NoError(opr.Process(op))
+
+	var ns state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAccount(sa.Address) {
+			ns = st.GetState()
+		}
+	}
+
+	ukeys, err := StateKeysValue(ns)
+	t.NoError(err)
+	t.True(nkeys.Equal(ukeys))
+}
+
+func (t *testKeyUpdaterOperation) TestStrictWithoutNewKeys() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	pool, _ := t.statepool(st)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkeys, err := NewKeys([]Key{t.newKey(npk.Publickey(), 100)}, 100)
+	t.NoError(err)
+
+	// NOTE without the signs of new keys
+	fact := NewKeyUpdaterFactStrict(util.UUID().Bytes(), sa.Address, nkeys, t.cid)
+	err = opr.Process(t.newOperationFromFact(fact, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing of new keys")
+
+	// NOTE without the signs of existing keys
+	fact = NewKeyUpdaterFactStrict(util.UUID().Bytes(), sa.Address, nkeys, t.cid)
+	err = opr.Process(t.newOperationFromFact(fact, []key.Privatekey{npk}))
+
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not passed threshold")
+	t.Empty(pool.Updates())
+}
+
+func (t *testKeyUpdaterOperation) TestStrictUnknownKey() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	pool, _ := t.statepool(st)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.MustNewBTCPrivatekey()
+	nkeys, err := NewKeys([]Key{t.newKey(npk.Publickey(), 100)}, 100)
+	t.NoError(err)
+
+	fact := NewKeyUpdaterFactStrict(util.UUID().Bytes(), sa.Address, nkeys, t.cid)
+	err = opr.Process(t.newOperationFromFact(fact, append(sa.Privs(), npk, key.MustNewBTCPrivatekey())))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "unknown key found")
+}
+
 func TestKeyUpdaterOperation(t *testing.T) {
 	suite.Run(t, new(testKeyUpdaterOperation))
 }
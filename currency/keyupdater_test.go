@@ -49,11 +49,32 @@ func (t *testKeyUpdater) TestNew() {
 	t.Implements((*operation.Operation)(nil), op)
 }
 
+func (t *testKeyUpdater) TestStrict() {
+	npk := key.MustNewBTCPrivatekey()
+	nkey, err := NewKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewKeys([]Key{nkey}, 100)
+	t.NoError(err)
+
+	token := util.UUID().Bytes()
+	target := NewTestAddress()
+
+	fact := NewKeyUpdaterFact(token, target, nkeys, t.cid)
+	sfact := NewKeyUpdaterFactStrict(token, target, nkeys, t.cid)
+
+	t.NoError(sfact.IsValid(nil))
+	t.False(fact.IsStrict())
+	t.True(sfact.IsStrict())
+	t.True(KeyUpdaterFactHint.Equal(fact.Hint()))
+	t.True(KeyUpdaterFactStrictHint.Equal(sfact.Hint()))
+	t.False(fact.Hash().Equal(sfact.Hash()))
+}
+
 func TestKeyUpdater(t *testing.T) {
 	suite.Run(t, new(testKeyUpdater))
 }
 
-func testKeyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+func testKeyUpdaterEncode(enc encoder.Encoder, strict bool) suite.TestingSuite {
 	t := new(baseTestOperationEncode)
 
 	t.enc = enc
@@ -74,7 +95,12 @@ func testKeyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		token := util.UUID().Bytes()
 
-		fact := NewKeyUpdaterFact(token, sender, nkeys, CurrencyID("SEEME"))
+		newFact := NewKeyUpdaterFact
+		if strict {
+			newFact = NewKeyUpdaterFactStrict
+		}
+
+		fact := newFact(token, sender, nkeys, CurrencyID("SEEME"))
 		sig, err := operation.NewFactSignature(spk, fact, nil)
 		t.NoError(err)
 		fs := []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}
@@ -96,6 +122,8 @@ func testKeyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 		fact := ca.Fact().(KeyUpdaterFact)
 		ufact := cb.Fact().(KeyUpdaterFact)
 
+		t.True(fact.Hint().Equal(ufact.Hint()))
+		t.True(fact.Hash().Equal(ufact.Hash()))
 		t.True(fact.target.Equal(ufact.target))
 		t.True(fact.Keys().Equal(ufact.Keys()))
 		t.Equal(fact.currency, ufact.currency)
@@ -105,9 +133,17 @@ func testKeyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
 }
 
 func TestKeyUpdaterEncodeJSON(t *testing.T) {
-	suite.Run(t, testKeyUpdaterEncode(jsonenc.NewEncoder()))
+	suite.Run(t, testKeyUpdaterEncode(jsonenc.NewEncoder(), false))
 }
 
 func TestKeyUpdaterEncodeBSON(t *testing.T) {
-	suite.Run(t, testKeyUpdaterEncode(bsonenc.NewEncoder()))
+	suite.Run(t, testKeyUpdaterEncode(bsonenc.NewEncoder(), false))
+}
+
+func TestKeyUpdaterStrictEncodeJSON(t *testing.T) {
+	suite.Run(t, testKeyUpdaterEncode(jsonenc.NewEncoder(), true))
+}
+
+func TestKeyUpdaterStrictEncodeBSON(t *testing.T) {
+	suite.Run(t, testKeyUpdaterEncode(bsonenc.NewEncoder(), true))
 }
@@ -2,7 +2,12 @@ package currency
 
 import "golang.org/x/xerrors"
 
-var MaxMemoSize = 100 // TODO should be managed by policy
+// MaxMemoSize is the upper bound of memo size, which is checked without the
+// network policy; the actual limit is set by NetworkPolicy.
+var MaxMemoSize = 1024
+
+// DefaultMaxMemoSize is the memo size limit of DefaultNetworkPolicy.
+var DefaultMaxMemoSize uint = 100
 
 func IsValidMemo(s string) error {
 	if len(s) > MaxMemoSize {
@@ -0,0 +1,258 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	MultiTransfersFactType = hint.MustNewType(0xa0, 0x4b, "mitum-currency-multi-transfers-operation-fact")
+	MultiTransfersFactHint = hint.MustHint(MultiTransfersFactType, "0.0.1")
+	MultiTransfersType     = hint.MustNewType(0xa0, 0x4c, "mitum-currency-multi-transfers-operation")
+	MultiTransfersHint     = hint.MustHint(MultiTransfersType, "0.0.1")
+)
+
+var (
+	MultiTransfersFactExpiringHint   = hint.MustHint(MultiTransfersFactType, "0.0.2")
+	MultiTransfersFactExpiringHinter = MultiTransfersFact{expiringFact: expiringFact{ht: MultiTransfersFactExpiringHint}}
+)
+
+// MultiTransfersFact transfers the amounts of multiple senders to the receivers
+// at once. The sum of the amounts of senders should be same with the sum of
+// the amounts of items by currency. Each sender pays the fee of it's own
+// amounts.
+type MultiTransfersFact struct {
+	expiringFact
+	h       valuehash.Hash
+	token   []byte
+	senders []MultiTransfersSender
+	items   []TransfersItem
+}
+
+func NewMultiTransfersFact(token []byte, senders []MultiTransfersSender, items []TransfersItem) MultiTransfersFact {
+	fact := MultiTransfersFact{
+		token:   token,
+		senders: senders,
+		items:   items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact MultiTransfersFact) WithExpiry(ex Expiry) MultiTransfersFact {
+	fact.expiringFact = newExpiringFact(MultiTransfersFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact MultiTransfersFact) Hint() hint.Hint {
+	return fact.factHint(MultiTransfersFactHint)
+}
+
+func (fact MultiTransfersFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact MultiTransfersFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MultiTransfersFact) Token() []byte {
+	return fact.token
+}
+
+func (fact MultiTransfersFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	sds := make([][]byte, len(fact.senders))
+	for i := range fact.senders {
+		sds[i] = fact.senders[i].Bytes()
+	}
+
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		util.ConcatBytesSlice(sds...),
+		util.ConcatBytesSlice(its...),
+		ext,
+	)
+}
+
+func (fact MultiTransfersFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for MultiTransfersFact")
+	} else if n := len(fact.senders); n < 1 {
+		return xerrors.Errorf("empty senders")
+	} else if n > int(MaxTransferItems) {
+		return xerrors.Errorf("senders, %d over max, %d", n, MaxTransferItems)
+	} else if n := len(fact.items); n < 1 {
+		return xerrors.Errorf("empty items")
+	} else if n > int(MaxTransferItems) {
+		return xerrors.Errorf("items, %d over max, %d", n, MaxTransferItems)
+	}
+
+	if err := fact.h.IsValid(nil); err != nil {
+		return err
+	}
+
+	inputs := map[CurrencyID]Big{}
+	foundSenders := map[string]struct{}{}
+	for i := range fact.senders {
+		sd := fact.senders[i]
+		if err := sd.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid sender found: %w", err)
+		}
+
+		k := StateAddressKeyPrefix(sd.Sender())
+		if _, found := foundSenders[k]; found {
+			return xerrors.Errorf("duplicated sender found, %s", sd.Sender())
+		}
+		foundSenders[k] = struct{}{}
+
+		for j := range sd.Amounts() {
+			addAmountToBigs(inputs, sd.Amounts()[j])
+		}
+	}
+
+	outputs := map[CurrencyID]Big{}
+	foundReceivers := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := it.IsValid(nil); err != nil {
+			return xerrors.Errorf("invalid item found: %w", err)
+		}
+
+		k := StateAddressKeyPrefix(it.Receiver())
+		if _, found := foundReceivers[k]; found {
+			return xerrors.Errorf("duplicated receiver found, %s", it.Receiver())
+		} else if _, found := foundSenders[k]; found {
+			return xerrors.Errorf("receiver is same with sender, %q", it.Receiver())
+		}
+		foundReceivers[k] = struct{}{}
+
+		for j := range it.Amounts() {
+			addAmountToBigs(outputs, it.Amounts()[j])
+		}
+	}
+
+	if len(inputs) != len(outputs) {
+		return xerrors.Errorf("currencies of senders and items does not match")
+	}
+
+	for cid := range inputs {
+		if o, found := outputs[cid]; !found {
+			return xerrors.Errorf("currency, %q of senders not found in items", cid)
+		} else if !inputs[cid].Equal(o) {
+			return xerrors.Errorf("amounts of senders and items does not match, %q; %v != %v", cid, inputs[cid], o)
+		}
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact MultiTransfersFact) Senders() []MultiTransfersSender {
+	return fact.senders
+}
+
+func (fact MultiTransfersFact) Items() []TransfersItem {
+	return fact.items
+}
+
+func (fact MultiTransfersFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.senders)+len(fact.items))
+	for i := range fact.senders {
+		as[i] = fact.senders[i].Sender()
+	}
+
+	for i := range fact.items {
+		as[len(fact.senders)+i] = fact.items[i].Receiver()
+	}
+
+	return as, nil
+}
+
+type MultiTransfers struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewMultiTransfers(
+	fact MultiTransfersFact,
+	fs []operation.FactSign,
+	memo string,
+) (MultiTransfers, error) {
+	if bo, err := operation.NewBaseOperationFromFact(MultiTransfersHint, fact, fs); err != nil {
+		return MultiTransfers{}, err
+	} else {
+		op := MultiTransfers{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op MultiTransfers) Hint() hint.Hint {
+	return MultiTransfersHint
+}
+
+func (op MultiTransfers) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op MultiTransfers) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op MultiTransfers) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
+
+func addAmountToBigs(m map[CurrencyID]Big, am Amount) {
+	if i, found := m[am.Currency()]; found {
+		m[am.Currency()] = i.Add(am.Big())
+	} else {
+		m[am.Currency()] = am.Big()
+	}
+}
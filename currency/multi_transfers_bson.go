@@ -0,0 +1,82 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact MultiTransfersFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"hash":    fact.h,
+		"token":   fact.token,
+		"senders": fact.senders,
+		"items":   fact.items,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
+}
+
+type MultiTransfersFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	SD []bson.Raw      `bson:"senders"`
+	IT []bson.Raw      `bson:"items"`
+	EX *Expiry         `bson:"expiry,omitempty"`
+}
+
+func (fact *MultiTransfersFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact MultiTransfersFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	sds := make([][]byte, len(ufact.SD))
+	for i := range ufact.SD {
+		sds[i] = ufact.SD[i]
+	}
+
+	its := make([][]byte, len(ufact.IT))
+	for i := range ufact.IT {
+		its[i] = ufact.IT[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, sds, its, ufact.EX)
+}
+
+func (op MultiTransfers) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *MultiTransfers) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = MultiTransfers{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (it *MultiTransfersSender) unpack(
+	enc encoder.Encoder,
+	bSender base.AddressDecoder,
+	bams [][]byte,
+) error {
+	if a, err := bSender.Encode(enc); err != nil {
+		return err
+	} else {
+		it.sender = a
+	}
+
+	ams := make([]Amount, len(bams))
+	for i := range bams {
+		if j, err := DecodeAmount(enc, bams[i]); err != nil {
+			return err
+		} else {
+			ams[i] = j
+		}
+	}
+
+	it.amounts = ams
+
+	return nil
+}
+
+func (fact *MultiTransfersFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bsenders [][]byte,
+	bitems [][]byte,
+	ex *Expiry,
+) error {
+	senders := make([]MultiTransfersSender, len(bsenders))
+	for i := range bsenders {
+		if hinter, err := enc.DecodeByHint(bsenders[i]); err != nil {
+			return err
+		} else if j, ok := hinter.(MultiTransfersSender); !ok {
+			return xerrors.Errorf("not MultiTransfersSender: %T", hinter)
+		} else {
+			senders[i] = j
+		}
+	}
+
+	items := make([]TransfersItem, len(bitems))
+	for i := range bitems {
+		if j, err := DecodeTransfersItem(enc, bitems[i]); err != nil {
+			return err
+		} else {
+			items[i] = j
+		}
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, MultiTransfersFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.senders = senders
+	fact.items = items
+
+	return nil
+}
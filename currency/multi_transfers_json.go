@@ -0,0 +1,91 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type MultiTransfersFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash         `json:"hash"`
+	TK []byte                 `json:"token"`
+	SD []MultiTransfersSender `json:"senders"`
+	IT []TransfersItem        `json:"items"`
+	EX *Expiry                `json:"expiry,omitempty"`
+}
+
+func (fact MultiTransfersFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(MultiTransfersFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.senders,
+		IT:         fact.items,
+		EX:         ex,
+	})
+}
+
+type MultiTransfersFactJSONUnpacker struct {
+	H  valuehash.Bytes   `json:"hash"`
+	TK []byte            `json:"token"`
+	SD []json.RawMessage `json:"senders"`
+	IT []json.RawMessage `json:"items"`
+	EX *Expiry           `json:"expiry,omitempty"`
+}
+
+func (fact *MultiTransfersFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact MultiTransfersFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	sds := make([][]byte, len(ufact.SD))
+	for i := range ufact.SD {
+		sds[i] = ufact.SD[i]
+	}
+
+	its := make([][]byte, len(ufact.IT))
+	for i := range ufact.IT {
+		its[i] = ufact.IT[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, sds, its, ufact.EX)
+}
+
+func (op MultiTransfers) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *MultiTransfers) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = MultiTransfers{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op MultiTransfers) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type MultiTransfersProcessor struct {
+	cp *CurrencyPool
+	MultiTransfers
+	sb       []map[CurrencyID]AmountState
+	rb       []*TransfersItemProcessor
+	required []map[CurrencyID][2]Big
+	height   base.Height
+	ss       []state.State
+}
+
+func NewMultiTransfersProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(MultiTransfers); !ok {
+			return nil, xerrors.Errorf("not MultiTransfers, %T", op)
+		} else {
+			return &MultiTransfersProcessor{
+				cp:             cp,
+				MultiTransfers: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *MultiTransfersProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *MultiTransfersProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *MultiTransfersProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(MultiTransfersFact)
+
+	senders := make([]base.Address, len(fact.senders))
+	sb := make([]map[CurrencyID]AmountState, len(fact.senders))
+	required := make([]map[CurrencyID][2]Big, len(fact.senders))
+	var ss []state.State
+	for i := range fact.senders {
+		sd := fact.senders[i]
+		senders[i] = sd.Sender()
+
+		if err := checkExistsState(StateKeyAccount(sd.Sender()), getState); err != nil {
+			return nil, err
+		}
+
+		if rq, err := CalculateItemsFee(opp.cp, []AmountsItem{sd}); err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		} else if st, err := CheckEnoughBalance(opp.cp, sd.Sender(), rq, getState); err != nil {
+			return nil, err
+		} else {
+			required[i] = rq
+			sb[i] = st
+		}
+
+		if i, err := checkSpendingLimits(sd.Sender(), opp.height, required[i], getState); err != nil {
+			return nil, err
+		} else {
+			ss = append(ss, i...)
+		}
+	}
+
+	rb := make([]*TransfersItemProcessor, len(fact.items))
+	for i := range fact.items {
+		c := &TransfersItemProcessor{cp: opp.cp, h: opp.Hash(), item: fact.items[i]}
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		rb[i] = c
+	}
+
+	if err := checkFactSignsBySenders(senders, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.sb = sb
+	opp.rb = rb
+	opp.required = required
+	opp.ss = ss
+
+	return opp, nil
+}
+
+func (opp *MultiTransfersProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(MultiTransfersFact)
+
+	var sts []state.State // nolint:prealloc
+	for i := range opp.rb {
+		if s, err := opp.rb[i].Process(getState, setState); err != nil {
+			return operation.NewBaseReasonError("failed to process transfer item: %w", err)
+		} else {
+			sts = append(sts, s...)
+		}
+	}
+
+	for i := range opp.required {
+		for k := range opp.required[i] {
+			rq := opp.required[i][k]
+			sts = append(sts, opp.sb[i][k].Sub(rq[0]).AddFee(rq[1]))
+		}
+	}
+
+	sts = append(sts, opp.ss...)
+
+	return setState(fact.Hash(), sts...)
+}
@@ -0,0 +1,270 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testMultiTransfersOperation struct {
+	baseTestOperationProcessor
+}
+
+func (t *testMultiTransfersOperation) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(MultiTransfers{}, NewMultiTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testMultiTransfersOperation) newOperation(
+	senders []MultiTransfersSender,
+	items []TransfersItem,
+	pks []key.Privatekey,
+) MultiTransfers {
+	fact := NewMultiTransfersFact(util.UUID().Bytes(), senders, items)
+
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewMultiTransfers(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testMultiTransfersOperation) balances(pool *storage.Statepool) map[string]Big {
+	bs := map[string]Big{}
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+		if !IsStateBalanceKey(st.Key()) {
+			continue
+		}
+
+		am, err := StateBalanceValue(st)
+		t.NoError(err)
+		bs[st.Key()] = am.Big()
+	}
+
+	return bs
+}
+
+func (t *testMultiTransfersOperation) TestNew() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, stb, str)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewFixedFeeer(sa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(10), t.cid)}),
+			NewMultiTransfersSender(sb.Address, []Amount{NewAmount(NewBig(20), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(30), t.cid))},
+		append(sa.Privs(), sb.Privs()...),
+	)
+	t.NoError(opr.Process(op))
+
+	bs := t.balances(pool)
+	t.True(NewBig(33 - 10 - 1).Equal(bs[StateKeyBalance(sa.Address, t.cid)]))
+	t.True(NewBig(33 - 20 - 1).Equal(bs[StateKeyBalance(sb.Address, t.cid)]))
+	t.True(NewBig(30).Equal(bs[StateKeyBalance(ra.Address, t.cid)]))
+}
+
+func (t *testMultiTransfersOperation) TestTransferLimits() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, ZeroBig)).WithTransferLimits(NewBig(5), NewBig(10))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	for _, c := range []struct {
+		big int64
+		err string
+	}{
+		{4, "under min transfer amount"},
+		{11, "over max transfer amount"},
+		{5, ""},
+		{10, ""},
+	} {
+		op := t.newOperation(
+			[]MultiTransfersSender{NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(c.big), t.cid)})},
+			[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(c.big), t.cid))},
+			sa.Privs(),
+		)
+		err := opr.Process(op)
+
+		if len(c.err) < 1 {
+			t.NoError(err, "amount=%d", c.big)
+
+			continue
+		}
+
+		var oper operation.ReasonError
+		t.True(xerrors.As(err, &oper))
+		t.Contains(err.Error(), c.err, "amount=%d", c.big)
+	}
+}
+
+func (t *testMultiTransfersOperation) TestNotSignedBySender() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, stb, str)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(10), t.cid)}),
+			NewMultiTransfersSender(sb.Address, []Amount{NewAmount(NewBig(20), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(30), t.cid))},
+		sa.Privs(),
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "not passed threshold of sender")
+	t.Empty(pool.Updates())
+}
+
+func (t *testMultiTransfersOperation) TestUnknownSigner() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, str)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(10), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(10), t.cid))},
+		append(sa.Privs(), key.MustNewBTCPrivatekey()),
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "unknown key found")
+}
+
+func (t *testMultiTransfersOperation) TestInsufficientBalanceOfOneSender() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, stb, str)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newOperation(
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(10), t.cid)}),
+			NewMultiTransfersSender(sb.Address, []Amount{NewAmount(NewBig(20), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(30), t.cid))},
+		append(sa.Privs(), sb.Privs()...),
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+	t.Empty(pool.Updates())
+}
+
+func (t *testMultiTransfersOperation) TestSenderInProposal() {
+	sa, sta := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, stb := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, str := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sta, stb, str)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), sa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	tf := NewTransfersFact(util.UUID().Bytes(), sb.Address, []TransfersItem{
+		NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(20), t.cid)),
+	})
+	sig, err := operation.NewFactSignature(sb.Priv, tf, nil)
+	t.NoError(err)
+	top, err := NewTransfers(tf, []operation.FactSign{operation.NewBaseFactSign(sb.Priv.Publickey(), sig)}, "")
+	t.NoError(err)
+	t.NoError(opr.Process(top))
+
+	op := t.newOperation(
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sa.Address, []Amount{NewAmount(NewBig(10), t.cid)}),
+			NewMultiTransfersSender(sb.Address, []Amount{NewAmount(NewBig(20), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(30), t.cid))},
+		append(sa.Privs(), sb.Privs()...),
+	)
+
+	err = opr.Process(op)
+
+	// NOTE the balance of sb is already debited by the previous operation
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func TestMultiTransfersOperation(t *testing.T) {
+	suite.Run(t, new(testMultiTransfersOperation))
+}
+
+var _ base.Fact = MultiTransfersFact{}
@@ -0,0 +1,79 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	MultiTransfersSenderType = hint.MustNewType(0xa0, 0x4a, "mitum-currency-multi-transfers-sender")
+	MultiTransfersSenderHint = hint.MustHint(MultiTransfersSenderType, "0.0.1")
+)
+
+// MultiTransfersSender is the amounts, which one of the senders of
+// MultiTransfers contributes.
+type MultiTransfersSender struct {
+	sender  base.Address
+	amounts []Amount
+}
+
+func NewMultiTransfersSender(sender base.Address, amounts []Amount) MultiTransfersSender {
+	return MultiTransfersSender{
+		sender:  sender,
+		amounts: amounts,
+	}
+}
+
+func (it MultiTransfersSender) Hint() hint.Hint {
+	return MultiTransfersSenderHint
+}
+
+func (it MultiTransfersSender) Bytes() []byte {
+	bs := make([][]byte, len(it.amounts)+1)
+	bs[0] = it.sender.Bytes()
+
+	for i := range it.amounts {
+		bs[i+1] = it.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it MultiTransfersSender) IsValid([]byte) error {
+	if err := it.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := len(it.amounts); n == 0 {
+		return xerrors.Errorf("empty amounts")
+	}
+
+	founds := map[CurrencyID]struct{}{}
+	for i := range it.amounts {
+		am := it.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return xerrors.Errorf("duplicated currency found, %q", am.Currency())
+		} else {
+			founds[am.Currency()] = struct{}{}
+		}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return xerrors.Errorf("amount should be over zero")
+		}
+	}
+
+	return nil
+}
+
+func (it MultiTransfersSender) Sender() base.Address {
+	return it.sender
+}
+
+func (it MultiTransfersSender) Amounts() []Amount {
+	return it.amounts
+}
@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (it MultiTransfersSender) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"sender":  it.sender,
+				"amounts": it.amounts,
+			}),
+	)
+}
+
+type MultiTransfersSenderBSONUnpacker struct {
+	SD base.AddressDecoder `bson:"sender"`
+	AM []bson.Raw          `bson:"amounts"`
+}
+
+func (it *MultiTransfersSender) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uit MultiTransfersSenderBSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(uit.AM))
+	for i := range uit.AM {
+		ams[i] = uit.AM[i]
+	}
+
+	return it.unpack(enc, uit.SD, ams)
+}
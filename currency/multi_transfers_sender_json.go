@@ -0,0 +1,41 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type MultiTransfersSenderJSONPacker struct {
+	jsonenc.HintedHead
+	SD base.Address `json:"sender"`
+	AM []Amount     `json:"amounts"`
+}
+
+func (it MultiTransfersSender) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(MultiTransfersSenderJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		SD:         it.sender,
+		AM:         it.amounts,
+	})
+}
+
+type MultiTransfersSenderJSONUnpacker struct {
+	SD base.AddressDecoder `json:"sender"`
+	AM []json.RawMessage   `json:"amounts"`
+}
+
+func (it *MultiTransfersSender) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uit MultiTransfersSenderJSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(uit.AM))
+	for i := range uit.AM {
+		ams[i] = uit.AM[i]
+	}
+
+	return it.unpack(enc, uit.SD, ams)
+}
@@ -0,0 +1,165 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testMultiTransfers struct {
+	baseTest
+}
+
+func (t *testMultiTransfers) TestNew() {
+	spk := key.MustNewBTCPrivatekey()
+
+	fact := NewMultiTransfersFact(
+		util.UUID().Bytes(),
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(NewTestAddress(), []Amount{NewAmount(NewBig(3), t.cid)}),
+			NewMultiTransfersSender(NewTestAddress(), []Amount{NewAmount(NewBig(7), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(10), t.cid))},
+	)
+	sig, err := operation.NewFactSignature(spk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewMultiTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testMultiTransfers) TestNotMatchedAmounts() {
+	fact := NewMultiTransfersFact(
+		util.UUID().Bytes(),
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(NewTestAddress(), []Amount{NewAmount(NewBig(3), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(4), t.cid))},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "amounts of senders and items does not match")
+}
+
+func (t *testMultiTransfers) TestNotMatchedCurrency() {
+	fact := NewMultiTransfersFact(
+		util.UUID().Bytes(),
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(NewTestAddress(), []Amount{NewAmount(NewBig(3), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(3), CurrencyID("FINDME")))},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "not found in items")
+}
+
+func (t *testMultiTransfers) TestDuplicatedSender() {
+	sender := NewTestAddress()
+	fact := NewMultiTransfersFact(
+		util.UUID().Bytes(),
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sender, []Amount{NewAmount(NewBig(3), t.cid)}),
+			NewMultiTransfersSender(sender, []Amount{NewAmount(NewBig(3), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(6), t.cid))},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated sender found")
+}
+
+func (t *testMultiTransfers) TestReceiverIsSender() {
+	sender := NewTestAddress()
+	fact := NewMultiTransfersFact(
+		util.UUID().Bytes(),
+		[]MultiTransfersSender{
+			NewMultiTransfersSender(sender, []Amount{NewAmount(NewBig(3), t.cid)}),
+		},
+		[]TransfersItem{NewTransfersItemSingleAmount(sender, NewAmount(NewBig(3), t.cid))},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func TestMultiTransfers(t *testing.T) {
+	suite.Run(t, new(testMultiTransfers))
+}
+
+func testMultiTransfersEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		spk := key.MustNewBTCPrivatekey()
+
+		cid := CurrencyID("SEEME")
+		fact := NewMultiTransfersFact(
+			util.UUID().Bytes(),
+			[]MultiTransfersSender{
+				NewMultiTransfersSender(NewTestAddress(), []Amount{NewAmount(NewBig(3), cid)}),
+				NewMultiTransfersSender(NewTestAddress(), []Amount{NewAmount(NewBig(7), cid)}),
+			},
+			[]TransfersItem{
+				NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(4), cid)),
+				NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(6), cid)),
+			},
+		)
+		sig, err := operation.NewFactSignature(spk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewMultiTransfers(
+			fact, []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(MultiTransfers)
+		tb := b.(MultiTransfers)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		fact := ta.Fact().(MultiTransfersFact)
+		ufact := tb.Fact().(MultiTransfersFact)
+
+		t.Equal(len(fact.senders), len(ufact.senders))
+		for i := range fact.senders {
+			t.Equal(fact.senders[i].Bytes(), ufact.senders[i].Bytes())
+		}
+
+		t.Equal(len(fact.items), len(ufact.items))
+		for i := range fact.items {
+			t.Equal(fact.items[i].Bytes(), ufact.items[i].Bytes())
+		}
+	}
+
+	return t
+}
+
+func TestMultiTransfersEncodeJSON(t *testing.T) {
+	suite.Run(t, testMultiTransfersEncode(jsonenc.NewEncoder()))
+}
+
+func TestMultiTransfersEncodeBSON(t *testing.T) {
+	suite.Run(t, testMultiTransfersEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,293 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	NetworkPolicyType = hint.MustNewType(0xa0, 0x6b, "mitum-currency-network-policy")
+	NetworkPolicyHint = hint.MustHint(NetworkPolicyType, "0.0.1")
+)
+
+// DefaultNetworkPolicy is the network policy before NetworkPolicyUpdater sets
+// the network policy state.
+var DefaultNetworkPolicy = NewNetworkPolicy(
+	DefaultMaxTransferItems, DefaultMaxMemoSize, DefaultMaxKeyInKeys, 1, 100, 1, 100)
+
+// NetworkPolicy has the protocol limits of the network, which are updated by
+// the suffrage nodes with NetworkPolicyUpdater. The limits are checked by
+// OperationProcessor; the facts only check the upper bounds, MaxTransferItems,
+// MaxMemoSize and MaxKeyInKeys, so NetworkPolicy can raise the limits up to
+// them. The capacity of block is also kept here, so every node accepts same
+// operations in proposal. The size limits of AccountData are also kept; without
+// them, DefaultAccountDataPolicy is used.
+type NetworkPolicy struct {
+	maxTransferItems uint
+	maxMemoSize      uint
+	maxKeyInKeys     uint
+	minKeyWeight     uint
+	maxKeyWeight     uint
+	minThreshold     uint
+	maxThreshold     uint
+	blockLimits      BlockLimits
+	accountData      AccountDataPolicy
+}
+
+func NewNetworkPolicy(
+	maxTransferItems,
+	maxMemoSize,
+	maxKeyInKeys,
+	minKeyWeight,
+	maxKeyWeight,
+	minThreshold,
+	maxThreshold uint,
+) NetworkPolicy {
+	return NetworkPolicy{
+		maxTransferItems: maxTransferItems,
+		maxMemoSize:      maxMemoSize,
+		maxKeyInKeys:     maxKeyInKeys,
+		minKeyWeight:     minKeyWeight,
+		maxKeyWeight:     maxKeyWeight,
+		minThreshold:     minThreshold,
+		maxThreshold:     maxThreshold,
+	}
+}
+
+func (po NetworkPolicy) Hint() hint.Hint {
+	return NetworkPolicyHint
+}
+
+func (po NetworkPolicy) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.UintToBytes(po.maxTransferItems),
+		util.UintToBytes(po.maxMemoSize),
+		util.UintToBytes(po.maxKeyInKeys),
+		util.UintToBytes(po.minKeyWeight),
+		util.UintToBytes(po.maxKeyWeight),
+		util.UintToBytes(po.minThreshold),
+		util.UintToBytes(po.maxThreshold),
+		util.UintToBytes(po.blockLimits.MaxOperations),
+		util.UintToBytes(po.blockLimits.MaxItems),
+		util.UintToBytes(po.accountData.maxEntries),
+		util.UintToBytes(po.accountData.maxKeySize),
+		util.UintToBytes(po.accountData.maxValueSize),
+	)
+}
+
+func (po NetworkPolicy) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(po.Bytes())
+}
+
+func (po NetworkPolicy) IsValid([]byte) error {
+	switch {
+	case po.maxTransferItems < 1 || po.maxTransferItems > MaxTransferItems:
+		return xerrors.Errorf("max transfer items should be 1 <= items <= %d, %d", MaxTransferItems, po.maxTransferItems)
+	case po.maxMemoSize > uint(MaxMemoSize):
+		return xerrors.Errorf("max memo size over %d, %d", MaxMemoSize, po.maxMemoSize)
+	case po.maxKeyInKeys < 1 || po.maxKeyInKeys > uint(MaxKeyInKeys):
+		return xerrors.Errorf("max keys in keys should be 1 <= keys <= %d, %d", MaxKeyInKeys, po.maxKeyInKeys)
+	case po.minKeyWeight < 1 || po.minKeyWeight > po.maxKeyWeight || po.maxKeyWeight > 100:
+		return xerrors.Errorf("invalid key weight range, %d - %d", po.minKeyWeight, po.maxKeyWeight)
+	case po.minThreshold < 1 || po.minThreshold > po.maxThreshold || po.maxThreshold > 100:
+		return xerrors.Errorf("invalid threshold range, %d - %d", po.minThreshold, po.maxThreshold)
+	case !po.accountData.IsEmpty():
+		return po.accountData.IsValid(nil)
+	default:
+		return nil
+	}
+}
+
+func (po NetworkPolicy) MaxTransferItems() uint {
+	return po.maxTransferItems
+}
+
+func (po NetworkPolicy) MaxMemoSize() uint {
+	return po.maxMemoSize
+}
+
+func (po NetworkPolicy) MaxKeyInKeys() uint {
+	return po.maxKeyInKeys
+}
+
+func (po NetworkPolicy) KeyWeightRange() (uint, uint) {
+	return po.minKeyWeight, po.maxKeyWeight
+}
+
+func (po NetworkPolicy) ThresholdRange() (uint, uint) {
+	return po.minThreshold, po.maxThreshold
+}
+
+func (po NetworkPolicy) BlockLimits() BlockLimits {
+	return po.blockLimits
+}
+
+func (po NetworkPolicy) SetBlockLimits(limits BlockLimits) NetworkPolicy {
+	po.blockLimits = limits
+
+	return po
+}
+
+// AccountDataPolicy returns the size limits of AccountData; without them,
+// DefaultAccountDataPolicy is returned.
+func (po NetworkPolicy) AccountDataPolicy() AccountDataPolicy {
+	if po.accountData.IsEmpty() {
+		return DefaultAccountDataPolicy
+	}
+
+	return po.accountData
+}
+
+func (po NetworkPolicy) SetAccountDataPolicy(adp AccountDataPolicy) NetworkPolicy {
+	po.accountData = adp
+
+	return po
+}
+
+// CheckOperation checks the operation is in the limits; the operations in
+// Batch are also checked.
+func (po NetworkPolicy) CheckOperation(op operation.Operation) error {
+	if err := po.CheckMemo(operationMemo(op)); err != nil {
+		return err
+	}
+
+	switch t := op.Fact().(type) {
+	case TransfersFact:
+		return po.checkTransferItems("items", len(t.Items()))
+	case MultiTransfersFact:
+		if err := po.checkTransferItems("senders", len(t.Senders())); err != nil {
+			return err
+		}
+
+		return po.checkTransferItems("items", len(t.Items()))
+	case CreateAccountsFact:
+		for _, it := range t.Items() {
+			if err := po.CheckKeys(it.Keys()); err != nil {
+				return err
+			}
+		}
+	case KeyUpdaterFact:
+		return po.CheckKeys(t.Keys())
+	case KeyRecoveryFact:
+		return po.CheckKeys(t.Keys())
+	case CreateClaimableBalanceFact:
+		return po.CheckKeys(t.Keys())
+	case ClaimBalanceFact:
+		return po.CheckKeys(t.Keys())
+	case BatchFact:
+		for i, sop := range t.Operations() {
+			if err := po.CheckOperation(sop); err != nil {
+				return xerrors.Errorf("%d operation in batch: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (po NetworkPolicy) CheckMemo(s string) error {
+	if n := uint(len(s)); n > po.maxMemoSize {
+		return xerrors.Errorf("memo over max size, %d > %d", n, po.maxMemoSize)
+	}
+
+	return nil
+}
+
+func (po NetworkPolicy) CheckKeys(ks Keys) error {
+	if n := uint(len(ks.Keys())); n > po.maxKeyInKeys {
+		return xerrors.Errorf("keys over %d, %d", po.maxKeyInKeys, n)
+	}
+
+	for _, k := range ks.Keys() {
+		if w := k.Weight(); w < po.minKeyWeight || w > po.maxKeyWeight {
+			return xerrors.Errorf("invalid key weight, %d, should be %d <= weight <= %d",
+				w, po.minKeyWeight, po.maxKeyWeight)
+		}
+	}
+
+	if err := po.checkThreshold(ks.Threshold()); err != nil {
+		return err
+	}
+
+	for t, th := range ks.Thresholds() {
+		if err := po.checkThreshold(th); err != nil {
+			return xerrors.Errorf("invalid threshold of %s: %w", t.Verbose(), err)
+		}
+	}
+
+	return nil
+}
+
+func (po NetworkPolicy) checkTransferItems(name string, n int) error {
+	if uint(n) > po.maxTransferItems {
+		return xerrors.Errorf("%s, %d over max, %d", name, n, po.maxTransferItems)
+	}
+
+	return nil
+}
+
+func (po NetworkPolicy) checkThreshold(th uint) error {
+	if th < po.minThreshold || th > po.maxThreshold {
+		return xerrors.Errorf("invalid threshold, %d, should be %d <= threshold <= %d",
+			th, po.minThreshold, po.maxThreshold)
+	}
+
+	return nil
+}
+
+// operationMemo returns the memo of operation; the unknown operation has no
+// memo.
+func operationMemo(op operation.Operation) string {
+	switch t := op.(type) {
+	case Transfers:
+		return t.Memo
+	case CreateAccounts:
+		return t.Memo
+	case KeyUpdater:
+		return t.Memo
+	case CurrencyRegister:
+		return t.Memo
+	case CurrencyPolicyUpdater:
+		return t.Memo
+	case CreateClaimableBalance:
+		return t.Memo
+	case ClaimBalance:
+		return t.Memo
+	case ReclaimBalance:
+		return t.Memo
+	case Approve:
+		return t.Memo
+	case TransferFrom:
+		return t.Memo
+	case MultiTransfers:
+		return t.Memo
+	case Batch:
+		return t.Memo
+	case AccountMerge:
+		return t.Memo
+	case GuardiansUpdater:
+		return t.Memo
+	case KeyRecovery:
+		return t.Memo
+	case KeyRecoveryCanceler:
+		return t.Memo
+	case AccountPolicyUpdater:
+		return t.Memo
+	case AliasRegister:
+		return t.Memo
+	case AliasTransfer:
+		return t.Memo
+	case AliasRelease:
+		return t.Memo
+	case AccountDataUpdater:
+		return t.Memo
+	case NetworkPolicyUpdater:
+		return t.Memo
+	default:
+		return ""
+	}
+}
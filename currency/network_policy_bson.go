@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (po NetworkPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(po.Hint()),
+		bson.M{
+			"max_transfer_items":   po.maxTransferItems,
+			"max_memo_size":        po.maxMemoSize,
+			"max_key_in_keys":      po.maxKeyInKeys,
+			"min_key_weight":       po.minKeyWeight,
+			"max_key_weight":       po.maxKeyWeight,
+			"min_threshold":        po.minThreshold,
+			"max_threshold":        po.maxThreshold,
+			"max_block_operations": po.blockLimits.MaxOperations,
+			"max_block_items":      po.blockLimits.MaxItems,
+			"max_data_entries":     po.accountData.maxEntries,
+			"max_data_key_size":    po.accountData.maxKeySize,
+			"max_data_value_size":  po.accountData.maxValueSize,
+		}),
+	)
+}
+
+type NetworkPolicyBSONUnpacker struct {
+	TI uint `bson:"max_transfer_items"`
+	MS uint `bson:"max_memo_size"`
+	KK uint `bson:"max_key_in_keys"`
+	NW uint `bson:"min_key_weight"`
+	XW uint `bson:"max_key_weight"`
+	NT uint `bson:"min_threshold"`
+	XT uint `bson:"max_threshold"`
+	BO uint `bson:"max_block_operations"`
+	BI uint `bson:"max_block_items"`
+	DE uint `bson:"max_data_entries"`
+	DK uint `bson:"max_data_key_size"`
+	DV uint `bson:"max_data_value_size"`
+}
+
+func (po *NetworkPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo NetworkPolicyBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	*po = NewNetworkPolicy(upo.TI, upo.MS, upo.KK, upo.NW, upo.XW, upo.NT, upo.XT).
+		SetBlockLimits(BlockLimits{MaxOperations: upo.BO, MaxItems: upo.BI}).
+		SetAccountDataPolicy(NewAccountDataPolicy(upo.DE, upo.DK, upo.DV))
+
+	return nil
+}
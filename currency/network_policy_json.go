@@ -0,0 +1,67 @@
+package currency
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type NetworkPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	TI uint `json:"max_transfer_items"`
+	MS uint `json:"max_memo_size"`
+	KK uint `json:"max_key_in_keys"`
+	NW uint `json:"min_key_weight"`
+	XW uint `json:"max_key_weight"`
+	NT uint `json:"min_threshold"`
+	XT uint `json:"max_threshold"`
+	BO uint `json:"max_block_operations"`
+	BI uint `json:"max_block_items"`
+	DE uint `json:"max_data_entries"`
+	DK uint `json:"max_data_key_size"`
+	DV uint `json:"max_data_value_size"`
+}
+
+func (po NetworkPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(NetworkPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(po.Hint()),
+		TI:         po.maxTransferItems,
+		MS:         po.maxMemoSize,
+		KK:         po.maxKeyInKeys,
+		NW:         po.minKeyWeight,
+		XW:         po.maxKeyWeight,
+		NT:         po.minThreshold,
+		XT:         po.maxThreshold,
+		BO:         po.blockLimits.MaxOperations,
+		BI:         po.blockLimits.MaxItems,
+		DE:         po.accountData.maxEntries,
+		DK:         po.accountData.maxKeySize,
+		DV:         po.accountData.maxValueSize,
+	})
+}
+
+type NetworkPolicyJSONUnpacker struct {
+	TI uint `json:"max_transfer_items"`
+	MS uint `json:"max_memo_size"`
+	KK uint `json:"max_key_in_keys"`
+	NW uint `json:"min_key_weight"`
+	XW uint `json:"max_key_weight"`
+	NT uint `json:"min_threshold"`
+	XT uint `json:"max_threshold"`
+	BO uint `json:"max_block_operations"`
+	BI uint `json:"max_block_items"`
+	DE uint `json:"max_data_entries"`
+	DK uint `json:"max_data_key_size"`
+	DV uint `json:"max_data_value_size"`
+}
+
+func (po *NetworkPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo NetworkPolicyJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	*po = NewNetworkPolicy(upo.TI, upo.MS, upo.KK, upo.NW, upo.XW, upo.NT, upo.XT).
+		SetBlockLimits(BlockLimits{MaxOperations: upo.BO, MaxItems: upo.BI}).
+		SetAccountDataPolicy(NewAccountDataPolicy(upo.DE, upo.DK, upo.DV))
+
+	return nil
+}
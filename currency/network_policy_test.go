@@ -0,0 +1,263 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testNetworkPolicy struct {
+	baseTest
+}
+
+func (t *testNetworkPolicy) newTransfers(n int, memo string) Transfers {
+	items := make([]TransfersItem, n)
+	for i := range items {
+		items[i] = NewTransfersItemSingleAmount(MustAddress(util.UUID().String()), NewAmount(NewBig(1), t.cid))
+	}
+
+	fact := NewTransfersFact(util.UUID().Bytes(), MustAddress(util.UUID().String()), items)
+
+	pk := key.MustNewBTCPrivatekey()
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, memo)
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testNetworkPolicy) TestDefault() {
+	t.NoError(DefaultNetworkPolicy.IsValid(nil))
+
+	t.Equal(DefaultMaxTransferItems, DefaultNetworkPolicy.MaxTransferItems())
+	t.Equal(DefaultMaxMemoSize, DefaultNetworkPolicy.MaxMemoSize())
+	t.Equal(DefaultMaxKeyInKeys, DefaultNetworkPolicy.MaxKeyInKeys())
+	t.Equal(DefaultAccountDataPolicy, DefaultNetworkPolicy.AccountDataPolicy())
+
+	adp := NewAccountDataPolicy(3, 4, 5)
+	t.Equal(adp, DefaultNetworkPolicy.SetAccountDataPolicy(adp).AccountDataPolicy())
+}
+
+func (t *testNetworkPolicy) TestInvalid() {
+	err := NewNetworkPolicy(0, 100, 10, 1, 100, 1, 100).IsValid(nil)
+	t.Contains(err.Error(), "max transfer items should be")
+
+	err = NewNetworkPolicy(10, 100, 10, 0, 100, 1, 100).IsValid(nil)
+	t.Contains(err.Error(), "invalid key weight range")
+
+	err = NewNetworkPolicy(10, 100, 10, 1, 100, 101, 100).IsValid(nil)
+	t.Contains(err.Error(), "invalid threshold range")
+
+	// NOTE over the upper bounds
+	err = NewNetworkPolicy(MaxTransferItems+1, 100, 10, 1, 100, 1, 100).IsValid(nil)
+	t.Contains(err.Error(), "max transfer items should be")
+
+	err = NewNetworkPolicy(10, uint(MaxMemoSize)+1, 10, 1, 100, 1, 100).IsValid(nil)
+	t.Contains(err.Error(), "max memo size over")
+
+	err = NewNetworkPolicy(10, 100, uint(MaxKeyInKeys)+1, 1, 100, 1, 100).IsValid(nil)
+	t.Contains(err.Error(), "max keys in keys should be")
+
+	err = NewNetworkPolicy(10, 100, 10, 1, 101, 1, 100).IsValid(nil)
+	t.Contains(err.Error(), "invalid key weight range")
+
+	err = NewNetworkPolicy(10, 100, 10, 1, 100, 1, 101).IsValid(nil)
+	t.Contains(err.Error(), "invalid threshold range")
+
+	err = NewNetworkPolicy(10, 100, 10, 1, 100, 1, 100).
+		SetAccountDataPolicy(NewAccountDataPolicy(0, 10, 10)).IsValid(nil)
+	t.Contains(err.Error(), "invalid account data policy")
+}
+
+func (t *testNetworkPolicy) TestTransferItems() {
+	po := NewNetworkPolicy(2, 100, 10, 1, 100, 1, 100)
+
+	t.NoError(po.CheckOperation(t.newTransfers(2, "")))
+
+	err := po.CheckOperation(t.newTransfers(3, ""))
+	t.Contains(err.Error(), "items, 3 over max, 2")
+
+	// NOTE over the upper bound
+	err = t.newTransfers(int(MaxTransferItems)+1, "").IsValid(nil)
+	t.Contains(err.Error(), "over max")
+}
+
+func (t *testNetworkPolicy) TestRaiseLimits() {
+	n := DefaultMaxTransferItems + 5
+	memo := strings.Repeat("a", int(DefaultMaxMemoSize)+1)
+
+	op := t.newTransfers(int(n), memo)
+	t.NoError(op.IsValid(nil))
+
+	err := DefaultNetworkPolicy.CheckOperation(op)
+	t.Contains(err.Error(), "memo over max size")
+
+	po := NewNetworkPolicy(n, DefaultMaxMemoSize*2, DefaultMaxKeyInKeys, 1, 100, 1, 100)
+	t.NoError(po.IsValid(nil))
+	t.NoError(po.CheckOperation(op))
+}
+
+func (t *testNetworkPolicy) TestMemo() {
+	po := NewNetworkPolicy(10, 3, 10, 1, 100, 1, 100)
+
+	t.NoError(po.CheckOperation(t.newTransfers(1, "abc")))
+
+	err := po.CheckOperation(t.newTransfers(1, "abcd"))
+	t.Contains(err.Error(), "memo over max size, 4 > 3")
+}
+
+func (t *testNetworkPolicy) TestKeys() {
+	po := NewNetworkPolicy(10, 100, 2, 10, 90, 50, 80)
+
+	newKeys := func(threshold uint, ws ...uint) Keys {
+		ks := make([]Key, len(ws))
+		for i := range ws {
+			k, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), ws[i])
+			t.NoError(err)
+
+			ks[i] = k
+		}
+
+		keys, err := NewKeys(ks, threshold)
+		t.NoError(err)
+
+		return keys
+	}
+
+	t.NoError(po.CheckKeys(newKeys(80, 90)))
+
+	err := po.CheckKeys(newKeys(30, 10, 10, 10))
+	t.Contains(err.Error(), "keys over 2, 3")
+
+	err = po.CheckKeys(newKeys(50, 9, 50))
+	t.Contains(err.Error(), "invalid key weight, 9")
+
+	err = po.CheckKeys(newKeys(40, 50))
+	t.Contains(err.Error(), "invalid threshold, 40")
+
+	err = po.CheckKeys(newKeys(81, 90))
+	t.Contains(err.Error(), "invalid threshold, 81")
+}
+
+func (t *testNetworkPolicy) TestBatch() {
+	po := NewNetworkPolicy(2, 3, 10, 1, 100, 1, 100)
+
+	newBatch := func(ops ...operation.Operation) Batch {
+		fact := NewBatchFact(util.UUID().Bytes(), ops)
+
+		pk := key.MustNewBTCPrivatekey()
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewBatch(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return op
+	}
+
+	t.NoError(po.CheckOperation(newBatch(t.newTransfers(1, ""), t.newTransfers(2, ""))))
+
+	err := po.CheckOperation(newBatch(t.newTransfers(1, ""), t.newTransfers(3, "")))
+	t.Contains(err.Error(), "1 operation in batch")
+	t.Contains(err.Error(), "items, 3 over max, 2")
+
+	err = po.CheckOperation(newBatch(t.newTransfers(1, strings.Repeat("a", 4))))
+	t.Contains(err.Error(), "memo over max size")
+}
+
+func (t *testNetworkPolicy) TestUpdater() {
+	po := NewNetworkPolicy(8, 50, 10, 1, 100, 1, 100)
+	fact := NewNetworkPolicyUpdaterFact(util.UUID().Bytes(), po)
+
+	var fs []operation.FactSign
+	for _, pk := range []key.Privatekey{
+		key.MustNewBTCPrivatekey(),
+		key.MustNewBTCPrivatekey(),
+	} {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewNetworkPolicyUpdater(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	t.Equal(po, op.Fact().(NetworkPolicyUpdaterFact).Policy())
+
+	// NOTE invalid policy
+	fact = NewNetworkPolicyUpdaterFact(util.UUID().Bytes(), NewNetworkPolicy(0, 50, 10, 1, 100, 1, 100))
+	pk := key.MustNewBTCPrivatekey()
+	sig, err := operation.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	op, err = NewNetworkPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.Contains(err.Error(), "max transfer items should be")
+}
+
+func TestNetworkPolicy(t *testing.T) {
+	suite.Run(t, new(testNetworkPolicy))
+}
+
+func testNetworkPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewNetworkPolicyUpdaterFact(util.UUID().Bytes(), NewNetworkPolicy(8, 50, 5, 1, 100, 2, 90).
+			SetBlockLimits(BlockLimits{MaxOperations: 100, MaxItems: 1000}).
+			SetAccountDataPolicy(NewAccountDataPolicy(3, 20, 100)))
+
+		pk := key.MustNewBTCPrivatekey()
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		op, err := NewNetworkPolicyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, util.UUID().String())
+		if err != nil {
+			panic(err)
+		}
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(NetworkPolicyUpdater)
+		cb := b.(NetworkPolicyUpdater)
+
+		t.Equal(ca.Memo, cb.Memo)
+
+		fact := ca.Fact().(NetworkPolicyUpdaterFact)
+		ufact := cb.Fact().(NetworkPolicyUpdaterFact)
+
+		t.Equal(fact.Policy(), ufact.Policy())
+	}
+
+	return t
+}
+
+func TestNetworkPolicyUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testNetworkPolicyUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestNetworkPolicyUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testNetworkPolicyUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,136 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	NetworkPolicyUpdaterFactType = hint.MustNewType(0xa0, 0x6c, "mitum-currency-network-policy-updater-operation-fact")
+	NetworkPolicyUpdaterFactHint = hint.MustHint(NetworkPolicyUpdaterFactType, "0.0.1")
+	NetworkPolicyUpdaterType     = hint.MustNewType(0xa0, 0x6d, "mitum-currency-network-policy-updater-operation")
+	NetworkPolicyUpdaterHint     = hint.MustHint(NetworkPolicyUpdaterType, "0.0.1")
+)
+
+var (
+	NetworkPolicyUpdaterFactExpiringHint   = hint.MustHint(NetworkPolicyUpdaterFactType, "0.0.2")
+	NetworkPolicyUpdaterFactExpiringHinter = NetworkPolicyUpdaterFact{expiringFact: expiringFact{ht: NetworkPolicyUpdaterFactExpiringHint}}
+)
+
+type NetworkPolicyUpdaterFact struct {
+	expiringFact
+	h      valuehash.Hash
+	token  []byte
+	policy NetworkPolicy
+}
+
+func NewNetworkPolicyUpdaterFact(token []byte, policy NetworkPolicy) NetworkPolicyUpdaterFact {
+	fact := NetworkPolicyUpdaterFact{
+		token:  token,
+		policy: policy,
+	}
+
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact NetworkPolicyUpdaterFact) WithExpiry(ex Expiry) NetworkPolicyUpdaterFact {
+	fact.expiringFact = newExpiringFact(NetworkPolicyUpdaterFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact NetworkPolicyUpdaterFact) Hint() hint.Hint {
+	return fact.factHint(NetworkPolicyUpdaterFactHint)
+}
+
+func (fact NetworkPolicyUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact NetworkPolicyUpdaterFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.policy.Bytes(),
+		ext,
+	)
+}
+
+func (fact NetworkPolicyUpdaterFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for NetworkPolicyUpdaterFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.policy,
+	}, nil, false); err != nil {
+		return xerrors.Errorf("invalid fact: %w", err)
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact NetworkPolicyUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact NetworkPolicyUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact NetworkPolicyUpdaterFact) Policy() NetworkPolicy {
+	return fact.policy
+}
+
+// NetworkPolicyUpdater replaces the network policy; it should be signed by the
+// suffrage nodes. The new network policy is applied from the next block.
+type NetworkPolicyUpdater struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewNetworkPolicyUpdater(
+	fact NetworkPolicyUpdaterFact,
+	fs []operation.FactSign,
+	memo string,
+) (NetworkPolicyUpdater, error) {
+	if bo, err := operation.NewBaseOperationFromFact(NetworkPolicyUpdaterHint, fact, fs); err != nil {
+		return NetworkPolicyUpdater{}, err
+	} else {
+		op := NetworkPolicyUpdater{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op NetworkPolicyUpdater) Hint() hint.Hint {
+	return NetworkPolicyUpdaterHint
+}
+
+func (op NetworkPolicyUpdater) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
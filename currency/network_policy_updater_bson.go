@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (fact NetworkPolicyUpdaterFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"hash":   fact.h,
+		"token":  fact.token,
+		"policy": fact.policy,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
+}
+
+type NetworkPolicyUpdaterFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	PO bson.Raw        `bson:"policy"`
+	EX *Expiry         `bson:"expiry,omitempty"`
+}
+
+func (fact *NetworkPolicyUpdaterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact NetworkPolicyUpdaterFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.PO, ufact.EX)
+}
+
+func (op NetworkPolicyUpdater) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *NetworkPolicyUpdater) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = NetworkPolicyUpdater{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
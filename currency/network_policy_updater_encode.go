@@ -0,0 +1,28 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *NetworkPolicyUpdaterFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bpo []byte,
+	ex *Expiry,
+) error {
+	fact.expiringFact = unpackExpiringFact(ht, NetworkPolicyUpdaterFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+
+	if i, err := DecodeNetworkPolicy(enc, bpo); err != nil {
+		return err
+	} else {
+		fact.policy = i
+	}
+
+	return nil
+}
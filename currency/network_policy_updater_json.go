@@ -0,0 +1,78 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type NetworkPolicyUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	PO NetworkPolicy  `json:"policy"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact NetworkPolicyUpdaterFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(NetworkPolicyUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		PO:         fact.policy,
+		EX:         ex,
+	})
+}
+
+type NetworkPolicyUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	TK []byte          `json:"token"`
+	PO json.RawMessage `json:"policy"`
+	EX *Expiry         `json:"expiry,omitempty"`
+}
+
+func (fact *NetworkPolicyUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact NetworkPolicyUpdaterFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.PO, ufact.EX)
+}
+
+func (op NetworkPolicyUpdater) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *NetworkPolicyUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = NetworkPolicyUpdater{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op NetworkPolicyUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type NetworkPolicyUpdaterProcessor struct {
+	NetworkPolicyUpdater
+	suffrageKeys SuffrageKeys
+	height       base.Height
+	st           state.State
+}
+
+func NewNetworkPolicyUpdaterProcessor(
+	suffrageKeys SuffrageKeys,
+) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(NetworkPolicyUpdater); !ok {
+			return nil, xerrors.Errorf("not NetworkPolicyUpdater, %T", op)
+		} else {
+			return &NetworkPolicyUpdaterProcessor{
+				NetworkPolicyUpdater: i,
+				suffrageKeys:         suffrageKeys,
+			}, nil
+		}
+	}
+}
+
+func (opp *NetworkPolicyUpdaterProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *NetworkPolicyUpdaterProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if err := checkFactSignsBySuffrage(opp.suffrageKeys, opp.height, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	if st, _, err := getState(StateKeyNetworkPolicy); err != nil {
+		return nil, err
+	} else {
+		opp.st = st
+	}
+
+	return opp, nil
+}
+
+func (opp *NetworkPolicyUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(NetworkPolicyUpdaterFact)
+
+	if i, err := SetStateNetworkPolicyValue(opp.st, fact.Policy()); err != nil {
+		return err
+	} else {
+		return setState(fact.Hash(), i)
+	}
+}
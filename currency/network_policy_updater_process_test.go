@@ -0,0 +1,205 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testNetworkPolicyUpdaterOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testNetworkPolicyUpdaterOperations) newOperation(keys []key.Privatekey, po NetworkPolicy) NetworkPolicyUpdater {
+	fact := NewNetworkPolicyUpdaterFact(util.UUID().Bytes(), po)
+
+	var fs []operation.FactSign
+	for _, pk := range keys {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewNetworkPolicyUpdater(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testNetworkPolicyUpdaterOperations) processor(n int) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.MustNewBTCPrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	opr := NewOperationProcessor(nil)
+	_, err = opr.SetProcessor(NetworkPolicyUpdater{}, NewNetworkPolicyUpdaterProcessor(NewFixedSuffrageKeys(pubs, threshold)))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testNetworkPolicyUpdaterOperations) TestNew() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+
+	opr := copr.New(pool)
+
+	po := NewNetworkPolicy(8, 50, 5, 1, 100, 1, 100)
+	op := t.newOperation(privs, po)
+	t.NoError(opr.Process(op))
+
+	var upo NetworkPolicy
+	for _, st := range pool.Updates() {
+		if st.Key() != StateKeyNetworkPolicy {
+			continue
+		}
+
+		i, err := StateNetworkPolicyValue(st.GetState())
+		t.NoError(err)
+
+		upo = i
+	}
+
+	t.Equal(po, upo)
+}
+
+func (t *testNetworkPolicyUpdaterOperations) TestNotEnoughSigns() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+
+	opr := copr.New(pool)
+
+	op := t.newOperation(privs[:2], NewNetworkPolicy(8, 50, 5, 1, 100, 1, 100))
+
+	err := opr.Process(op)
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testNetworkPolicyUpdaterOperations) TestDuplicated() {
+	privs, copr := t.processor(3)
+
+	pool, _ := t.statepool()
+
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newOperation(privs, NewNetworkPolicy(8, 50, 5, 1, 100, 1, 100))))
+
+	err := opr.Process(t.newOperation(privs, NewNetworkPolicy(9, 50, 5, 1, 100, 1, 100)))
+	t.Contains(err.Error(), "duplicated network policy")
+}
+
+func (t *testNetworkPolicyUpdaterOperations) TestAppliedFromState() {
+	cid := CurrencyID("SHOWME")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), cid)})
+	rb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), cid)})
+
+	st, err := state.NewStateV0(StateKeyNetworkPolicy, nil, base.Height(33))
+	t.NoError(err)
+
+	pst, err := SetStateNetworkPolicyValue(st, NewNetworkPolicy(1, 50, 5, 1, 100, 1, 100))
+	t.NoError(err)
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{pst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	opr := copr.New(pool)
+
+	newTransfers := func(receivers ...base.Address) Transfers {
+		items := make([]TransfersItem, len(receivers))
+		for i := range receivers {
+			items[i] = NewTransfersItemSingleAmount(receivers[i], NewAmount(NewBig(1), cid))
+		}
+
+		fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items)
+		sig, err := operation.NewFactSignature(sa.Priv, fact, nil)
+		t.NoError(err)
+
+		op, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(sa.Priv.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	err = opr.Process(newTransfers(ra.Address, rb.Address))
+	t.Contains(err.Error(), "items, 2 over max, 1")
+
+	t.NoError(opr.Process(newTransfers(ra.Address)))
+}
+
+func (t *testNetworkPolicyUpdaterOperations) TestRaisedFromState() {
+	cid := CurrencyID("SHOWME")
+
+	n := int(DefaultMaxTransferItems) + 2
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(33), cid)})
+
+	receivers := make([]base.Address, n)
+	for i := range receivers {
+		ra, st := t.newAccount(true, []Amount{NewAmount(NewBig(1), cid)})
+		receivers[i] = ra.Address
+		sts = append(sts, st...)
+	}
+
+	st, err := state.NewStateV0(StateKeyNetworkPolicy, nil, base.Height(33))
+	t.NoError(err)
+
+	pst, err := SetStateNetworkPolicyValue(st, NewNetworkPolicy(uint(n), 50, 5, 1, 100, 1, 100))
+	t.NoError(err)
+
+	pool, _ := t.statepool(sts, []state.State{pst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	copr, err := NewOperationProcessor(cp).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	opr := copr.New(pool)
+
+	items := make([]TransfersItem, n)
+	for i := range receivers {
+		items[i] = NewTransfersItemSingleAmount(receivers[i], NewAmount(NewBig(1), cid))
+	}
+
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items)
+	sig, err := operation.NewFactSignature(sa.Priv, fact, nil)
+	t.NoError(err)
+
+	op, err := NewTransfers(fact, []operation.FactSign{operation.NewBaseFactSign(sa.Priv.Publickey(), sig)}, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	// NOTE over DefaultNetworkPolicy, but allowed by the network policy state
+	t.Error(DefaultNetworkPolicy.CheckOperation(op))
+	t.NoError(opr.Process(op))
+}
+
+func TestNetworkPolicyUpdaterOperations(t *testing.T) {
+	suite.Run(t, new(testNetworkPolicyUpdaterOperations))
+}
@@ -26,14 +26,42 @@ func (t *baseTestEncode) SetupSuite() {
 
 	t.encs.AddHinter(key.BTCPublickeyHinter)
 	t.encs.AddHinter(Address(""))
+	t.encs.AddHinter(AddressChecksumHinter)
 	t.encs.AddHinter(operation.BaseFactSign{})
 	t.encs.AddHinter(Key{})
 	t.encs.AddHinter(Keys{})
+	t.encs.AddHinter(KeysWithThresholdsHinter)
 	t.encs.AddHinter(TransfersFact{})
 	t.encs.AddHinter(Transfers{})
 	t.encs.AddHinter(CreateAccountsFact{})
 	t.encs.AddHinter(CreateAccounts{})
 	t.encs.AddHinter(KeyUpdaterFact{})
+	t.encs.AddHinter(KeyUpdaterFactStrictHinter)
+	t.encs.AddHinter(TransfersFactExpiringHinter)
+	t.encs.AddHinter(CreateAccountsFactExpiringHinter)
+	t.encs.AddHinter(KeyUpdaterFactExpiringHinter)
+	t.encs.AddHinter(AccountDataUpdaterFactExpiringHinter)
+	t.encs.AddHinter(AccountMergeFactExpiringHinter)
+	t.encs.AddHinter(AccountPolicyUpdaterFactExpiringHinter)
+	t.encs.AddHinter(AliasRegisterFactExpiringHinter)
+	t.encs.AddHinter(AliasReleaseFactExpiringHinter)
+	t.encs.AddHinter(AliasTransferFactExpiringHinter)
+	t.encs.AddHinter(ApproveFactExpiringHinter)
+	t.encs.AddHinter(BatchFactExpiringHinter)
+	t.encs.AddHinter(ClaimBalanceFactExpiringHinter)
+	t.encs.AddHinter(CreateClaimableBalanceFactExpiringHinter)
+	t.encs.AddHinter(CurrencyPolicyUpdaterFactExpiringHinter)
+	t.encs.AddHinter(CurrencyRegisterFactExpiringHinter)
+	t.encs.AddHinter(GuardiansUpdaterFactExpiringHinter)
+	t.encs.AddHinter(KeyRecoveryFactExpiringHinter)
+	t.encs.AddHinter(KeyRecoveryCancelerFactExpiringHinter)
+	t.encs.AddHinter(MultiTransfersFactExpiringHinter)
+	t.encs.AddHinter(NetworkPolicyUpdaterFactExpiringHinter)
+	t.encs.AddHinter(ReclaimBalanceFactExpiringHinter)
+	t.encs.AddHinter(TransferFromFactExpiringHinter)
+	t.encs.AddHinter(TransfersFactSequencedHinter)
+	t.encs.AddHinter(CreateAccountsFactSequencedHinter)
+	t.encs.AddHinter(KeyUpdaterFactSequencedHinter)
 	t.encs.AddHinter(KeyUpdater{})
 	t.encs.AddHinter(FeeOperationFact{})
 	t.encs.AddHinter(FeeOperation{})
@@ -43,6 +71,7 @@ func (t *baseTestEncode) SetupSuite() {
 	t.encs.AddHinter(Amount{})
 	t.encs.AddHinter(CreateAccountsItemMultiAmountsHinter)
 	t.encs.AddHinter(CreateAccountsItemSingleAmountHinter)
+	t.encs.AddHinter(CreateAccountsItemSaltedHinter)
 	t.encs.AddHinter(TransfersItemMultiAmountsHinter)
 	t.encs.AddHinter(TransfersItemSingleAmountHinter)
 	t.encs.AddHinter(CurrencyRegisterFact{})
@@ -51,9 +80,60 @@ func (t *baseTestEncode) SetupSuite() {
 	t.encs.AddHinter(NilFeeer{})
 	t.encs.AddHinter(FixedFeeer{})
 	t.encs.AddHinter(RatioFeeer{})
+	t.encs.AddHinter(DynamicFeeer{})
 	t.encs.AddHinter(CurrencyPolicyUpdaterFact{})
+	t.encs.AddHinter(CurrencyPolicyUpdaterFactScheduledHinter)
 	t.encs.AddHinter(CurrencyPolicyUpdater{})
 	t.encs.AddHinter(CurrencyPolicy{})
+	t.encs.AddHinter(CurrencyPolicyMinBalanceHinter)
+	t.encs.AddHinter(CurrencyPolicyTransferLimitsHinter)
+	t.encs.AddHinter(PendingCurrencyPolicy{})
+	t.encs.AddHinter(NetworkPolicyUpdaterFact{})
+	t.encs.AddHinter(NetworkPolicyUpdater{})
+	t.encs.AddHinter(NetworkPolicy{})
+	t.encs.AddHinter(CreateClaimableBalanceFact{})
+	t.encs.AddHinter(CreateClaimableBalance{})
+	t.encs.AddHinter(ClaimBalanceFact{})
+	t.encs.AddHinter(ClaimBalance{})
+	t.encs.AddHinter(ReclaimBalanceFact{})
+	t.encs.AddHinter(ReclaimBalance{})
+	t.encs.AddHinter(ClaimableBalance{})
+	t.encs.AddHinter(Allowance{})
+	t.encs.AddHinter(ApproveFact{})
+	t.encs.AddHinter(Approve{})
+	t.encs.AddHinter(TransferFromFact{})
+	t.encs.AddHinter(TransferFrom{})
+	t.encs.AddHinter(MultiTransfersFact{})
+	t.encs.AddHinter(MultiTransfersSender{})
+	t.encs.AddHinter(MultiTransfers{})
+	t.encs.AddHinter(BatchFact{})
+	t.encs.AddHinter(Batch{})
+	t.encs.AddHinter(AccountClosed{})
+	t.encs.AddHinter(AccountMergeFact{})
+	t.encs.AddHinter(AccountMerge{})
+	t.encs.AddHinter(Guardians{})
+	t.encs.AddHinter(Recovery{})
+	t.encs.AddHinter(GuardiansUpdaterFact{})
+	t.encs.AddHinter(GuardiansUpdater{})
+	t.encs.AddHinter(KeyRecoveryFact{})
+	t.encs.AddHinter(KeyRecovery{})
+	t.encs.AddHinter(KeyRecoveryCancelerFact{})
+	t.encs.AddHinter(KeyRecoveryCanceler{})
+	t.encs.AddHinter(AccountPolicy{})
+	t.encs.AddHinter(AccountSpent{})
+	t.encs.AddHinter(PendingAccountPolicy{})
+	t.encs.AddHinter(AccountPolicyUpdaterFact{})
+	t.encs.AddHinter(AccountPolicyUpdater{})
+	t.encs.AddHinter(Alias{})
+	t.encs.AddHinter(AliasRegisterFact{})
+	t.encs.AddHinter(AliasRegister{})
+	t.encs.AddHinter(AliasTransferFact{})
+	t.encs.AddHinter(AliasTransfer{})
+	t.encs.AddHinter(AliasReleaseFact{})
+	t.encs.AddHinter(AliasRelease{})
+	t.encs.AddHinter(AccountData{})
+	t.encs.AddHinter(AccountDataUpdaterFact{})
+	t.encs.AddHinter(AccountDataUpdater{})
 }
 
 func (t *baseTestEncode) TestEncode() {
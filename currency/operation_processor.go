@@ -2,8 +2,10 @@ package currency
 
 import (
 	"sync"
+	"time"
 
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/block"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/prprocessor"
 	"github.com/spikeekips/mitum/base/state"
@@ -19,10 +21,38 @@ type GetNewProcessor func(state.Processor) (state.Processor, error)
 type DuplicationType string
 
 const (
-	DuplicationTypeSender   DuplicationType = "sender"
-	DuplicationTypeCurrency DuplicationType = "currency"
+	DuplicationTypeSender    DuplicationType = "sender"
+	DuplicationTypeCurrency  DuplicationType = "currency"
+	DuplicationTypeClaimable DuplicationType = "claimable"
+	DuplicationTypeAlias     DuplicationType = "alias"
+	DuplicationTypeNetwork   DuplicationType = "network"
 )
 
+// proposalHeightSetter is implemented by the processors, which need the
+// height of the current proposal.
+type proposalHeightSetter interface {
+	setProposalHeight(base.Height)
+}
+
+// currencyPoolSetter is implemented by the processors, which need the currency
+// designs; the CurrencyPool in effect at the height of the current proposal is
+// set.
+type currencyPoolSetter interface {
+	setCurrencyPool(*CurrencyPool)
+}
+
+// networkPolicySetter is implemented by the processors, which need the network
+// policy; the network policy of the last block is set.
+type networkPolicySetter interface {
+	setNetworkPolicy(NetworkPolicy)
+}
+
+// subProcessorsSetter is implemented by the processors, which process the
+// other operations inside, like Batch.
+type subProcessorsSetter interface {
+	setSubProcessors(func(state.Processor) (state.Processor, bool, error))
+}
+
 type OperationProcessor struct {
 	sync.RWMutex
 	*logging.Logging
@@ -30,9 +60,25 @@ type OperationProcessor struct {
 	cp                   *CurrencyPool
 	pool                 *storage.Statepool
 	fee                  map[CurrencyID]Big
+	used                 map[CurrencyID]uint
 	amountPool           map[string]AmountState
 	duplicated           map[string]DuplicationType
 	duplicatedNewAddress map[string]struct{}
+	facts                map[string]struct{}
+	lastManifest         func() (block.Manifest, bool, error)
+	hasOperationFact     func(valuehash.Hash) (bool, error)
+	networkPolicy        *NetworkPolicy
+	confirmedAt          *time.Time
+	stateLock            sync.RWMutex
+	states               *batchStatepool
+	versions             map[string]uint64
+	version              uint64
+	last                 *pendingOperation
+	canceled             chan struct{}
+	cancelOnce           sync.Once
+	closeOnce            sync.Once
+	closeErr             error
+	current              *OperationProcessor
 }
 
 func NewOperationProcessor(cp *CurrencyPool) *OperationProcessor {
@@ -46,18 +92,63 @@ func NewOperationProcessor(cp *CurrencyPool) *OperationProcessor {
 }
 
 func (opr *OperationProcessor) New(pool *storage.Statepool) prprocessor.OperationProcessor {
-	return &OperationProcessor{
+	opr.Lock()
+	defer opr.Unlock()
+
+	// NOTE ConcurrentOperationsProcessor asks New by each type of operations;
+	// the operations of one proposal share the same OperationProcessor, so the
+	// order of operations and the fees of block are kept in one place.
+	if opr.current != nil && opr.current.pool == pool {
+		return opr.current
+	}
+
+	// NOTE the currency designs of the past proposal can be different with
+	// the last ones.
+	cp := opr.cp
+	if cp != nil {
+		cp = cp.At(pool.Height())
+	}
+
+	opr.current = &OperationProcessor{
 		Logging: logging.NewLogging(func(c logging.Context) logging.Emitter {
 			return c.Str("module", "mitum-currency-operations-processor")
 		}),
 		processorHintSet:     opr.processorHintSet,
-		cp:                   opr.cp,
+		cp:                   cp,
 		pool:                 pool,
 		fee:                  map[CurrencyID]Big{},
+		used:                 map[CurrencyID]uint{},
 		amountPool:           map[string]AmountState{},
 		duplicated:           map[string]DuplicationType{},
 		duplicatedNewAddress: map[string]struct{}{},
+		facts:                map[string]struct{}{},
+		lastManifest:         opr.lastManifest,
+		hasOperationFact:     opr.hasOperationFact,
+		states:               newBatchStatepool(pool.Get),
+		versions:             map[string]uint64{},
+		canceled:             make(chan struct{}),
 	}
+
+	return opr.current
+}
+
+// SetLastManifest sets the function to get the last block manifest. The
+// confirmed time of the last block is used to check the time of Expiry; without
+// it, only the height of Expiry is checked.
+func (opr *OperationProcessor) SetLastManifest(f func() (block.Manifest, bool, error)) *OperationProcessor {
+	opr.lastManifest = f
+
+	return opr
+}
+
+// SetHasOperationFact sets the function to check the operation is already
+// stored. The known operation is rejected by PreProcess; the operation, which is
+// known after PreProcess, is dropped by the work filter, so the next operations
+// do not wait for it.
+func (opr *OperationProcessor) SetHasOperationFact(f func(valuehash.Hash) (bool, error)) *OperationProcessor {
+	opr.hasOperationFact = f
+
+	return opr
 }
 
 func (opr *OperationProcessor) SetProcessor(
@@ -71,12 +162,15 @@ func (opr *OperationProcessor) SetProcessor(
 	}
 }
 
-func (opr *OperationProcessor) setState(op valuehash.Hash, sts ...state.State) error {
+func (opr *OperationProcessor) setState(fact base.Fact, sts ...state.State) error {
 	opr.Lock()
 	defer opr.Unlock()
 
+	used := map[CurrencyID]struct{}{}
 	for i := range sts {
 		if t, ok := sts[i].(AmountState); ok {
+			used[t.Currency()] = struct{}{}
+
 			if t.Fee().OverZero() {
 				var f Big = ZeroBig
 				if i, found := opr.fee[t.Currency()]; found {
@@ -88,43 +182,124 @@ func (opr *OperationProcessor) setState(op valuehash.Hash, sts ...state.State) e
 		}
 	}
 
-	return opr.pool.Set(op, sts...)
+	if len(used) > 0 {
+		_, items := countFact(fact)
+		for cid := range used {
+			opr.used[cid] += items
+		}
+	}
+
+	return opr.pool.Set(fact.Hash(), sts...)
 }
 
+// PreProcess checks the operation and puts it in the order of proposal; the
+// operation is processed by Process.
 func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor, error) {
-	var sp state.Processor
-	switch i, known, err := opr.getNewProcessor(op); {
+	switch _, known, err := opr.getNewProcessor(op); {
 	case err != nil:
 		return nil, operation.NewBaseReasonErrorFromError(err)
 	case !known:
 		return op, nil
-	default:
-		sp = i
 	}
 
-	var pop state.Processor
-	if pr, err := sp.(state.PreProcessor).PreProcess(opr.pool.Get, opr.setState); err != nil {
-		return nil, err
+	var o operation.Operation
+	if i, ok := op.(operation.Operation); !ok {
+		return nil, operation.NewBaseReasonError("not operation, %T", op)
 	} else {
-		pop = pr
+		o = i
+	}
+
+	if opr.hasOperationFact != nil {
+		hs := operationFactHashes(o.Fact())
+		for i := range hs {
+			switch found, err := opr.hasOperationFact(hs[i]); {
+			case err != nil:
+				return nil, err
+			case found:
+				return nil, operation.NewBaseReasonError("known operation, %s", hs[i])
+			}
+		}
+	}
+
+	if err := opr.checkExpiry(o.Fact()); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	opr.Lock()
+	defer opr.Unlock()
+
+	if err := opr.checkNetworkPolicy(o); err != nil {
+		return nil, err
+	}
+
+	po := &pendingOperation{Operation: o, previous: opr.last, done: make(chan struct{})}
+	opr.last = po
+
+	return po, nil
+}
+
+// checkNetworkPolicy checks the operation with the network policy of the last
+// block; the network policy updated in this block is applied from the next
+// block.
+func (opr *OperationProcessor) checkNetworkPolicy(op operation.Operation) error {
+	if opr.networkPolicy == nil {
+		if po, err := opr.loadNetworkPolicy(); err != nil {
+			return err
+		} else {
+			opr.networkPolicy = &po
+		}
+	}
+
+	if err := opr.networkPolicy.CheckOperation(op); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
 	}
 
-	if err := opr.checkDuplication(op); err != nil {
-		return nil, operation.NewBaseReasonError("duplication found: %w", err)
+	return nil
+}
+
+// loadNetworkPolicy returns the network policy of the last block; without the
+// network policy state, DefaultNetworkPolicy is returned.
+func (opr *OperationProcessor) loadNetworkPolicy() (NetworkPolicy, error) {
+	if opr.networkPolicy != nil {
+		return *opr.networkPolicy, nil
 	}
 
-	return pop, nil
+	switch st, found, err := opr.pool.Get(StateKeyNetworkPolicy); {
+	case err != nil:
+		return NetworkPolicy{}, err
+	case found:
+		return StateNetworkPolicyValue(st)
+	default:
+		return DefaultNetworkPolicy, nil
+	}
 }
 
 func (opr *OperationProcessor) Process(op state.Processor) error {
 	switch op.(type) {
-	case *TransfersProcessor,
-		*CreateAccountsProcessor,
-		*KeyUpdaterProcessor,
-		*CurrencyRegisterProcessor,
-		*CurrencyPolicyUpdaterProcessor:
+	case *pendingOperation:
 		return opr.process(op)
-	case Transfers, CreateAccounts, KeyUpdater, CurrencyRegister, CurrencyPolicyUpdater:
+	case Transfers,
+		CreateAccounts,
+		KeyUpdater,
+		CurrencyRegister,
+		CurrencyPolicyUpdater,
+		NetworkPolicyUpdater,
+		CreateClaimableBalance,
+		ClaimBalance,
+		ReclaimBalance,
+		Approve,
+		TransferFrom,
+		MultiTransfers,
+		Batch,
+		AccountMerge,
+		GuardiansUpdater,
+		KeyRecovery,
+		KeyRecoveryCanceler,
+		AccountPolicyUpdater,
+		AliasRegister,
+		AliasTransfer,
+		AliasRelease,
+		AccountDataUpdater:
 		if pr, err := opr.PreProcess(op); err != nil {
 			return err
 		} else {
@@ -135,76 +310,576 @@ func (opr *OperationProcessor) Process(op state.Processor) error {
 	}
 }
 
+// process processes the operation against the states of the previous
+// operations without waiting them, so the independent operations are processed
+// concurrently. The result is committed in the order of proposal; if the states,
+// which the operation read, are updated by the previous operations, the
+// operation is processed again.
 func (opr *OperationProcessor) process(op state.Processor) error {
+	po, ok := op.(*pendingOperation)
+	if !ok {
+		return op.Process(opr.pool.Get, opr.pool.Set)
+	}
+
+	defer po.close()
+
+	r := opr.run(po.Operation)
+
+	if err := opr.waitPrevious(po); err != nil {
+		return err
+	}
+
+	return opr.commit(po.Operation, r)
+}
+
+// waitPrevious waits until the previous operation is committed. The operation
+// dropped by the work filter of ConcurrentOperationsProcessor after PreProcess
+// is never processed, so it is closed and the one before it is waited.
+func (opr *OperationProcessor) waitPrevious(po *pendingOperation) error {
+	for prev := po.previous; prev != nil; prev = prev.previous {
+		if opr.isFiltered(prev) {
+			defer prev.close()
+
+			continue
+		}
+
+		select {
+		case <-prev.done:
+			return nil
+		case <-opr.canceled:
+			return xerrors.Errorf("operation processor canceled")
+		}
+	}
+
+	return nil
+}
+
+// isFiltered checks the operation is dropped by the work filter, which drops
+// the known operation and the operation failed to be checked.
+func (opr *OperationProcessor) isFiltered(op operation.Operation) bool {
+	if opr.hasOperationFact == nil {
+		return false
+	}
+
+	found, err := opr.hasOperationFact(op.Fact().Hash())
+
+	return err != nil || found
+}
+
+// processResult is the result of operation, processed against the state view of
+// proposal. reads has the versions of the states, which are read.
+type processResult struct {
+	reads map[string]uint64
+	sts   []state.State
+	err   error
+}
+
+func (opr *OperationProcessor) run(op operation.Operation) processResult {
+	r := processResult{reads: map[string]uint64{}}
+
+	getState := func(key string) (state.State, bool, error) {
+		opr.stateLock.RLock()
+		defer opr.stateLock.RUnlock()
+
+		if _, found := r.reads[key]; !found {
+			r.reads[key] = opr.versions[key]
+		}
+
+		return opr.states.get(key)
+	}
+
+	bp := newBatchStatepool(getState)
+	if err := opr.runProcessor(op, bp); err != nil {
+		r.err = err
+	} else {
+		r.sts = bp.updates()
+	}
+
+	return r
+}
+
+func (opr *OperationProcessor) runProcessor(op operation.Operation, bp *batchStatepool) error {
 	var sp state.Processor
+	switch i, _, err := opr.getNewProcessor(op.(state.Processor)); {
+	case err != nil:
+		return operation.NewBaseReasonErrorFromError(err)
+	default:
+		sp = i
+	}
 
-	switch t := op.(type) {
-	case *TransfersProcessor:
-		sp = t
-	case *CreateAccountsProcessor:
-		sp = t
-	case *KeyUpdaterProcessor:
-		sp = t
+	if err := opr.checkSequence(op.Fact(), bp); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if i, ok := sp.(proposalHeightSetter); ok {
+		i.setProposalHeight(opr.pool.Height())
+	}
+
+	if i, ok := sp.(subProcessorsSetter); ok {
+		i.setSubProcessors(opr.getNewProcessor)
+	}
+
+	if pr, err := sp.(state.PreProcessor).PreProcess(bp.get, bp.set); err != nil {
+		return err
+	} else {
+		return pr.Process(bp.get, bp.set)
+	}
+}
+
+// isConflicted checks the states, which are read, are updated after reading.
+func (opr *OperationProcessor) isConflicted(r processResult) bool {
+	opr.stateLock.RLock()
+	defer opr.stateLock.RUnlock()
+
+	for k := range r.reads {
+		if opr.versions[k] != r.reads[k] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// commit sets the states of operation to the state view and the Statepool. The
+// previous operations of proposal are already committed.
+func (opr *OperationProcessor) commit(op operation.Operation, r processResult) error {
+	var d duplication
+	if i, err := opr.checkDuplication(op.(state.Processor)); err != nil {
+		return operation.NewBaseReasonError("duplication found: %w", err)
+	} else {
+		d = i
+	}
+
+	if err := opr.checkFactDuplication(op.Fact()); err != nil {
+		return operation.NewBaseReasonError("duplication found: %w", err)
+	}
+
+	// NOTE the conflicted operation is processed again in order.
+	if opr.isConflicted(r) {
+		r = opr.run(op)
+	}
+
+	if r.err != nil {
+		return r.err
+	}
+
+	fact := op.Fact()
+
+	if err := func() error {
+		opr.stateLock.Lock()
+		defer opr.stateLock.Unlock()
+
+		if err := opr.states.set(fact.Hash(), r.sts...); err != nil {
+			return err
+		}
+
+		opr.version++
+		for i := range r.sts {
+			opr.versions[r.sts[i].Key()] = opr.version
+		}
+
+		return nil
+	}(); err != nil {
+		return err
+	}
+
+	opr.setDuplication(d)
+	opr.setFacts(fact)
+
+	if err := opr.setState(fact, r.sts...); err != nil {
+		return err
+	}
+
+	// NOTE the operations in Batch are added to the block, so they can not be
+	// processed again by themselves.
+	if i, ok := fact.(BatchFact); ok {
+		opr.pool.AddOperations(i.Operations()...)
+	}
+
+	return nil
+}
+
+// operationFactHashes returns the fact hash of operation; for Batch, the fact
+// hashes of the operations inside are also returned, so they can not be
+// replayed by wrapping them in the new Batch.
+func operationFactHashes(fact base.Fact) []valuehash.Hash {
+	hs := []valuehash.Hash{fact.Hash()}
+	if i, ok := fact.(BatchFact); ok {
+		ops := i.Operations()
+		for j := range ops {
+			hs = append(hs, ops[j].Fact().Hash())
+		}
+	}
+
+	return hs
+}
+
+// checkFactDuplication prevents the operation from being processed again in
+// one proposal, by itself or inside Batch.
+func (opr *OperationProcessor) checkFactDuplication(fact base.Fact) error {
+	opr.RLock()
+	defer opr.RUnlock()
+
+	hs := operationFactHashes(fact)
+	for i := range hs {
+		if _, found := opr.facts[hs[i].String()]; found {
+			return xerrors.Errorf("operation, %s already processed in proposal", hs[i])
+		}
+	}
+
+	return nil
+}
+
+func (opr *OperationProcessor) setFacts(fact base.Fact) {
+	opr.Lock()
+	defer opr.Unlock()
+
+	hs := operationFactHashes(fact)
+	for i := range hs {
+		opr.facts[hs[i].String()] = struct{}{}
+	}
+}
+
+// checkExpiry rejects the expired operation; every fact, which carries Expiry,
+// is checked by ExpiringFact. The operations inside Batch are also checked.
+func (opr *OperationProcessor) checkExpiry(fact base.Fact) error {
+	if i, ok := fact.(BatchFact); ok {
+		ops := i.Operations()
+		for j := range ops {
+			if err := opr.checkExpiry(ops[j].Fact()); err != nil {
+				return err
+			}
+		}
+	}
+
+	var ex Expiry
+	if i, ok := fact.(ExpiringFact); !ok {
+		return nil
+	} else if ex = i.Expiry(); ex.IsEmpty() {
+		return nil
+	}
+
+	if t, err := opr.lastConfirmedAt(); err != nil {
+		return err
+	} else {
+		return ex.IsExpired(opr.pool.Height(), t)
+	}
+}
+
+func (opr *OperationProcessor) lastConfirmedAt() (time.Time, error) {
+	opr.Lock()
+	defer opr.Unlock()
+
+	if opr.confirmedAt != nil {
+		return *opr.confirmedAt, nil
+	}
+
+	var t time.Time
+	if opr.lastManifest != nil {
+		switch m, found, err := opr.lastManifest(); {
+		case err != nil:
+			return time.Time{}, err
+		case found:
+			t = m.ConfirmedAt()
+		}
+	}
+
+	opr.confirmedAt = &t
+
+	return t, nil
+}
+
+// checkSequence checks the sequence of SequencedFact is the next of the last
+// sequence of account and sets the new sequence to the state view; the
+// operations inside Batch are also checked.
+func (opr *OperationProcessor) checkSequence(fact base.Fact, bp *batchStatepool) error {
+	if i, ok := fact.(BatchFact); ok {
+		ops := i.Operations()
+		for j := range ops {
+			if err := opr.checkSequence(ops[j].Fact(), bp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var sf SequencedFact
+	if i, ok := fact.(SequencedFact); !ok || !i.IsSequenced() {
+		return nil
+	} else {
+		sf = i
+	}
+
+	var st state.State
+	if i, _, err := bp.get(StateKeySequence(sf.SequenceAccount())); err != nil {
+		return err
+	} else {
+		st = i
+	}
+
+	if last, err := StateSequenceValue(st); err != nil {
+		return err
+	} else if sf.Sequence() != last+1 {
+		return xerrors.Errorf("wrong sequence of %s; expected=%d != %d", sf.SequenceAccount(), last+1, sf.Sequence())
+	}
+
+	if nst, err := SetStateSequenceValue(st, sf.Sequence()); err != nil {
+		return err
+	} else {
+		return bp.set(fact.Hash(), nst)
+	}
+}
+
+type duplication struct {
+	did          string
+	didtype      DuplicationType
+	newAddresses []base.Address
+	claimables   []base.Address
+	aliases      []AliasName
+}
+
+// checkDuplication checks the duplications of operation in proposal; the
+// duplications are not counted until setDuplication.
+func (opr *OperationProcessor) checkDuplication(op state.Processor) (duplication, error) {
+	opr.RLock()
+	defer opr.RUnlock()
+
+	var d duplication
+	switch i, ok, err := duplicationOf(op); {
+	case err != nil:
+		return d, err
+	case !ok:
+		return d, nil
 	default:
-		return op.Process(opr.pool.Get, opr.pool.Set)
+		d = i
+	}
+
+	// NOTE the operations of same sender are processed in order against the
+	// state view of proposal, so sender can be duplicated.
+	if len(d.did) > 0 && d.didtype != DuplicationTypeSender {
+		if _, found := opr.duplicated[d.did]; found {
+			switch d.didtype {
+			case DuplicationTypeCurrency:
+				return d, xerrors.Errorf("duplicated currency id, %q found in proposal", d.did)
+			case DuplicationTypeNetwork:
+				return d, xerrors.Errorf("duplicated network policy found in proposal")
+			default:
+				return d, xerrors.Errorf("violates duplication in proposal")
+			}
+		}
+	}
+
+	if err := opr.checkNewAddressDuplication(d.newAddresses); err != nil {
+		return d, err
+	}
+
+	if err := opr.checkClaimableDuplication(d.claimables); err != nil {
+		return d, err
+	}
+
+	if err := opr.checkAliasDuplication(d.aliases); err != nil {
+		return d, err
 	}
 
-	return sp.Process(opr.pool.Get, opr.setState)
+	return d, nil
 }
 
-func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
+func (opr *OperationProcessor) setDuplication(d duplication) {
 	opr.Lock()
 	defer opr.Unlock()
 
-	var did string
-	var didtype DuplicationType
-	var newAddresses []base.Address
+	if len(d.did) > 0 && d.didtype != DuplicationTypeSender {
+		opr.duplicated[d.did] = d.didtype
+	}
+
+	for i := range d.newAddresses {
+		opr.duplicatedNewAddress[d.newAddresses[i].String()] = struct{}{}
+	}
+
+	for i := range d.claimables {
+		opr.duplicated[StateKeyClaimableBalance(d.claimables[i])] = DuplicationTypeClaimable
+	}
+
+	for i := range d.aliases {
+		opr.duplicated[StateKeyAlias(d.aliases[i])] = DuplicationTypeAlias
+	}
+}
+
+func duplicationOf(op state.Processor) (duplication, bool, error) { // nolint:gocyclo
+	var d duplication
 
 	switch t := op.(type) {
 	case Transfers:
-		did = t.Fact().(TransfersFact).Sender().String()
-		didtype = DuplicationTypeSender
+		d.did = t.Fact().(TransfersFact).Sender().String()
+		d.didtype = DuplicationTypeSender
 	case CreateAccounts:
 		fact := t.Fact().(CreateAccountsFact)
 		if as, err := fact.Targets(); err != nil {
-			return xerrors.Errorf("failed to get Addresses")
+			return d, false, xerrors.Errorf("failed to get Addresses")
 		} else {
-			newAddresses = as
+			d.newAddresses = as
+			d.claimables = as
 		}
 
-		did = fact.Sender().String()
-		didtype = DuplicationTypeSender
+		d.did = fact.Sender().String()
+		d.didtype = DuplicationTypeSender
 	case KeyUpdater:
-		did = t.Fact().(KeyUpdaterFact).Target().String()
-		didtype = DuplicationTypeSender
+		d.did = t.Fact().(KeyUpdaterFact).Target().String()
+		d.didtype = DuplicationTypeSender
 	case CurrencyRegister:
-		did = t.Fact().(CurrencyRegisterFact).Currency().Currency().String()
-		didtype = DuplicationTypeCurrency
+		d.did = t.Fact().(CurrencyRegisterFact).Currency().Currency().String()
+		d.didtype = DuplicationTypeCurrency
 	case CurrencyPolicyUpdater:
-		did = t.Fact().(CurrencyPolicyUpdaterFact).Currency().String()
-		didtype = DuplicationTypeCurrency
+		d.did = t.Fact().(CurrencyPolicyUpdaterFact).Currency().String()
+		d.didtype = DuplicationTypeCurrency
+	case NetworkPolicyUpdater:
+		d.did = StateKeyNetworkPolicy
+		d.didtype = DuplicationTypeNetwork
+	case CreateClaimableBalance:
+		fact := t.Fact().(CreateClaimableBalanceFact)
+		if a, err := fact.Target(); err != nil {
+			return d, false, xerrors.Errorf("failed to get target")
+		} else {
+			d.claimables = []base.Address{a}
+		}
+
+		d.did = fact.Sender().String()
+		d.didtype = DuplicationTypeSender
+	case ClaimBalance:
+		fact := t.Fact().(ClaimBalanceFact)
+		if a, err := fact.Owner(); err != nil {
+			return d, false, xerrors.Errorf("failed to get owner")
+		} else {
+			d.newAddresses = []base.Address{a}
+			d.claimables = []base.Address{a}
+
+			d.did = a.String()
+		}
+
+		d.didtype = DuplicationTypeSender
+	case ReclaimBalance:
+		fact := t.Fact().(ReclaimBalanceFact)
+		d.claimables = []base.Address{fact.Target()}
+
+		d.did = fact.Sender().String()
+		d.didtype = DuplicationTypeSender
+	case Approve:
+		d.did = t.Fact().(ApproveFact).Owner().String()
+		d.didtype = DuplicationTypeSender
+	case TransferFrom:
+		d.did = t.Fact().(TransferFromFact).Spender().String()
+		d.didtype = DuplicationTypeSender
+	case MultiTransfers:
+		d.did = t.Fact().(MultiTransfersFact).Senders()[0].Sender().String()
+		d.didtype = DuplicationTypeSender
+	case AccountMerge:
+		d.did = t.Fact().(AccountMergeFact).Sender().String()
+		d.didtype = DuplicationTypeSender
+	case GuardiansUpdater:
+		d.did = t.Fact().(GuardiansUpdaterFact).Target().String()
+		d.didtype = DuplicationTypeSender
+	case KeyRecovery:
+		d.did = t.Fact().(KeyRecoveryFact).Target().String()
+		d.didtype = DuplicationTypeSender
+	case KeyRecoveryCanceler:
+		d.did = t.Fact().(KeyRecoveryCancelerFact).Target().String()
+		d.didtype = DuplicationTypeSender
+	case AccountPolicyUpdater:
+		d.did = t.Fact().(AccountPolicyUpdaterFact).Target().String()
+		d.didtype = DuplicationTypeSender
+	case AliasRegister:
+		fact := t.Fact().(AliasRegisterFact)
+		d.aliases = []AliasName{fact.Name()}
+
+		d.did = fact.Sender().String()
+		d.didtype = DuplicationTypeSender
+	case AliasTransfer:
+		fact := t.Fact().(AliasTransferFact)
+		d.aliases = []AliasName{fact.Name()}
+
+		d.did = fact.Sender().String()
+		d.didtype = DuplicationTypeSender
+	case AliasRelease:
+		fact := t.Fact().(AliasReleaseFact)
+		d.aliases = []AliasName{fact.Name()}
+
+		d.did = fact.Sender().String()
+		d.didtype = DuplicationTypeSender
+	case AccountDataUpdater:
+		d.did = t.Fact().(AccountDataUpdaterFact).Target().String()
+		d.didtype = DuplicationTypeSender
+	case Batch:
+		return batchDuplication(t.Fact().(BatchFact).Operations())
 	default:
-		return nil
+		return d, false, nil
 	}
 
-	if len(did) > 0 {
-		if _, found := opr.duplicated[did]; found {
-			switch didtype {
-			case DuplicationTypeSender:
-				return xerrors.Errorf("violates only one sender in proposal")
-			case DuplicationTypeCurrency:
-				return xerrors.Errorf("duplicated currency id, %q found in proposal", did)
-			default:
-				return xerrors.Errorf("violates duplication in proposal")
+	return d, true, nil
+}
+
+// batchDuplication collects the duplications of the operations in Batch.
+func batchDuplication(ops []operation.Operation) (duplication, bool, error) {
+	var d duplication
+
+	claimables := map[string]struct{}{}
+	aliases := map[AliasName]struct{}{}
+	for i := range ops {
+		op, ok := ops[i].(state.Processor)
+		if !ok {
+			return d, false, xerrors.Errorf("not state.Processor, %T", ops[i])
+		}
+
+		var j duplication
+		switch k, ok, err := duplicationOf(op); {
+		case err != nil:
+			return d, false, err
+		case !ok:
+			continue
+		case k.didtype != DuplicationTypeSender:
+			return d, false, xerrors.Errorf("%T can not be in batch", ops[i])
+		default:
+			j = k
+		}
+
+		for k := range j.claimables {
+			if _, found := claimables[j.claimables[k].String()]; !found {
+				claimables[j.claimables[k].String()] = struct{}{}
+				d.claimables = append(d.claimables, j.claimables[k])
+			}
+		}
+
+		for k := range j.aliases {
+			if _, found := aliases[j.aliases[k]]; !found {
+				aliases[j.aliases[k]] = struct{}{}
+				d.aliases = append(d.aliases, j.aliases[k])
 			}
 		}
 
-		opr.duplicated[did] = didtype
+		d.newAddresses = append(d.newAddresses, j.newAddresses...)
 	}
 
-	if len(newAddresses) > 0 {
-		if err := opr.checkNewAddressDuplication(newAddresses); err != nil {
-			return err
+	return d, true, nil
+}
+
+// checkClaimableDuplication prevents the claimable balances of same address
+// from being updated by multiple operations in one proposal.
+func (opr *OperationProcessor) checkClaimableDuplication(as []base.Address) error {
+	for i := range as {
+		if _, found := opr.duplicated[StateKeyClaimableBalance(as[i])]; found {
+			return xerrors.Errorf("claimable balance of %q already processed", as[i])
+		}
+	}
+
+	return nil
+}
+
+// checkAliasDuplication prevents same alias from being updated by multiple
+// operations in one proposal.
+func (opr *OperationProcessor) checkAliasDuplication(names []AliasName) error {
+	for i := range names {
+		if _, found := opr.duplicated[StateKeyAlias(names[i])]; found {
+			return xerrors.Errorf("alias, %q already processed", names[i])
 		}
 	}
 
@@ -218,34 +893,126 @@ func (opr *OperationProcessor) checkNewAddressDuplication(as []base.Address) err
 		}
 	}
 
-	for i := range as {
-		opr.duplicatedNewAddress[as[i].String()] = struct{}{}
-	}
-
 	return nil
 }
 
+// Close sets the FeeOperation, the base fees and the activated currency
+// policies of the next block; it is done
+// once, because the OperationProcessor is shared by the types of operation.
 func (opr *OperationProcessor) Close() error {
+	opr.closeOnce.Do(func() {
+		opr.closeErr = opr.close()
+	})
+
+	return opr.closeErr
+}
+
+func (opr *OperationProcessor) close() error {
 	opr.RLock()
 	defer opr.RUnlock()
 
-	if opr.cp != nil && len(opr.fee) > 0 {
-		op := NewFeeOperation(NewFeeOperationFact(opr.pool.Height(), opr.fee))
+	// NOTE Statepool keeps the first value of the state, which is set by
+	// multiple operations, so the last values of the state view are set.
+	for _, su := range opr.pool.Updates() {
+		st, found := opr.states.updated[su.Key()]
+		if !found {
+			continue
+		} else if _, ok := st.(AmountState); ok {
+			continue
+		}
 
-		pr := NewFeeOperationProcessor(opr.cp, op)
-		if err := pr.Process(opr.pool.Get, opr.pool.Set); err != nil {
+		if err := su.SetValue(st.Value()); err != nil {
 			return err
-		} else {
-			opr.pool.AddOperations(op)
 		}
 	}
 
+	if opr.cp == nil {
+		return nil
+	}
+
+	var sts []state.State
+	if i, err := opr.nextBaseFees(); err != nil {
+		return err
+	} else {
+		sts = i
+	}
+
+	if i, err := opr.activatePendingPolicies(); err != nil {
+		return err
+	} else {
+		sts = append(sts, i...)
+	}
+
+	if len(opr.fee) < 1 && len(sts) < 1 {
+		return nil
+	}
+
+	op := NewFeeOperation(NewFeeOperationFact(opr.pool.Height(), opr.fee))
+
+	pr := NewFeeOperationProcessor(opr.cp, op)
+	if err := pr.Process(opr.pool.Get, opr.pool.Set); err != nil {
+		return err
+	}
+
+	if len(sts) > 0 {
+		if err := opr.pool.Set(op.Fact().Hash(), sts...); err != nil {
+			return err
+		}
+	}
+
+	opr.pool.AddOperations(op)
+
 	return nil
 }
 
+// nextBaseFees returns the base fee states of DynamicFeeer for the next block,
+// which are changed by the items of operations of this block against the
+// capacity of block. The base fee state is set in every processed block, so the
+// height of state tells the empty blocks after it; see CurrencyPool.At.
+func (opr *OperationProcessor) nextBaseFees() ([]state.State, error) {
+	var capacity uint
+	if po, err := opr.loadNetworkPolicy(); err != nil {
+		return nil, err
+	} else {
+		capacity = po.BlockLimits().Capacity()
+	}
+
+	var sts []state.State
+	for cid := range opr.cp.Designs() {
+		var feeer DynamicFeeer
+		if i, found := opr.cp.Feeer(cid); !found {
+			continue
+		} else if j, ok := i.(DynamicFeeer); !ok {
+			continue
+		} else {
+			feeer = j
+		}
+
+		st, _, err := opr.pool.Get(StateKeyBaseFee(cid))
+		if err != nil {
+			return nil, err
+		}
+
+		next := feeer.NextBaseFee(opr.used[cid], capacity)
+
+		if nst, err := SetStateBaseFeeValue(st, NewAmount(next, cid)); err != nil {
+			return nil, err
+		} else {
+			sts = append(sts, nst)
+		}
+	}
+
+	return sts, nil
+}
+
 func (opr *OperationProcessor) Cancel() error {
-	opr.RLock()
-	defer opr.RUnlock()
+	if opr.canceled == nil {
+		return nil
+	}
+
+	opr.cancelOnce.Do(func() {
+		close(opr.canceled)
+	})
 
 	return nil
 }
@@ -255,6 +1022,18 @@ func (opr *OperationProcessor) getNewProcessor(op state.Processor) (state.Proces
 	case err != nil:
 		return nil, false, err
 	case i != nil:
+		if j, ok := i.(currencyPoolSetter); ok && opr.cp != nil {
+			j.setCurrencyPool(opr.cp)
+		}
+
+		if j, ok := i.(networkPolicySetter); ok {
+			if po, err := opr.loadNetworkPolicy(); err != nil {
+				return nil, false, err
+			} else {
+				j.setNetworkPolicy(po)
+			}
+		}
+
 		return i, true, nil
 	}
 
@@ -263,7 +1042,24 @@ func (opr *OperationProcessor) getNewProcessor(op state.Processor) (state.Proces
 		CreateAccounts,
 		KeyUpdater,
 		CurrencyRegister,
-		CurrencyPolicyUpdater:
+		CurrencyPolicyUpdater,
+		NetworkPolicyUpdater,
+		CreateClaimableBalance,
+		ClaimBalance,
+		ReclaimBalance,
+		Approve,
+		TransferFrom,
+		MultiTransfers,
+		Batch,
+		AccountMerge,
+		GuardiansUpdater,
+		KeyRecovery,
+		KeyRecoveryCanceler,
+		AccountPolicyUpdater,
+		AliasRegister,
+		AliasTransfer,
+		AliasRelease,
+		AccountDataUpdater:
 		return nil, false, xerrors.Errorf("%T needs SetProcessor", t)
 	default:
 		return op, false, nil
@@ -277,10 +1073,83 @@ func (opr *OperationProcessor) getNewProcessorFromHintset(op state.Processor) (s
 	} else if i, found := opr.processorHintSet.Get(hinter); !found {
 		return nil, nil
 	} else if j, ok := i.(GetNewProcessor); !ok {
-		return nil, xerrors.Errorf("invalid GetNewProcessor func, %%", i)
+		return nil, xerrors.Errorf("invalid GetNewProcessor func, %T", i)
 	} else {
 		f = j
 	}
 
 	return f(op)
 }
+
+// pendingOperation is the operation accepted by PreProcess. done is closed when
+// the operation is processed or dropped, so the next operation in proposal can
+// be committed.
+type pendingOperation struct {
+	operation.Operation
+	previous *pendingOperation
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func (po *pendingOperation) close() {
+	po.doneOnce.Do(func() {
+		close(po.done)
+	})
+}
+
+func (*pendingOperation) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE pendingOperation is processed by OperationProcessor
+	return xerrors.Errorf("pending operation can not be processed by itself")
+}
+
+// activatePendingPolicies returns the states to swap in the pending currency
+// policies, which are activated from the next block. The currency updated in
+// this block is skipped; the pending policy of it is swapped in by the next
+// block.
+func (opr *OperationProcessor) activatePendingPolicies() ([]state.State, error) {
+	var sts []state.State
+	for cid := range opr.cp.Designs() {
+		if opr.duplicated[cid.String()] == DuplicationTypeCurrency {
+			continue
+		}
+
+		var pp PendingCurrencyPolicy
+		switch pst, found, err := opr.pool.Get(StateKeyPendingCurrencyPolicy(cid)); {
+		case err != nil:
+			return nil, err
+		case !found:
+			continue
+		default:
+			if i, err := StatePendingCurrencyPolicyValue(pst); err != nil {
+				return nil, err
+			} else if !i.IsActivated(opr.pool.Height() + 1) {
+				continue
+			} else if nst, err := SetStatePendingCurrencyPolicyValue(pst, i.SetApplied()); err != nil {
+				return nil, err
+			} else {
+				pp = i
+				sts = append(sts, nst)
+			}
+		}
+
+		switch st, found, err := opr.pool.Get(StateKeyCurrencyDesign(cid)); {
+		case err != nil:
+			return nil, err
+		case !found:
+			return nil, xerrors.Errorf("unknown currency, %q found", cid)
+		default:
+			if de, err := StateCurrencyDesignValue(st); err != nil {
+				return nil, err
+			} else if nst, err := SetStateCurrencyDesignValue(st, de.SetPolicy(pp.Policy())); err != nil {
+				return nil, err
+			} else {
+				sts = append(sts, nst)
+			}
+		}
+	}
+
+	return sts, nil
+}
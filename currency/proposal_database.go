@@ -0,0 +1,141 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/storage"
+)
+
+// ProposalDatabase is the storage.Database of proposal maker. The staged
+// operation seals are selected under the BlockLimits of the network policy of
+// the last block; the seals over it are not selected and wait for the next
+// proposal, so they are not rejected and the operations are not lost. With fee
+// priority, the seals are ordered by the highest fee of their operations, so
+// the proposal maker picks the seals of paying operations first. Only the first
+// seals of window in incoming order are ordered, so the seals in memory are
+// bounded and the old seals are not starved by the new ones.
+type ProposalDatabase struct {
+	storage.Database
+	cp          *CurrencyPool
+	feePriority bool
+	window      uint
+}
+
+func NewProposalDatabase(st storage.Database, cp *CurrencyPool) ProposalDatabase {
+	return ProposalDatabase{Database: st, cp: cp}
+}
+
+// SetFeePriority orders the staged operation seals by fee within the window.
+func (st ProposalDatabase) SetFeePriority(window uint) ProposalDatabase {
+	if window < 1 {
+		window = DefaultFeePriorityWindow
+	}
+
+	st.feePriority = true
+	st.window = window
+
+	return st
+}
+
+func (st ProposalDatabase) StagedOperationSeals(callback func(operation.Seal) (bool, error), sorted bool) error {
+	var limits BlockLimits
+	if i, err := st.blockLimits(); err != nil {
+		return err
+	} else {
+		limits = i
+	}
+
+	selected := st.selectByLimits(limits, callback)
+	if !st.feePriority {
+		return st.Database.StagedOperationSeals(selected, sorted)
+	}
+
+	var seals []operation.Seal
+	var fees []Big
+	if err := st.Database.StagedOperationSeals(func(sl operation.Seal) (bool, error) {
+		fee := ZeroBig
+		for _, op := range sl.Operations() {
+			if i, err := OperationFee(st.cp, op); err == nil && i.Compare(fee) > 0 {
+				fee = i
+			}
+		}
+
+		seals = append(seals, sl)
+		fees = append(fees, fee)
+
+		return uint(len(seals)) < st.window, nil
+	}, sorted); err != nil {
+		return err
+	}
+
+	indices := sortByFee(fees)
+	for i := range indices {
+		switch keep, err := selected(seals[indices[i]]); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// selectByLimits skips the seal, whose operations are over the BlockLimits
+// with the operations of the selected seals. The known operations are not
+// counted, because they are excluded from proposal.
+func (st ProposalDatabase) selectByLimits(
+	limits BlockLimits,
+	callback func(operation.Seal) (bool, error),
+) func(operation.Seal) (bool, error) {
+	if limits.IsEmpty() {
+		return callback
+	}
+
+	founds := map[string]struct{}{}
+
+	var operations, items uint
+	return func(sl operation.Seal) (bool, error) {
+		o, i := operations, items
+
+		var fhs []string
+		for _, op := range sl.Operations() {
+			fh := op.Fact().Hash()
+			if _, found := founds[fh.String()]; found {
+				continue
+			} else if found, err := st.Database.HasOperationFact(fh); err != nil {
+				return false, err
+			} else if found {
+				continue
+			}
+
+			var fits bool
+			if o, i, fits = limits.fits(o, i, op.Fact()); !fits {
+				return true, nil
+			}
+
+			fhs = append(fhs, fh.String())
+		}
+
+		operations, items = o, i
+		for i := range fhs {
+			founds[fhs[i]] = struct{}{}
+		}
+
+		return callback(sl)
+	}
+}
+
+func (st ProposalDatabase) blockLimits() (BlockLimits, error) {
+	switch i, found, err := st.Database.State(StateKeyNetworkPolicy); {
+	case err != nil:
+		return BlockLimits{}, err
+	case !found:
+		return DefaultNetworkPolicy.BlockLimits(), nil
+	default:
+		if po, err := StateNetworkPolicyValue(i); err != nil {
+			return BlockLimits{}, err
+		} else {
+			return po.BlockLimits(), nil
+		}
+	}
+}
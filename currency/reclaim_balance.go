@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ReclaimBalanceFactType = hint.MustNewType(0xa0, 0x42, "mitum-currency-reclaim-balance-operation-fact")
+	ReclaimBalanceFactHint = hint.MustHint(ReclaimBalanceFactType, "0.0.1")
+	ReclaimBalanceType     = hint.MustNewType(0xa0, 0x43, "mitum-currency-reclaim-balance-operation")
+	ReclaimBalanceHint     = hint.MustHint(ReclaimBalanceType, "0.0.1")
+)
+
+var (
+	ReclaimBalanceFactExpiringHint   = hint.MustHint(ReclaimBalanceFactType, "0.0.2")
+	ReclaimBalanceFactExpiringHinter = ReclaimBalanceFact{expiringFact: expiringFact{ht: ReclaimBalanceFactExpiringHint}}
+)
+
+// ReclaimBalanceFact returns the expired claimable balances of target, which
+// were created by sender, to the balance of sender.
+type ReclaimBalanceFact struct {
+	expiringFact
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	target base.Address
+}
+
+func NewReclaimBalanceFact(token []byte, sender, target base.Address) ReclaimBalanceFact {
+	fact := ReclaimBalanceFact{
+		token:  token,
+		sender: sender,
+		target: target,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ReclaimBalanceFact) WithExpiry(ex Expiry) ReclaimBalanceFact {
+	fact.expiringFact = newExpiringFact(ReclaimBalanceFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ReclaimBalanceFact) Hint() hint.Hint {
+	return fact.factHint(ReclaimBalanceFactHint)
+}
+
+func (fact ReclaimBalanceFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ReclaimBalanceFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReclaimBalanceFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+		ext,
+	)
+}
+
+func (fact ReclaimBalanceFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for ReclaimBalanceFact")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.sender,
+		fact.target,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.target) {
+		return xerrors.Errorf("target address is same with sender, %q", fact.sender)
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ReclaimBalanceFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ReclaimBalanceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ReclaimBalanceFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact ReclaimBalanceFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.target}, nil
+}
+
+type ReclaimBalance struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewReclaimBalance(fact ReclaimBalanceFact, fs []operation.FactSign, memo string) (ReclaimBalance, error) {
+	if bo, err := operation.NewBaseOperationFromFact(ReclaimBalanceHint, fact, fs); err != nil {
+		return ReclaimBalance{}, err
+	} else {
+		op := ReclaimBalance{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op ReclaimBalance) Hint() hint.Hint {
+	return ReclaimBalanceHint
+}
+
+func (op ReclaimBalance) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op ReclaimBalance) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op ReclaimBalance) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
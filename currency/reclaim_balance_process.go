@@ -0,0 +1,121 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op ReclaimBalance) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type ReclaimBalanceProcessor struct {
+	cp *CurrencyPool
+	ReclaimBalance
+	height  base.Height
+	cs      state.State
+	remains []ClaimableBalance
+	sb      map[CurrencyID]AmountState
+	claimed map[CurrencyID][2]Big
+}
+
+func NewReclaimBalanceProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(ReclaimBalance); !ok {
+			return nil, xerrors.Errorf("not ReclaimBalance, %T", op)
+		} else {
+			return &ReclaimBalanceProcessor{
+				cp:             cp,
+				ReclaimBalance: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *ReclaimBalanceProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *ReclaimBalanceProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *ReclaimBalanceProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ReclaimBalanceFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	var cbs []ClaimableBalance
+	switch st, i, err := loadClaimableBalances(fact.target, getState); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case len(i) < 1:
+		return nil, operation.NewBaseReasonError("claimable balance of target does not exist")
+	default:
+		opp.cs = st
+		cbs = i
+	}
+
+	var reclaimed []ClaimableBalance
+	for i := range cbs {
+		cb := cbs[i]
+		if cb.Sender().Equal(fact.sender) && cb.IsExpired(opp.height) {
+			reclaimed = append(reclaimed, cb)
+		} else {
+			opp.remains = append(opp.remains, cb)
+		}
+	}
+
+	if len(reclaimed) < 1 {
+		return nil, operation.NewBaseReasonError("expired claimable balance of sender does not exist")
+	}
+
+	if sb, claimed, err := claimedAmountStates(
+		opp.cp, fact.sender, sumClaimableBalances(reclaimed), getState,
+	); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	} else {
+		opp.sb = sb
+		opp.claimed = claimed
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ReclaimBalanceProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ReclaimBalanceFact)
+
+	var sts []state.State // nolint:prealloc
+	if st, err := SetStateClaimableBalancesValue(opp.cs, opp.remains); err != nil {
+		return err
+	} else {
+		sts = append(sts, st)
+	}
+
+	for k := range opp.claimed {
+		cl := opp.claimed[k]
+		sts = append(sts, opp.sb[k].Add(cl[0].Sub(cl[1])).AddFee(cl[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
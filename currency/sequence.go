@@ -0,0 +1,14 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+)
+
+// SequencedFact is the fact, which has the sequence of account. The sequence
+// of account should be increased one by one from 1, so the operations of
+// account are ordered and can not be replayed.
+type SequencedFact interface {
+	IsSequenced() bool
+	Sequence() uint64
+	SequenceAccount() base.Address
+}
@@ -0,0 +1,293 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+)
+
+type testSequence struct {
+	suite.Suite
+}
+
+func (t *testSequence) TestWithSequence() {
+	sender := NewTestAddress()
+	items := []TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(1), CurrencyID("SHOWME")))}
+
+	fact := NewTransfersFact(util.UUID().Bytes(), sender, items)
+	t.False(fact.IsSequenced())
+
+	sfact := fact.WithSequence(3)
+	t.NoError(sfact.IsValid(nil))
+	t.True(sfact.Hint().Equal(TransfersFactSequencedHint))
+	t.Equal(uint64(3), sfact.Sequence())
+	t.True(sfact.SequenceAccount().Equal(sender))
+	t.False(sfact.Hash().Equal(fact.Hash()))
+	t.False(sfact.Hash().Equal(fact.WithSequence(4).Hash()))
+
+	// NOTE expiry is kept with sequence
+	ex := NewExpiry(33, localtime.UTCNow())
+	efact := fact.WithExpiry(ex).WithSequence(3)
+	t.NoError(efact.IsValid(nil))
+	t.True(efact.IsSequenced())
+	t.True(efact.IsExpiring())
+	t.Equal(ex.Height(), efact.Expiry().Height())
+	t.False(efact.Hash().Equal(sfact.Hash()))
+
+	t.True(sfact.WithExpiry(ex).Hash().Equal(efact.Hash()))
+}
+
+func (t *testSequence) TestZeroSequence() {
+	items := []TransfersItem{NewTransfersItemSingleAmount(NewTestAddress(), NewAmount(NewBig(1), CurrencyID("SHOWME")))}
+
+	fact := NewTransfersFact(util.UUID().Bytes(), NewTestAddress(), items).WithSequence(0)
+
+	err := fact.IsValid(nil)
+	t.Contains(err.Error(), "sequence should be over zero")
+}
+
+func (t *testSequence) TestKeyUpdaterKeepStrict() {
+	k, err := NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	t.NoError(err)
+	keys, err := NewKeys([]Key{k}, 100)
+	t.NoError(err)
+
+	fact := NewKeyUpdaterFactStrict(util.UUID().Bytes(), NewTestAddress(), keys, CurrencyID("SHOWME")).WithSequence(1)
+	t.NoError(fact.IsValid(nil))
+	t.True(fact.IsStrict())
+	t.True(fact.IsSequenced())
+
+	nfact := NewKeyUpdaterFact(fact.Token(), fact.Target(), keys, CurrencyID("SHOWME")).WithSequence(1)
+	t.False(nfact.IsStrict())
+	t.False(fact.Hash().Equal(nfact.Hash()))
+}
+
+func TestSequence(t *testing.T) {
+	suite.Run(t, new(testSequence))
+}
+
+type testSequenceOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testSequenceOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testSequenceOperations) processor(pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(nil).
+		SetProcessor(Transfers{}, NewTransfersProcessor(nil))
+	t.NoError(err)
+
+	return copr.New(pool)
+}
+
+func (t *testSequenceOperations) newStateSequence(a base.Address, sequence uint64) state.State {
+	st, err := state.NewStateV0(StateKeySequence(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateSequenceValue(st, sequence)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testSequenceOperations) newTransfer(sender *account, receiver base.Address, sequence uint64) Transfers {
+	items := []TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(NewBig(1), t.cid))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sender.Address, items)
+	if sequence > 0 {
+		fact = fact.WithSequence(sequence)
+	}
+
+	var fs []operation.FactSign
+	for _, pk := range sender.Privs() {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	tf, err := NewTransfers(fact, fs, "")
+	t.NoError(err)
+	t.NoError(tf.IsValid(nil))
+
+	return tf
+}
+
+func (t *testSequenceOperations) sequence(pool *storage.Statepool, a base.Address) (uint64, bool) {
+	for _, st := range pool.Updates() {
+		if st.Key() != StateKeySequence(a) {
+			continue
+		}
+
+		i, err := StateSequenceValue(st.GetState())
+		t.NoError(err)
+
+		return i, true
+	}
+
+	return 0, false
+}
+
+func (t *testSequenceOperations) TestFirst() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	opr := t.processor(pool)
+
+	t.NoError(opr.Process(t.newTransfer(sa, ra.Address, 1)))
+	t.NoError(opr.Close())
+
+	sequence, found := t.sequence(pool, sa.Address)
+	t.True(found)
+	t.Equal(uint64(1), sequence)
+}
+
+func (t *testSequenceOperations) TestNext() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSequence(sa.Address, 5)})
+	opr := t.processor(pool)
+
+	t.NoError(opr.Process(t.newTransfer(sa, ra.Address, 6)))
+	t.NoError(opr.Close())
+
+	sequence, found := t.sequence(pool, sa.Address)
+	t.True(found)
+	t.Equal(uint64(6), sequence)
+}
+
+func (t *testSequenceOperations) TestNextInProposal() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSequence(sa.Address, 5)})
+	opr := t.processor(pool)
+
+	t.NoError(opr.Process(t.newTransfer(sa, ra.Address, 6)))
+	t.NoError(opr.Process(t.newTransfer(sa, ra.Address, 7)))
+
+	err := opr.Process(t.newTransfer(sa, ra.Address, 7))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "wrong sequence")
+
+	t.NoError(opr.Close())
+
+	sequence, found := t.sequence(pool, sa.Address)
+	t.True(found)
+	t.Equal(uint64(7), sequence)
+}
+
+func (t *testSequenceOperations) TestReplay() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSequence(sa.Address, 5)})
+	opr := t.processor(pool)
+
+	err := opr.Process(t.newTransfer(sa, ra.Address, 5))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "wrong sequence")
+}
+
+func (t *testSequenceOperations) TestSkipped() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	opr := t.processor(pool)
+
+	err := opr.Process(t.newTransfer(sa, ra.Address, 2))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "wrong sequence")
+}
+
+func (t *testSequenceOperations) TestWithoutSequence() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSequence(sa.Address, 5)})
+	opr := t.processor(pool)
+
+	t.NoError(opr.Process(t.newTransfer(sa, ra.Address, 0)))
+	t.NoError(opr.Close())
+
+	_, found := t.sequence(pool, sa.Address)
+	t.False(found)
+}
+
+func TestSequenceOperations(t *testing.T) {
+	suite.Run(t, new(testSequenceOperations))
+}
+
+func testSequenceEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.MustNewBTCPrivatekey()
+
+		skey, err := NewKey(pk.Publickey(), 100)
+		t.NoError(err)
+		skeys, err := NewKeys([]Key{skey}, 100)
+		t.NoError(err)
+
+		target, _ := NewAddressFromKeys(skeys)
+
+		fact := NewKeyUpdaterFactStrict(util.UUID().Bytes(), target, skeys, CurrencyID("SHOWME")).
+			WithExpiry(NewExpiry(33, localtime.UTCNow())).
+			WithSequence(3)
+
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		ku, err := NewKeyUpdater(fact, []operation.FactSign{operation.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return ku
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(KeyUpdater).Fact().(KeyUpdaterFact)
+		ufact := b.(KeyUpdater).Fact().(KeyUpdaterFact)
+
+		t.True(ufact.Hint().Equal(KeyUpdaterFactSequencedHint))
+		t.True(fact.Hash().Equal(ufact.Hash()))
+		t.True(ufact.IsStrict())
+		t.Equal(fact.Sequence(), ufact.Sequence())
+		t.Equal(fact.Expiry().Height(), ufact.Expiry().Height())
+		t.NoError(ufact.IsValid(nil))
+	}
+
+	return t
+}
+
+func TestSequenceEncodeJSON(t *testing.T) {
+	suite.Run(t, testSequenceEncode(jsonenc.NewEncoder()))
+}
+
+func TestSequenceEncodeBSON(t *testing.T) {
+	suite.Run(t, testSequenceEncode(bsonenc.NewEncoder()))
+}
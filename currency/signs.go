@@ -5,8 +5,40 @@ import (
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"golang.org/x/xerrors"
 )
 
+// SuffrageKeys returns the publickeys and the threshold of the suffrage nodes
+// for the proposal of the height; the governance operations, like
+// CurrencyRegister, should be signed by them.
+type SuffrageKeys func(base.Height) ([]key.Publickey, base.Threshold, error)
+
+// NewFixedSuffrageKeys returns the SuffrageKeys, which returns the same keys at
+// any height.
+func NewFixedSuffrageKeys(pubs []key.Publickey, threshold base.Threshold) SuffrageKeys {
+	return func(base.Height) ([]key.Publickey, base.Threshold, error) {
+		return pubs, threshold, nil
+	}
+}
+
+// checkFactSignsBySuffrage checks the fact signs by the suffrage keys at the
+// height.
+func checkFactSignsBySuffrage(suffrageKeys SuffrageKeys, height base.Height, signs []operation.FactSign) error {
+	if suffrageKeys == nil {
+		return xerrors.Errorf("empty publickeys for operation signs")
+	}
+
+	pubs, threshold, err := suffrageKeys(height)
+	if err != nil {
+		return xerrors.Errorf("failed to load suffrage keys: %w", err)
+	} else if len(pubs) < 1 {
+		return xerrors.Errorf("empty publickeys for operation signs")
+	}
+
+	return checkFactSignsByPubs(pubs, threshold, signs)
+}
+
 func checkFactSignsByPubs(pubs []key.Publickey, threshold base.Threshold, signs []operation.FactSign) error {
 	var signed uint
 	for i := range signs {
@@ -26,11 +58,18 @@ func checkFactSignsByPubs(pubs []key.Publickey, threshold base.Threshold, signs
 	return nil
 }
 
+// checkFactSignsByState checks the fact signs by the keys of account. The
+// threshold follows the given operation type.
 func checkFactSignsByState(
 	address base.Address,
+	t hint.Type,
 	fs []operation.FactSign,
 	getState func(key string) (state.State, bool, error),
 ) error {
+	if err := checkNotClosedAccount(address, getState); err != nil {
+		return err
+	}
+
 	var keys Keys
 	if st, err := existsState(StateKeyAccount(address), "keys of account", getState); err != nil {
 		return err
@@ -42,9 +81,118 @@ func checkFactSignsByState(
 		}
 	}
 
-	if err := checkThreshold(fs, keys); err != nil {
+	if err := checkThreshold(fs, keys, t); err != nil {
 		return operation.NewBaseReasonErrorFromError(err)
 	}
 
 	return nil
 }
+
+// checkFactSignsBySenders checks the threshold of each sender by the fact
+// signs. Every fact sign should belong to the keys of at least one sender.
+func checkFactSignsBySenders(
+	senders []base.Address,
+	t hint.Type,
+	fs []operation.FactSign,
+	getState func(key string) (state.State, bool, error),
+) error {
+	keys := make([]Keys, len(senders))
+	for i := range senders {
+		if err := checkNotClosedAccount(senders[i], getState); err != nil {
+			return err
+		}
+
+		if st, err := existsState(StateKeyAccount(senders[i]), "keys of account", getState); err != nil {
+			return err
+		} else if ks, err := StateKeysValue(st); err != nil {
+			return operation.NewBaseReasonErrorFromError(err)
+		} else {
+			keys[i] = ks
+		}
+	}
+
+	sums := make([]uint, len(senders))
+	for i := range fs {
+		var known bool
+		for j := range keys {
+			if ky, found := keys[j].Key(fs[i].Signer()); found {
+				sums[j] += ky.Weight()
+				known = true
+			}
+		}
+
+		if !known {
+			return operation.NewBaseReasonError("unknown key found, %s", fs[i].Signer())
+		}
+	}
+
+	for i := range senders {
+		if th := keys[i].ThresholdOf(t); sums[i] < th {
+			return operation.NewBaseReasonError(
+				"not passed threshold of sender, %s; sum=%d < threshold=%d", senders[i], sums[i], th)
+		}
+	}
+
+	return nil
+}
+
+// checkFactSignsByGuardians checks the fact signs of guardians. The guardian
+// is counted when the signs of its keys pass its threshold for the operation
+// type and the number of the counted guardians should pass the threshold of
+// Guardians. The closed guardians are not counted.
+func checkFactSignsByGuardians(
+	gd Guardians,
+	t hint.Type,
+	fs []operation.FactSign,
+	getState func(key string) (state.State, bool, error),
+) error {
+	var guardians []base.Address
+	var keys []Keys
+	for i := range gd.Guardians() {
+		a := gd.Guardians()[i]
+		switch _, found, err := getState(StateKeyAccountClosed(a)); {
+		case err != nil:
+			return err
+		case found:
+			continue
+		}
+
+		if st, err := existsState(StateKeyAccount(a), "keys of guardian", getState); err != nil {
+			return err
+		} else if ks, err := StateKeysValue(st); err != nil {
+			return operation.NewBaseReasonErrorFromError(err)
+		} else {
+			guardians = append(guardians, a)
+			keys = append(keys, ks)
+		}
+	}
+
+	sums := make([]uint, len(guardians))
+	for i := range fs {
+		var known bool
+		for j := range keys {
+			if ky, found := keys[j].Key(fs[i].Signer()); found {
+				sums[j] += ky.Weight()
+				known = true
+			}
+		}
+
+		if !known {
+			return operation.NewBaseReasonError("unknown key found, %s", fs[i].Signer())
+		}
+	}
+
+	var passed uint
+	for i := range guardians {
+		if sums[i] >= keys[i].ThresholdOf(t) {
+			passed++
+		}
+	}
+
+	if passed < gd.Threshold() {
+		return operation.NewBaseReasonError(
+			"not passed threshold of guardians; passed=%d < threshold=%d", passed, gd.Threshold())
+	}
+
+	return nil
+}
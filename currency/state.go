@@ -10,12 +10,26 @@ import (
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 var (
 	StateKeyAccountSuffix        = ":account"
 	StateKeyBalanceSuffix        = ":balance"
+	StateKeyClaimableSuffix      = ":claimable"
+	StateKeyAllowanceSuffix      = ":allowance"
+	StateKeyAccountClosedSuffix  = ":closed"
+	StateKeyGuardiansSuffix      = ":guardians"
+	StateKeyRecoverySuffix       = ":recovery"
+	StateKeyAccountPolicySuffix  = ":policy"
+	StateKeyAccountSpentSuffix   = ":spent"
+	StateKeyAccountDataSuffix    = ":data"
+	StateKeySequenceSuffix       = ":sequence"
 	StateKeyCurrencyDesignPrefix = "currencydesign:"
+	StateKeyBaseFeeSuffix        = ":basefee"
+	StateKeyPendingPolicySuffix  = ":pendingpolicy"
+	StateKeyNetworkPolicy        = "networkpolicy"
+	StateKeyAliasPrefix          = "alias:"
 )
 
 func StateAddressKeyPrefix(a base.Address) string {
@@ -88,6 +102,267 @@ func SetStateKeysValue(st state.State, v Keys) (state.State, error) {
 	}
 }
 
+func StateKeyAccountClosed(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyAccountClosedSuffix)
+}
+
+func IsStateAccountClosedKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountClosedSuffix)
+}
+
+func StateAccountClosedValue(st state.State) (AccountClosed, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountClosed{}, util.NotFoundError.Errorf("closed account not found in State")
+	}
+
+	if s, ok := v.Interface().(AccountClosed); !ok {
+		return AccountClosed{}, xerrors.Errorf("invalid closed account value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAccountClosedValue(st state.State, v AccountClosed) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyGuardians(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyGuardiansSuffix)
+}
+
+func IsStateGuardiansKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyGuardiansSuffix)
+}
+
+func StateGuardiansValue(st state.State) (Guardians, error) {
+	v := st.Value()
+	if v == nil {
+		return Guardians{}, util.NotFoundError.Errorf("guardians not found in State")
+	}
+
+	if s, ok := v.Interface().(Guardians); !ok {
+		return Guardians{}, xerrors.Errorf("invalid guardians value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateGuardiansValue(st state.State, v Guardians) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyRecovery(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyRecoverySuffix)
+}
+
+func IsStateRecoveryKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyRecoverySuffix)
+}
+
+// StateRecoveryValue returns the pending Recovery. The recovery state keeps
+// the empty list after the recovery is finished or canceled, so the bool
+// return value is false.
+func StateRecoveryValue(st state.State) (Recovery, bool, error) {
+	v := st.Value()
+	if v == nil {
+		return Recovery{}, false, util.NotFoundError.Errorf("recovery not found in State")
+	}
+
+	var l []hint.Hinter
+	if s, ok := v.Interface().([]hint.Hinter); !ok {
+		return Recovery{}, false, xerrors.Errorf("invalid recovery value found, %T", v.Interface())
+	} else {
+		l = s
+	}
+
+	switch {
+	case len(l) < 1:
+		return Recovery{}, false, nil
+	case len(l) > 1:
+		return Recovery{}, false, xerrors.Errorf("multiple recoveries found, %d", len(l))
+	}
+
+	if rc, ok := l[0].(Recovery); !ok {
+		return Recovery{}, false, xerrors.Errorf("invalid recovery found, %T", l[0])
+	} else {
+		return rc, true, nil
+	}
+}
+
+func SetStateRecoveryValue(st state.State, v Recovery) (state.State, error) {
+	if uv, err := state.NewSliceValue([]Recovery{v}); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func ClearStateRecoveryValue(st state.State) (state.State, error) {
+	if uv, err := state.NewSliceValue([]Recovery{}); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyAccountPolicy(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyAccountPolicySuffix)
+}
+
+func IsStateAccountPolicyKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountPolicySuffix)
+}
+
+func StateAccountPolicyValue(st state.State) (AccountPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountPolicy{}, util.NotFoundError.Errorf("account policy not found in State")
+	}
+
+	if s, ok := v.Interface().(AccountPolicy); !ok {
+		return AccountPolicy{}, xerrors.Errorf("invalid account policy value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAccountPolicyValue(st state.State, v AccountPolicy) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+// StateKeyPendingAccountPolicy is the key of the relaxed AccountPolicy, which
+// is not yet activated.
+func StateKeyPendingAccountPolicy(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyPendingPolicySuffix)
+}
+
+func IsStatePendingAccountPolicyKey(key string) bool {
+	return !strings.HasPrefix(key, StateKeyCurrencyDesignPrefix) && strings.HasSuffix(key, StateKeyPendingPolicySuffix)
+}
+
+func StatePendingAccountPolicyValue(st state.State) (PendingAccountPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return PendingAccountPolicy{}, util.NotFoundError.Errorf("pending account policy not found in State")
+	}
+
+	if s, ok := v.Interface().(PendingAccountPolicy); !ok {
+		return PendingAccountPolicy{}, xerrors.Errorf("invalid pending account policy value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStatePendingAccountPolicyValue(st state.State, v PendingAccountPolicy) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyAccountData(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyAccountDataSuffix)
+}
+
+func IsStateAccountDataKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountDataSuffix)
+}
+
+func StateAccountDataValue(st state.State) (AccountData, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountData{}, util.NotFoundError.Errorf("account data not found in State")
+	}
+
+	if s, ok := v.Interface().(AccountData); !ok {
+		return AccountData{}, xerrors.Errorf("invalid account data value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAccountDataValue(st state.State, v AccountData) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeySequence(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeySequenceSuffix)
+}
+
+func IsStateSequenceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeySequenceSuffix)
+}
+
+// StateSequenceValue returns the last sequence of account. If not yet set, 0
+// is returned.
+func StateSequenceValue(st state.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, nil
+	}
+
+	if s, ok := v.Interface().(uint64); !ok {
+		return 0, xerrors.Errorf("invalid sequence value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateSequenceValue(st state.State, v uint64) (state.State, error) {
+	if uv, err := state.NewNumberValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyAccountSpent(a base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyAccountSpentSuffix)
+}
+
+func IsStateAccountSpentKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountSpentSuffix)
+}
+
+func StateAccountSpentValue(st state.State) (AccountSpent, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountSpent{}, util.NotFoundError.Errorf("account spent not found in State")
+	}
+
+	if s, ok := v.Interface().(AccountSpent); !ok {
+		return AccountSpent{}, xerrors.Errorf("invalid account spent value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAccountSpentValue(st state.State, v AccountSpent) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
 func StateKeyBalance(a base.Address, cid CurrencyID) string {
 	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyBalanceSuffix)
 }
@@ -117,8 +392,136 @@ func SetStateBalanceValue(st state.State, v Amount) (state.State, error) {
 	}
 }
 
+func StateKeyClaimableBalance(a base.Address) string {
+	return fmt.Sprintf("%s%s", StateAddressKeyPrefix(a), StateKeyClaimableSuffix)
+}
+
+func IsStateClaimableBalanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyClaimableSuffix)
+}
+
+func StateClaimableBalancesValue(st state.State) ([]ClaimableBalance, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.NotFoundError.Errorf("claimable balances not found in State")
+	}
+
+	var l []hint.Hinter
+	if s, ok := v.Interface().([]hint.Hinter); !ok {
+		return nil, xerrors.Errorf("invalid claimable balances value found, %T", v.Interface())
+	} else {
+		l = s
+	}
+
+	cbs := make([]ClaimableBalance, len(l))
+	for i := range l {
+		if j, ok := l[i].(ClaimableBalance); !ok {
+			return nil, xerrors.Errorf("invalid claimable balance found, %T", l[i])
+		} else {
+			cbs[i] = j
+		}
+	}
+
+	return cbs, nil
+}
+
+func SetStateClaimableBalancesValue(st state.State, v []ClaimableBalance) (state.State, error) {
+	if uv, err := state.NewSliceValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateAllowanceKeyPrefix(owner, spender base.Address) string {
+	return fmt.Sprintf("%s-%s", StateAddressKeyPrefix(owner), StateAddressKeyPrefix(spender))
+}
+
+func StateKeyAllowance(owner, spender base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s-%s%s", StateAllowanceKeyPrefix(owner, spender), cid, StateKeyAllowanceSuffix)
+}
+
+func IsStateAllowanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAllowanceSuffix)
+}
+
+func StateAllowanceValue(st state.State) (Allowance, error) {
+	v := st.Value()
+	if v == nil {
+		return Allowance{}, util.NotFoundError.Errorf("allowance not found in State")
+	}
+
+	if s, ok := v.Interface().(Allowance); !ok {
+		return Allowance{}, xerrors.Errorf("invalid allowance value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAllowanceValue(st state.State, v Allowance) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func StateKeyAlias(name AliasName) string {
+	return fmt.Sprintf("%s%s", StateKeyAliasPrefix, name)
+}
+
+func IsStateAliasKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyAliasPrefix)
+}
+
+// StateAliasValue returns the registered Alias. The alias state keeps the
+// empty list after the alias is released, so the bool return value is false.
+func StateAliasValue(st state.State) (Alias, bool, error) {
+	v := st.Value()
+	if v == nil {
+		return Alias{}, false, util.NotFoundError.Errorf("alias not found in State")
+	}
+
+	var l []hint.Hinter
+	if s, ok := v.Interface().([]hint.Hinter); !ok {
+		return Alias{}, false, xerrors.Errorf("invalid alias value found, %T", v.Interface())
+	} else {
+		l = s
+	}
+
+	switch {
+	case len(l) < 1:
+		return Alias{}, false, nil
+	case len(l) > 1:
+		return Alias{}, false, xerrors.Errorf("multiple aliases found, %d", len(l))
+	}
+
+	if al, ok := l[0].(Alias); !ok {
+		return Alias{}, false, xerrors.Errorf("invalid alias found, %T", l[0])
+	} else {
+		return al, true, nil
+	}
+}
+
+func SetStateAliasValue(st state.State, v Alias) (state.State, error) {
+	if uv, err := state.NewSliceValue([]Alias{v}); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func ClearStateAliasValue(st state.State) (state.State, error) {
+	if uv, err := state.NewSliceValue([]Alias{}); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
 func IsStateCurrencyDesignKey(key string) bool {
-	return strings.HasPrefix(key, StateKeyCurrencyDesignPrefix)
+	return strings.HasPrefix(key, StateKeyCurrencyDesignPrefix) &&
+		!IsStateBaseFeeKey(key) && !IsStatePendingCurrencyPolicyKey(key)
 }
 
 func StateKeyCurrencyDesign(cid CurrencyID) string {
@@ -146,6 +549,94 @@ func SetStateCurrencyDesignValue(st state.State, v CurrencyDesign) (state.State,
 	}
 }
 
+// StateKeyBaseFee is the key of the base fee of DynamicFeeer; it has the
+// prefix of currency design, so it is loaded with the currency designs.
+func StateKeyBaseFee(cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateKeyCurrencyDesign(cid), StateKeyBaseFeeSuffix)
+}
+
+func IsStateBaseFeeKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyCurrencyDesignPrefix) && strings.HasSuffix(key, StateKeyBaseFeeSuffix)
+}
+
+func StateBaseFeeValue(st state.State) (Amount, error) {
+	v := st.Value()
+	if v == nil {
+		return Amount{}, util.NotFoundError.Errorf("base fee not found in State")
+	}
+
+	if s, ok := v.Interface().(Amount); !ok {
+		return Amount{}, xerrors.Errorf("invalid base fee value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateBaseFeeValue(st state.State, v Amount) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+// StateKeyPendingCurrencyPolicy is the key of the CurrencyPolicy scheduled by
+// CurrencyPolicyUpdater; like the base fee, it is loaded with the currency
+// designs.
+func StateKeyPendingCurrencyPolicy(cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateKeyCurrencyDesign(cid), StateKeyPendingPolicySuffix)
+}
+
+func IsStatePendingCurrencyPolicyKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyCurrencyDesignPrefix) && strings.HasSuffix(key, StateKeyPendingPolicySuffix)
+}
+
+func StatePendingCurrencyPolicyValue(st state.State) (PendingCurrencyPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return PendingCurrencyPolicy{}, util.NotFoundError.Errorf("pending currency policy not found in State")
+	}
+
+	if s, ok := v.Interface().(PendingCurrencyPolicy); !ok {
+		return PendingCurrencyPolicy{}, xerrors.Errorf("invalid pending currency policy value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStatePendingCurrencyPolicyValue(st state.State, v PendingCurrencyPolicy) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+func IsStateNetworkPolicyKey(key string) bool {
+	return key == StateKeyNetworkPolicy
+}
+
+func StateNetworkPolicyValue(st state.State) (NetworkPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return NetworkPolicy{}, util.NotFoundError.Errorf("network policy not found in State")
+	}
+
+	if s, ok := v.Interface().(NetworkPolicy); !ok {
+		return NetworkPolicy{}, xerrors.Errorf("invalid network policy value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateNetworkPolicyValue(st state.State, v NetworkPolicy) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
 func checkExistsState(
 	key string,
 	getState func(key string) (state.State, bool, error),
@@ -189,3 +680,17 @@ func notExistsState(
 		return st, nil
 	}
 }
+
+func checkNotClosedAccount(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) error {
+	switch _, found, err := getState(StateKeyAccountClosed(a)); {
+	case err != nil:
+		return err
+	case found:
+		return operation.NewBaseReasonError("account, %q already closed", a)
+	default:
+		return nil
+	}
+}
@@ -61,18 +61,53 @@ func (t *baseTest) SetupSuite() {
 	_ = t.Encs.AddHinter(operation.BaseFactSign{})
 	_ = t.Encs.AddHinter(Key{})
 	_ = t.Encs.AddHinter(Keys{})
+	_ = t.Encs.AddHinter(KeysWithThresholdsHinter)
 	_ = t.Encs.AddHinter(Address(""))
+	_ = t.Encs.AddHinter(AddressChecksumHinter)
 	_ = t.Encs.AddHinter(CreateAccounts{})
 	_ = t.Encs.AddHinter(Transfers{})
 	_ = t.Encs.AddHinter(KeyUpdaterFact{})
+	_ = t.Encs.AddHinter(KeyUpdaterFactStrictHinter)
+	_ = t.Encs.AddHinter(TransfersFactExpiringHinter)
+	_ = t.Encs.AddHinter(CreateAccountsFactExpiringHinter)
+	_ = t.Encs.AddHinter(KeyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(AccountDataUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(AccountMergeFactExpiringHinter)
+	_ = t.Encs.AddHinter(AccountPolicyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(AliasRegisterFactExpiringHinter)
+	_ = t.Encs.AddHinter(AliasReleaseFactExpiringHinter)
+	_ = t.Encs.AddHinter(AliasTransferFactExpiringHinter)
+	_ = t.Encs.AddHinter(ApproveFactExpiringHinter)
+	_ = t.Encs.AddHinter(BatchFactExpiringHinter)
+	_ = t.Encs.AddHinter(ClaimBalanceFactExpiringHinter)
+	_ = t.Encs.AddHinter(CreateClaimableBalanceFactExpiringHinter)
+	_ = t.Encs.AddHinter(CurrencyPolicyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(CurrencyRegisterFactExpiringHinter)
+	_ = t.Encs.AddHinter(GuardiansUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(KeyRecoveryFactExpiringHinter)
+	_ = t.Encs.AddHinter(KeyRecoveryCancelerFactExpiringHinter)
+	_ = t.Encs.AddHinter(MultiTransfersFactExpiringHinter)
+	_ = t.Encs.AddHinter(NetworkPolicyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(ReclaimBalanceFactExpiringHinter)
+	_ = t.Encs.AddHinter(TransferFromFactExpiringHinter)
+	_ = t.Encs.AddHinter(TransfersFactSequencedHinter)
+	_ = t.Encs.AddHinter(CreateAccountsFactSequencedHinter)
+	_ = t.Encs.AddHinter(KeyUpdaterFactSequencedHinter)
 	_ = t.Encs.AddHinter(KeyUpdater{})
 	_ = t.Encs.AddHinter(FeeOperationFact{})
 	_ = t.Encs.AddHinter(FeeOperation{})
 	_ = t.Encs.AddHinter(Account{})
 	_ = t.Encs.AddHinter(CurrencyDesign{})
 	_ = t.Encs.AddHinter(CurrencyPolicyUpdaterFact{})
+	_ = t.Encs.AddHinter(CurrencyPolicyUpdaterFactScheduledHinter)
 	_ = t.Encs.AddHinter(CurrencyPolicyUpdater{})
 	_ = t.Encs.AddHinter(CurrencyPolicy{})
+	_ = t.Encs.AddHinter(CurrencyPolicyMinBalanceHinter)
+	_ = t.Encs.AddHinter(CurrencyPolicyTransferLimitsHinter)
+	_ = t.Encs.AddHinter(PendingCurrencyPolicy{})
+	_ = t.Encs.AddHinter(NetworkPolicyUpdaterFact{})
+	_ = t.Encs.AddHinter(NetworkPolicyUpdater{})
+	_ = t.Encs.AddHinter(NetworkPolicy{})
 
 	t.cid = CurrencyID("SEEME")
 }
@@ -171,7 +206,11 @@ func (t *baseTestOperationProcessor) newStateBalance(a base.Address, big Big, ci
 }
 
 func (t *baseTestOperationProcessor) newCurrencyDesignState(cid CurrencyID, big Big, genesisAccount base.Address, feeer Feeer) state.State {
-	de := NewCurrencyDesign(NewAmount(big, cid), genesisAccount, NewCurrencyPolicy(ZeroBig, feeer))
+	return t.newCurrencyDesignPolicyState(cid, big, genesisAccount, NewCurrencyPolicy(ZeroBig, feeer))
+}
+
+func (t *baseTestOperationProcessor) newCurrencyDesignPolicyState(cid CurrencyID, big Big, genesisAccount base.Address, po CurrencyPolicy) state.State {
+	de := NewCurrencyDesign(NewAmount(big, cid), genesisAccount, po)
 
 	st, err := state.NewStateV0(StateKeyCurrencyDesign(cid), nil, base.NilHeight)
 	t.NoError(err)
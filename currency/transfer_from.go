@@ -0,0 +1,223 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferFromFactType = hint.MustNewType(0xa0, 0x47, "mitum-currency-transfer-from-operation-fact")
+	TransferFromFactHint = hint.MustHint(TransferFromFactType, "0.0.1")
+	TransferFromType     = hint.MustNewType(0xa0, 0x48, "mitum-currency-transfer-from-operation")
+	TransferFromHint     = hint.MustHint(TransferFromType, "0.0.1")
+)
+
+var (
+	TransferFromFactExpiringHint   = hint.MustHint(TransferFromFactType, "0.0.2")
+	TransferFromFactExpiringHinter = TransferFromFact{expiringFact: expiringFact{ht: TransferFromFactExpiringHint}}
+)
+
+// TransferFromFact moves the amounts from owner to receiver within the
+// allowances, which owner approved to spender. It is signed by spender and the
+// fee is paid by spender.
+type TransferFromFact struct {
+	expiringFact
+	h        valuehash.Hash
+	token    []byte
+	spender  base.Address
+	owner    base.Address
+	receiver base.Address
+	amounts  []Amount
+}
+
+func NewTransferFromFact(
+	token []byte,
+	spender base.Address,
+	owner base.Address,
+	receiver base.Address,
+	amounts []Amount,
+) TransferFromFact {
+	fact := TransferFromFact{
+		token:    token,
+		spender:  spender,
+		owner:    owner,
+		receiver: receiver,
+		amounts:  amounts,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferFromFact) WithExpiry(ex Expiry) TransferFromFact {
+	fact.expiringFact = newExpiringFact(TransferFromFactExpiringHint, ex)
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferFromFact) Hint() hint.Hint {
+	return fact.factHint(TransferFromFactHint)
+}
+
+func (fact TransferFromFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferFromFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferFromFact) Bytes() []byte {
+	ext := fact.expiryBytes()
+
+	bs := make([][]byte, len(fact.amounts)+4)
+	bs[0] = fact.token
+	bs[1] = fact.spender.Bytes()
+	bs[2] = fact.owner.Bytes()
+	bs[3] = fact.receiver.Bytes()
+
+	for i := range fact.amounts {
+		bs[i+4] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(append(bs, ext)...)
+}
+
+func (fact TransferFromFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return xerrors.Errorf("empty token for TransferFromFact")
+	} else if n := len(fact.amounts); n < 1 {
+		return xerrors.Errorf("empty amounts")
+	}
+
+	if err := isvalid.Check([]isvalid.IsValider{
+		fact.h,
+		fact.spender,
+		fact.owner,
+		fact.receiver,
+	}, nil, false); err != nil {
+		return err
+	}
+
+	if fact.owner.Equal(fact.spender) {
+		return xerrors.Errorf("spender is same with owner, %q", fact.owner)
+	} else if fact.owner.Equal(fact.receiver) {
+		return xerrors.Errorf("receiver is same with owner, %q", fact.owner)
+	}
+
+	founds := map[CurrencyID]struct{}{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return xerrors.Errorf("duplicated currency found, %q", am.Currency())
+		} else {
+			founds[am.Currency()] = struct{}{}
+		}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return xerrors.Errorf("amount should be over zero")
+		}
+	}
+
+	if err := fact.isValidExpiry(); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact TransferFromFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferFromFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact TransferFromFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact TransferFromFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferFromFact) Amounts() []Amount {
+	return fact.amounts
+}
+
+func (fact TransferFromFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.spender, fact.owner}
+	if !fact.receiver.Equal(fact.spender) {
+		as = append(as, fact.receiver)
+	}
+
+	return as, nil
+}
+
+type TransferFrom struct {
+	operation.BaseOperation
+	Memo string
+}
+
+func NewTransferFrom(fact TransferFromFact, fs []operation.FactSign, memo string) (TransferFrom, error) {
+	if bo, err := operation.NewBaseOperationFromFact(TransferFromHint, fact, fs); err != nil {
+		return TransferFrom{}, err
+	} else {
+		op := TransferFrom{BaseOperation: bo, Memo: memo}
+
+		op.BaseOperation = bo.SetHash(op.GenerateHash())
+
+		return op, nil
+	}
+}
+
+func (op TransferFrom) Hint() hint.Hint {
+	return TransferFromHint
+}
+
+func (op TransferFrom) IsValid(networkID []byte) error {
+	if err := IsValidMemo(op.Memo); err != nil {
+		return err
+	}
+
+	return operation.IsValidOperation(op, networkID)
+}
+
+func (op TransferFrom) GenerateHash() valuehash.Hash {
+	bs := make([][]byte, len(op.Signs())+1)
+	for i := range op.Signs() {
+		bs[i] = op.Signs()[i].Bytes()
+	}
+
+	bs[len(bs)-1] = []byte(op.Memo)
+
+	e := util.ConcatBytesSlice(op.Fact().Hash().Bytes(), util.ConcatBytesSlice(bs...))
+
+	return valuehash.NewSHA256(e)
+}
+
+func (op TransferFrom) AddFactSigns(fs ...operation.FactSign) (operation.FactSignUpdater, error) {
+	if o, err := op.BaseOperation.AddFactSigns(fs...); err != nil {
+		return nil, err
+	} else {
+		op.BaseOperation = o.(operation.BaseOperation)
+	}
+
+	op.BaseOperation = op.SetHash(op.GenerateHash())
+
+	return op, nil
+}
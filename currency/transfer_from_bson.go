@@ -0,0 +1,82 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact TransferFromFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"hash":     fact.h,
+		"token":    fact.token,
+		"spender":  fact.spender,
+		"owner":    fact.owner,
+		"receiver": fact.receiver,
+		"amounts":  fact.amounts,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
+}
+
+type TransferFromFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SP base.AddressDecoder `bson:"spender"`
+	OW base.AddressDecoder `bson:"owner"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM []bson.Raw          `bson:"amounts"`
+	EX *Expiry             `bson:"expiry,omitempty"`
+}
+
+func (fact *TransferFromFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact TransferFromFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ufact.AM))
+	for i := range ufact.AM {
+		ams[i] = ufact.AM[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SP, ufact.OW, ufact.RC, ams, ufact.EX)
+}
+
+func (op TransferFrom) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(
+			op.BaseOperation.BSONM(),
+			bson.M{"memo": op.Memo},
+		))
+}
+
+func (op *TransferFrom) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = TransferFrom{BaseOperation: ubo}
+
+	var um MemoBSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *TransferFromFact) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	h valuehash.Hash,
+	token []byte,
+	bSpender base.AddressDecoder,
+	bOwner base.AddressDecoder,
+	bReceiver base.AddressDecoder,
+	bams [][]byte,
+	ex *Expiry,
+) error {
+	if a, err := bSpender.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.spender = a
+	}
+
+	if a, err := bOwner.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.owner = a
+	}
+
+	if a, err := bReceiver.Encode(enc); err != nil {
+		return err
+	} else {
+		fact.receiver = a
+	}
+
+	ams := make([]Amount, len(bams))
+	for i := range bams {
+		if j, err := DecodeAmount(enc, bams[i]); err != nil {
+			return err
+		} else {
+			ams[i] = j
+		}
+	}
+
+	fact.expiringFact = unpackExpiringFact(ht, TransferFromFactExpiringHint, ex)
+	fact.h = h
+	fact.token = token
+	fact.amounts = ams
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type TransferFromFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SP base.Address   `json:"spender"`
+	OW base.Address   `json:"owner"`
+	RC base.Address   `json:"receiver"`
+	AM []Amount       `json:"amounts"`
+	EX *Expiry        `json:"expiry,omitempty"`
+}
+
+func (fact TransferFromFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
+	return jsonenc.Marshal(TransferFromFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SP:         fact.spender,
+		OW:         fact.owner,
+		RC:         fact.receiver,
+		AM:         fact.amounts,
+		EX:         ex,
+	})
+}
+
+type TransferFromFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SP base.AddressDecoder `json:"spender"`
+	OW base.AddressDecoder `json:"owner"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM []json.RawMessage   `json:"amounts"`
+	EX *Expiry             `json:"expiry,omitempty"`
+}
+
+func (fact *TransferFromFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
+	var ufact TransferFromFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	ams := make([][]byte, len(ufact.AM))
+	for i := range ufact.AM {
+		ams[i] = ufact.AM[i]
+	}
+
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SP, ufact.OW, ufact.RC, ams, ufact.EX)
+}
+
+func (op TransferFrom) MarshalJSON() ([]byte, error) {
+	m := op.BaseOperation.JSONM()
+	m["memo"] = op.Memo
+
+	return jsonenc.Marshal(m)
+}
+
+func (op *TransferFrom) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo operation.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	*op = TransferFrom{BaseOperation: ubo}
+
+	var um MemoJSONUnpacker
+	if err := enc.Unmarshal(b, &um); err != nil {
+		return err
+	} else {
+		op.Memo = um.Memo
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package currency
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op TransferFrom) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type TransferFromProcessor struct {
+	cp *CurrencyPool
+	TransferFrom
+	ob       map[CurrencyID]AmountState
+	rb       map[CurrencyID]AmountState
+	sb       map[CurrencyID]AmountState
+	aw       map[CurrencyID]state.State
+	aws      map[CurrencyID]Allowance
+	required map[CurrencyID][2]Big
+	height   base.Height
+	ss       []state.State
+}
+
+func NewTransferFromProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		if i, ok := op.(TransferFrom); !ok {
+			return nil, xerrors.Errorf("not TransferFrom, %T", op)
+		} else {
+			return &TransferFromProcessor{
+				cp:           cp,
+				TransferFrom: i,
+			}, nil
+		}
+	}
+}
+
+func (opp *TransferFromProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *TransferFromProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *TransferFromProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferFromFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.spender), getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.owner), "owner", getState); err != nil {
+		return nil, err
+	} else if err := checkNotClosedAccount(fact.owner, getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.receiver), "receiver", getState); err != nil {
+		return nil, err
+	} else if err := checkNotClosedAccount(fact.receiver, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkTransferAmounts(opp.cp, fact.amounts); err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if required, err := CalculateItemsFee(opp.cp, []AmountsItem{fact}); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else {
+		opp.required = required
+	}
+
+	if err := opp.loadAllowances(getState); err != nil {
+		return nil, err
+	}
+
+	if err := opp.loadBalances(getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.spender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *TransferFromProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(TransferFromFact)
+
+	var sts []state.State // nolint:prealloc
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		cid := am.Currency()
+		fee := opp.required[cid][1]
+
+		aw := opp.aws[cid]
+		if st, err := SetStateAllowanceValue(opp.aw[cid], aw.WithBig(aw.Amount().Big().Sub(am.Big()))); err != nil {
+			return operation.NewBaseReasonError("failed to set allowance: %w", err)
+		} else {
+			sts = append(sts, st)
+		}
+
+		sts = append(sts, opp.ob[cid].Sub(am.Big()))
+
+		if sb, found := opp.sb[cid]; found {
+			sts = append(sts, opp.rb[cid].Add(am.Big()), sb.Sub(fee).AddFee(fee))
+		} else {
+			sts = append(sts, opp.rb[cid].Add(am.Big().Sub(fee)).AddFee(fee))
+		}
+	}
+
+	sts = append(sts, opp.ss...)
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *TransferFromProcessor) loadAllowances(getState func(key string) (state.State, bool, error)) error {
+	fact := opp.Fact().(TransferFromFact)
+
+	aw := map[CurrencyID]state.State{}
+	aws := map[CurrencyID]Allowance{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+
+		var st state.State
+		if i, err := existsState(
+			StateKeyAllowance(fact.owner, fact.spender, am.Currency()), "allowance", getState,
+		); err != nil {
+			return err
+		} else {
+			st = i
+		}
+
+		switch a, err := StateAllowanceValue(st); {
+		case err != nil:
+			return operation.NewBaseReasonErrorFromError(err)
+		case a.Amount().Big().Compare(am.Big()) < 0:
+			return operation.NewBaseReasonError(
+				"insufficient allowance of spender, %s; %d !> %d", fact.spender, a.Amount().Big(), am.Big())
+		default:
+			aw[am.Currency()] = st
+			aws[am.Currency()] = a
+		}
+	}
+
+	opp.aw = aw
+	opp.aws = aws
+
+	return nil
+}
+
+// loadBalances loads the balance states of owner, receiver and spender. The
+// fee is paid by spender; if receiver is spender, the fee is taken from the
+// received amount.
+func (opp *TransferFromProcessor) loadBalances(getState func(key string) (state.State, bool, error)) error {
+	fact := opp.Fact().(TransferFromFact)
+
+	orq := map[CurrencyID][2]Big{}
+	srq := map[CurrencyID][2]Big{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		fee := opp.required[am.Currency()][1]
+
+		orq[am.Currency()] = [2]Big{am.Big(), ZeroBig}
+		if fee.OverZero() && !fact.receiver.Equal(fact.spender) {
+			srq[am.Currency()] = [2]Big{fee, fee}
+		}
+	}
+
+	if ob, err := CheckEnoughBalance(opp.cp, fact.owner, orq, getState); err != nil {
+		return err
+	} else {
+		opp.ob = ob
+	}
+
+	if sb, err := CheckEnoughBalance(opp.cp, fact.spender, srq, getState); err != nil {
+		return err
+	} else {
+		opp.sb = sb
+	}
+
+	var ss []state.State
+	if i, err := checkSpendingLimits(fact.owner, opp.height, orq, getState); err != nil {
+		return err
+	} else {
+		ss = i
+	}
+
+	if i, err := checkSpendingLimits(fact.spender, opp.height, srq, getState); err != nil {
+		return err
+	} else {
+		ss = append(ss, i...)
+	}
+
+	opp.ss = ss
+
+	rb := map[CurrencyID]AmountState{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+
+		var st state.State
+		if j, _, err := getState(StateKeyBalance(fact.receiver, am.Currency())); err != nil {
+			return err
+		} else {
+			st = j
+		}
+
+		if _, found := opp.sb[am.Currency()]; !found {
+			var b Big = ZeroBig
+			switch j, err := StateBalanceValue(st); {
+			case err == nil:
+				b = j.Big()
+			case !xerrors.Is(err, util.NotFoundError):
+				return operation.NewBaseReasonErrorFromError(err)
+			}
+
+			if fee := opp.required[am.Currency()][1]; b.Add(am.Big()).Compare(fee) < 0 {
+				return operation.NewBaseReasonError("insufficient balance of spender for fee, %d", fee)
+			}
+		}
+
+		rb[am.Currency()] = NewAmountState(st, am.Currency())
+	}
+
+	opp.rb = rb
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/xerrors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAllowanceOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAllowanceOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(Approve{}, NewApproveProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(TransferFrom{}, NewTransferFromProcessor(cp))
+	t.NoError(err)
+	_, err = copr.(*OperationProcessor).SetProcessor(Transfers{}, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAllowanceOperations) factSigns(fact base.Fact, pks []key.Privatekey) []operation.FactSign {
+	var fs []operation.FactSign
+	for _, pk := range pks {
+		sig, err := operation.NewFactSignature(pk, fact, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		fs = append(fs, operation.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAllowanceOperations) newApprove(owner, spender base.Address, amounts []Amount, pks []key.Privatekey) Approve {
+	fact := NewApproveFact(util.UUID().Bytes(), owner, spender, amounts)
+
+	op, err := NewApprove(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAllowanceOperations) newTransferFrom(
+	spender, owner, receiver base.Address, amounts []Amount, pks []key.Privatekey,
+) TransferFrom {
+	fact := NewTransferFromFact(util.UUID().Bytes(), spender, owner, receiver, amounts)
+
+	op, err := NewTransferFrom(fact, t.factSigns(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAllowanceOperations) newAllowanceState(owner, spender base.Address, am Amount) state.State {
+	st, err := state.NewStateV0(StateKeyAllowance(owner, spender, am.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAllowanceValue(st, NewAllowance(owner, spender, am))
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAllowanceOperations) updated(pool *storage.Statepool) (map[string]Amount, map[string]Allowance) {
+	balances := map[string]Amount{}
+	allowances := map[string]Allowance{}
+	for _, stu := range pool.Updates() {
+		st := stu.GetState()
+		switch {
+		case IsStateBalanceKey(st.Key()):
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			balances[st.Key()] = am
+		case IsStateAllowanceKey(st.Key()):
+			aw, err := StateAllowanceValue(st)
+			t.NoError(err)
+			allowances[st.Key()] = aw
+		}
+	}
+
+	return balances, allowances
+}
+
+func (t *testAllowanceOperations) TestApprove() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst)
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewFixedFeeer(oa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	t.NoError(opr.Process(t.newApprove(oa.Address, sa.Address, []Amount{am}, oa.Privs())))
+
+	balances, allowances := t.updated(pool)
+
+	aw, found := allowances[StateKeyAllowance(oa.Address, sa.Address, t.cid)]
+	t.True(found)
+	t.True(aw.Owner().Equal(oa.Address))
+	t.True(aw.Spender().Equal(sa.Address))
+	t.True(aw.Amount().Equal(am))
+
+	t.True(NewBig(32).Equal(balances[StateKeyBalance(oa.Address, t.cid)].Big()))
+}
+
+func (t *testAllowanceOperations) TestApproveUnknownSpender() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(ost)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newApprove(oa.Address, sa.Address, []Amount{NewAmount(NewBig(10), t.cid)}, oa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "spender does not exist")
+}
+
+func (t *testAllowanceOperations) TestTransferFrom() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, rst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, rst, []state.State{
+		t.newAllowanceState(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)),
+	})
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewFixedFeeer(oa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(15), t.cid)
+	t.NoError(opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, []Amount{am}, sa.Privs())))
+
+	balances, allowances := t.updated(pool)
+
+	t.True(NewBig(5).Equal(allowances[StateKeyAllowance(oa.Address, sa.Address, t.cid)].Amount().Big()))
+	t.True(NewBig(18).Equal(balances[StateKeyBalance(oa.Address, t.cid)].Big()))
+	t.True(NewBig(2).Equal(balances[StateKeyBalance(sa.Address, t.cid)].Big()))
+	t.True(NewBig(15).Equal(balances[StateKeyBalance(ra.Address, t.cid)].Big()))
+}
+
+func (t *testAllowanceOperations) TestTransferFromTransferLimits() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sa, sst := t.newAccount(true, nil)
+	ra, rst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, rst, []state.State{
+		t.newAllowanceState(oa.Address, sa.Address, NewAmount(NewBig(100), t.cid)),
+	})
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(oa.Address, ZeroBig)).WithTransferLimits(NewBig(5), NewBig(10))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	for _, c := range []struct {
+		big int64
+		err string
+	}{
+		{4, "under min transfer amount"},
+		{11, "over max transfer amount"},
+		{5, ""},
+		{10, ""},
+	} {
+		am := NewAmount(NewBig(c.big), t.cid)
+		err := opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, []Amount{am}, sa.Privs()))
+
+		if len(c.err) < 1 {
+			t.NoError(err, "amount=%d", c.big)
+
+			continue
+		}
+
+		var oper operation.ReasonError
+		t.True(xerrors.As(err, &oper))
+		t.Contains(err.Error(), c.err, "amount=%d", c.big)
+	}
+}
+
+func (t *testAllowanceOperations) TestTransferFromToSpender() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, []state.State{
+		t.newAllowanceState(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid)),
+	})
+
+	fee := NewBig(1)
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewFixedFeeer(oa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(15), t.cid)
+	t.NoError(opr.Process(t.newTransferFrom(sa.Address, oa.Address, sa.Address, []Amount{am}, sa.Privs())))
+
+	balances, _ := t.updated(pool)
+
+	t.True(NewBig(18).Equal(balances[StateKeyBalance(oa.Address, t.cid)].Big()))
+	t.True(NewBig(14).Equal(balances[StateKeyBalance(sa.Address, t.cid)].Big()))
+}
+
+func (t *testAllowanceOperations) TestTransferFromOverAllowance() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, rst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, rst, []state.State{
+		t.newAllowanceState(oa.Address, sa.Address, NewAmount(NewBig(10), t.cid)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransferFrom(
+		sa.Address, oa.Address, ra.Address, []Amount{NewAmount(NewBig(11), t.cid)}, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient allowance")
+}
+
+func (t *testAllowanceOperations) TestTransferFromWithoutAllowance() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, rst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, rst)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransferFrom(
+		sa.Address, oa.Address, ra.Address, []Amount{NewAmount(NewBig(1), t.cid)}, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "allowance does not exist")
+}
+
+func (t *testAllowanceOperations) TestTransferFromSignedByOwner() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, rst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, rst, []state.State{
+		t.newAllowanceState(oa.Address, sa.Address, NewAmount(NewBig(10), t.cid)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransferFrom(
+		sa.Address, oa.Address, ra.Address, []Amount{NewAmount(NewBig(1), t.cid)}, oa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testAllowanceOperations) TestTransferFromOwnerSendsInProposal() {
+	oa, ost := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, sst := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+	ra, rst := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(ost, sst, rst, []state.State{
+		t.newAllowanceState(oa.Address, sa.Address, NewAmount(NewBig(30), t.cid)),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), oa.Address, NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	tf := NewTransfersFact(util.UUID().Bytes(), oa.Address, []TransfersItem{
+		NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(20), t.cid)),
+	})
+	top, err := NewTransfers(tf, t.factSigns(tf, oa.Privs()), "")
+	t.NoError(err)
+	t.NoError(opr.Process(top))
+
+	// NOTE the balance of owner is already debited by the previous operation
+	err = opr.Process(t.newTransferFrom(
+		sa.Address, oa.Address, ra.Address, []Amount{NewAmount(NewBig(20), t.cid)}, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+
+	t.NoError(opr.Process(t.newTransferFrom(
+		sa.Address, oa.Address, ra.Address, []Amount{NewAmount(NewBig(13), t.cid)}, sa.Privs())))
+}
+
+func TestAllowanceOperations(t *testing.T) {
+	suite.Run(t, new(testAllowanceOperations))
+}
@@ -0,0 +1,183 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testTransferFrom struct {
+	baseTest
+}
+
+func (t *testTransferFrom) TestNew() {
+	spk := key.MustNewBTCPrivatekey()
+
+	fact := NewTransferFromFact(
+		util.UUID().Bytes(), NewTestAddress(), NewTestAddress(), NewTestAddress(),
+		[]Amount{NewAmount(NewBig(10), t.cid)},
+	)
+	sig, err := operation.NewFactSignature(spk, fact, nil)
+	t.NoError(err)
+
+	op, err := NewTransferFrom(fact, []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func (t *testTransferFrom) TestSameOwnerAndReceiver() {
+	owner := NewTestAddress()
+	fact := NewTransferFromFact(
+		util.UUID().Bytes(), NewTestAddress(), owner, owner,
+		[]Amount{NewAmount(NewBig(10), t.cid)},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "receiver is same with owner")
+}
+
+func (t *testTransferFrom) TestZeroAmount() {
+	fact := NewTransferFromFact(
+		util.UUID().Bytes(), NewTestAddress(), NewTestAddress(), NewTestAddress(),
+		[]Amount{NewAmount(ZeroBig, t.cid)},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "amount should be over zero")
+}
+
+func (t *testTransferFrom) TestApproveZeroAmount() {
+	fact := NewApproveFact(
+		util.UUID().Bytes(), NewTestAddress(), NewTestAddress(), []Amount{NewAmount(ZeroBig, t.cid)},
+	)
+
+	t.NoError(fact.IsValid(nil))
+}
+
+func (t *testTransferFrom) TestApproveSameSpender() {
+	owner := NewTestAddress()
+	fact := NewApproveFact(
+		util.UUID().Bytes(), owner, owner, []Amount{NewAmount(NewBig(10), t.cid)},
+	)
+
+	err := fact.IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "spender is same with owner")
+}
+
+func TestTransferFrom(t *testing.T) {
+	suite.Run(t, new(testTransferFrom))
+}
+
+func testTransferFromEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		spk := key.MustNewBTCPrivatekey()
+
+		fact := NewTransferFromFact(
+			util.UUID().Bytes(), NewTestAddress(), NewTestAddress(), NewTestAddress(),
+			[]Amount{NewAmount(NewBig(10), CurrencyID("SEEME"))},
+		)
+		sig, err := operation.NewFactSignature(spk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewTransferFrom(
+			fact, []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(TransferFrom)
+		cb := b.(TransferFrom)
+
+		t.Equal(ca.Memo, cb.Memo)
+
+		fact := ca.Fact().(TransferFromFact)
+		ufact := cb.Fact().(TransferFromFact)
+
+		t.True(fact.spender.Equal(ufact.spender))
+		t.True(fact.owner.Equal(ufact.owner))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.Equal(len(fact.amounts), len(ufact.amounts))
+		for i := range fact.amounts {
+			t.True(fact.amounts[i].Equal(ufact.amounts[i]))
+		}
+	}
+
+	return t
+}
+
+func TestTransferFromEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransferFromEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransferFromEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransferFromEncode(bsonenc.NewEncoder()))
+}
+
+func testApproveEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		spk := key.MustNewBTCPrivatekey()
+
+		fact := NewApproveFact(
+			util.UUID().Bytes(), NewTestAddress(), NewTestAddress(),
+			[]Amount{NewAmount(NewBig(10), CurrencyID("SEEME")), NewAmount(ZeroBig, CurrencyID("FINDME"))},
+		)
+		sig, err := operation.NewFactSignature(spk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewApprove(
+			fact, []operation.FactSign{operation.NewBaseFactSign(spk.Publickey(), sig)}, util.UUID().String())
+		t.NoError(err)
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(Approve)
+		cb := b.(Approve)
+
+		t.Equal(ca.Memo, cb.Memo)
+
+		fact := ca.Fact().(ApproveFact)
+		ufact := cb.Fact().(ApproveFact)
+
+		t.True(fact.owner.Equal(ufact.owner))
+		t.True(fact.spender.Equal(ufact.spender))
+		t.Equal(len(fact.amounts), len(ufact.amounts))
+		for i := range fact.amounts {
+			t.True(fact.amounts[i].Equal(ufact.amounts[i]))
+		}
+	}
+
+	return t
+}
+
+func TestApproveEncodeJSON(t *testing.T) {
+	suite.Run(t, testApproveEncode(jsonenc.NewEncoder()))
+}
+
+func TestApproveEncodeBSON(t *testing.T) {
+	suite.Run(t, testApproveEncode(bsonenc.NewEncoder()))
+}
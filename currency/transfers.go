@@ -18,7 +18,22 @@ var (
 	TransfersHint     = hint.MustHint(TransfersType, "0.0.1")
 )
 
-var MaxTransferItems uint = 10
+var (
+	TransfersFactExpiringHint   = hint.MustHint(TransfersFactType, "0.0.2")
+	TransfersFactExpiringHinter = TransfersFact{hint: TransfersFactExpiringHint}
+)
+
+var (
+	TransfersFactSequencedHint   = hint.MustHint(TransfersFactType, "0.0.3")
+	TransfersFactSequencedHinter = TransfersFact{hint: TransfersFactSequencedHint}
+)
+
+// MaxTransferItems is the upper bound of the number of transfer items, which is
+// checked without the network policy; the actual limit is set by NetworkPolicy.
+var MaxTransferItems uint = 1000
+
+// DefaultMaxTransferItems is the transfer items limit of DefaultNetworkPolicy.
+var DefaultMaxTransferItems uint = 10
 
 type TransfersItem interface {
 	hint.Hinter
@@ -30,10 +45,13 @@ type TransfersItem interface {
 }
 
 type TransfersFact struct {
-	h      valuehash.Hash
-	token  []byte
-	sender base.Address
-	items  []TransfersItem
+	hint     hint.Hint
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	items    []TransfersItem
+	expiry   Expiry
+	sequence uint64
 }
 
 func NewTransfersFact(token []byte, sender base.Address, items []TransfersItem) TransfersFact {
@@ -47,8 +65,37 @@ func NewTransfersFact(token []byte, sender base.Address, items []TransfersItem)
 	return fact
 }
 
+// WithExpiry sets the Expiry; the sequenced fact keeps the sequenced hint.
+func (fact TransfersFact) WithExpiry(ex Expiry) TransfersFact {
+	if !fact.IsSequenced() {
+		fact.hint = TransfersFactExpiringHint
+	}
+
+	fact.expiry = ex
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+// WithSequence returns the sequenced TransfersFact; the operation is accepted
+// only with the next sequence of sender. The Expiry is kept.
+func (fact TransfersFact) WithSequence(sequence uint64) TransfersFact {
+	fact.hint = TransfersFactSequencedHint
+	fact.sequence = sequence
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
 func (fact TransfersFact) Hint() hint.Hint {
-	return TransfersFactHint
+	switch {
+	case fact.hint.Equal(TransfersFactSequencedHint):
+		return TransfersFactSequencedHint
+	case fact.hint.Equal(TransfersFactExpiringHint):
+		return TransfersFactExpiringHint
+	default:
+		return TransfersFactHint
+	}
 }
 
 func (fact TransfersFact) Hash() valuehash.Hash {
@@ -69,10 +116,19 @@ func (fact TransfersFact) Bytes() []byte {
 		its[i] = fact.items[i].Bytes()
 	}
 
+	var ex []byte
+	switch ht := fact.Hint(); {
+	case ht.Equal(TransfersFactSequencedHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), fact.expiry.Bytes(), util.Uint64ToBytes(fact.sequence))
+	case ht.Equal(TransfersFactExpiringHint):
+		ex = util.ConcatBytesSlice(ht.Bytes(), fact.expiry.Bytes())
+	}
+
 	return util.ConcatBytesSlice(
 		fact.token,
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(its...),
+		ex,
 	)
 }
 
@@ -89,6 +145,16 @@ func (fact TransfersFact) IsValid([]byte) error {
 		return err
 	}
 
+	if fact.IsExpiring() {
+		if err := fact.expiry.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.IsSequenced() && fact.sequence < 1 {
+		return xerrors.Errorf("sequence should be over zero")
+	}
+
 	foundReceivers := map[string]struct{}{}
 	for i := range fact.items {
 		it := fact.items[i]
@@ -122,6 +188,26 @@ func (fact TransfersFact) Items() []TransfersItem {
 	return fact.items
 }
 
+func (fact TransfersFact) Expiry() Expiry {
+	return fact.expiry
+}
+
+func (fact TransfersFact) IsExpiring() bool {
+	return fact.Hint().Equal(TransfersFactExpiringHint) || (fact.IsSequenced() && !fact.expiry.IsEmpty())
+}
+
+func (fact TransfersFact) Sequence() uint64 {
+	return fact.sequence
+}
+
+func (fact TransfersFact) IsSequenced() bool {
+	return fact.Hint().Equal(TransfersFactSequencedHint)
+}
+
+func (fact TransfersFact) SequenceAccount() base.Address {
+	return fact.sender
+}
+
 func (fact TransfersFact) Rebulild() TransfersFact {
 	items := make([]TransfersItem, len(fact.items))
 	for i := range fact.items {
@@ -10,14 +10,22 @@ import (
 )
 
 func (fact TransfersFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
-			bson.M{
-				"hash":   fact.h,
-				"token":  fact.token,
-				"sender": fact.sender,
-				"items":  fact.items,
-			}))
+	m := bson.M{
+		"hash":   fact.h,
+		"token":  fact.token,
+		"sender": fact.sender,
+		"items":  fact.items,
+	}
+
+	if fact.IsExpiring() {
+		m["expiry"] = fact.expiry
+	}
+
+	if fact.IsSequenced() {
+		m["sequence"] = fact.sequence
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()), m))
 }
 
 type TransfersFactBSONUnpacker struct {
@@ -25,9 +33,16 @@ type TransfersFactBSONUnpacker struct {
 	TK []byte              `bson:"token"`
 	SD base.AddressDecoder `bson:"sender"`
 	IT []bson.Raw          `bson:"items"`
+	EX *Expiry             `bson:"expiry,omitempty"`
+	SQ uint64              `bson:"sequence,omitempty"`
 }
 
 func (fact *TransfersFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ht bsonenc.PackHintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact TransfersFactBSONUnpacker
 	if err := enc.Unmarshal(b, &ufact); err != nil {
 		return err
@@ -38,7 +53,7 @@ func (fact *TransfersFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		its[i] = ufact.IT[i]
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, its)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, its, ufact.EX, ufact.SQ)
 }
 
 func (op Transfers) MarshalBSON() ([]byte, error) {
@@ -37,10 +37,13 @@ func (it *BaseTransfersItem) unpack(
 
 func (fact *TransfersFact) unpack(
 	enc encoder.Encoder,
+	ht hint.Hint,
 	h valuehash.Hash,
 	token []byte,
 	bSender base.AddressDecoder,
 	bitems [][]byte,
+	ex *Expiry,
+	sequence uint64,
 ) error {
 	var sender base.Address
 	if a, err := bSender.Encode(enc); err != nil {
@@ -58,10 +61,17 @@ func (fact *TransfersFact) unpack(
 		}
 	}
 
+	fact.hint = ht
 	fact.h = h
 	fact.token = token
 	fact.sender = sender
 	fact.items = items
 
+	if ex != nil {
+		fact.expiry = *ex
+	}
+
+	fact.sequence = sequence
+
 	return nil
 }
@@ -15,24 +15,40 @@ type TransferFactJSONPacker struct {
 	TK []byte          `json:"token"`
 	SD base.Address    `json:"sender"`
 	IT []TransfersItem `json:"items"`
+	EX *Expiry         `json:"expiry,omitempty"`
+	SQ uint64          `json:"sequence,omitempty"`
 }
 
 func (fact TransfersFact) MarshalJSON() ([]byte, error) {
+	var ex *Expiry
+	if fact.IsExpiring() {
+		ex = &fact.expiry
+	}
+
 	return jsonenc.Marshal(TransferFactJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
 		H:          fact.h,
 		TK:         fact.token,
 		SD:         fact.sender,
 		IT:         fact.items,
+		EX:         ex,
+		SQ:         fact.sequence,
 	})
 }
 
 func (fact *TransfersFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ht jsonenc.HintedHead
+	if err := enc.Unmarshal(b, &ht); err != nil {
+		return err
+	}
+
 	var ufact struct {
 		H  valuehash.Bytes     `json:"hash"`
 		TK []byte              `json:"token"`
 		SD base.AddressDecoder `json:"sender"`
 		IT []json.RawMessage   `json:"items"`
+		EX *Expiry             `json:"expiry,omitempty"`
+		SQ uint64              `json:"sequence,omitempty"`
 	}
 	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
 		return err
@@ -43,7 +59,7 @@ func (fact *TransfersFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		its[i] = ufact.IT[i]
 	}
 
-	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, its)
+	return fact.unpack(enc, ht.H, ufact.H, ufact.TK, ufact.SD, its, ufact.EX, ufact.SQ)
 }
 
 func (op Transfers) MarshalJSON() ([]byte, error) {
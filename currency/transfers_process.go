@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -30,18 +31,18 @@ func (opp *TransfersItemProcessor) PreProcess(
 ) error {
 	if _, err := existsState(StateKeyAccount(opp.item.Receiver()), "receiver", getState); err != nil {
 		return err
+	} else if err := checkNotClosedAccount(opp.item.Receiver(), getState); err != nil {
+		return err
+	}
+
+	if err := checkTransferAmounts(opp.cp, opp.item.Amounts()); err != nil {
+		return err
 	}
 
 	rb := map[CurrencyID]AmountState{}
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
-		if opp.cp != nil {
-			if !opp.cp.Exists(am.Currency()) {
-				return xerrors.Errorf("currency not registered, %q", am.Currency())
-			}
-		}
-
 		if st, _, err := getState(StateKeyBalance(opp.item.Receiver(), am.Currency())); err != nil {
 			return err
 		} else {
@@ -67,12 +68,34 @@ func (opp *TransfersItemProcessor) Process(
 	return sts, nil
 }
 
+// checkTransferAmounts checks the amounts are in the transfer limits of
+// CurrencyPolicy.
+func checkTransferAmounts(cp *CurrencyPool, amounts []Amount) error {
+	if cp == nil {
+		return nil
+	}
+
+	for i := range amounts {
+		am := amounts[i]
+
+		if po, found := cp.Policy(am.Currency()); !found {
+			return xerrors.Errorf("currency not registered, %q", am.Currency())
+		} else if err := po.CheckTransferAmount(am.Big()); err != nil {
+			return xerrors.Errorf("invalid amount of currency, %q: %w", am.Currency(), err)
+		}
+	}
+
+	return nil
+}
+
 type TransfersProcessor struct {
 	cp *CurrencyPool
 	Transfers
 	sb       map[CurrencyID]AmountState
 	rb       []*TransfersItemProcessor
 	required map[CurrencyID][2]Big
+	height   base.Height
+	ss       []state.State
 }
 
 func NewTransfersProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -88,6 +111,14 @@ func NewTransfersProcessor(cp *CurrencyPool) GetNewProcessor {
 	}
 }
 
+func (opp *TransfersProcessor) setCurrencyPool(cp *CurrencyPool) {
+	opp.cp = cp
+}
+
+func (opp *TransfersProcessor) setProposalHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *TransfersProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
@@ -100,13 +131,19 @@ func (opp *TransfersProcessor) PreProcess(
 
 	if required, err := opp.calculateItemsFee(); err != nil {
 		return nil, operation.NewBaseReasonErrorFromError(err)
-	} else if sb, err := CheckEnoughBalance(fact.sender, required, getState); err != nil {
+	} else if sb, err := CheckEnoughBalance(opp.cp, fact.sender, required, getState); err != nil {
 		return nil, err
 	} else {
 		opp.required = required
 		opp.sb = sb
 	}
 
+	if ss, err := checkSpendingLimits(fact.sender, opp.height, opp.required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.ss = ss
+	}
+
 	rb := make([]*TransfersItemProcessor, len(fact.items))
 	for i := range fact.items {
 		c := &TransfersItemProcessor{cp: opp.cp, h: opp.Hash(), item: fact.items[i]}
@@ -117,7 +154,7 @@ func (opp *TransfersProcessor) PreProcess(
 		rb[i] = c
 	}
 
-	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+	if err := checkFactSignsByState(fact.sender, opp.Hint().Type(), opp.Signs(), getState); err != nil {
 		return nil, xerrors.Errorf("invalid signing: %w", err)
 	}
 
@@ -146,6 +183,8 @@ func (opp *TransfersProcessor) Process( // nolint:dupl
 		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
 	}
 
+	sts = append(sts, opp.ss...)
+
 	return setState(fact.Hash(), sts...)
 }
 
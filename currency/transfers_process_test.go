@@ -2,6 +2,7 @@ package currency
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/tree"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 type testTransfersOperations struct {
@@ -133,6 +135,79 @@ func (t *testTransfersOperations) TestInsufficientBalance() {
 	t.Contains(err.Error(), "insufficient balance")
 }
 
+func (t *testTransfersOperations) TestUnderMinBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(1))).WithMinBalance(NewBig(3))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE 10 - (7 + fee 1) = 2, under min balance, 3
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(7))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "under min balance")
+
+	// NOTE 10 - (6 + fee 1) = 3
+	items = []TransfersItem{t.newTransfersItem(ra.Address, NewBig(6))}
+	tf = t.newTransfer(sa.Address, sa.Privs(), items)
+
+	t.NoError(opr.Process(tf))
+
+	for _, stu := range pool.Updates() {
+		if st := stu.GetState(); st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			am, err := StateBalanceValue(st)
+			t.NoError(err)
+			t.True(NewBig(3).Equal(am.Big()))
+		}
+	}
+}
+
+func (t *testTransfersOperations) TestTransferLimits() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, ZeroBig)).WithTransferLimits(NewBig(5), NewBig(10))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignPolicyState(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	for _, c := range []struct {
+		big int64
+		err string
+	}{
+		{4, "under min transfer amount"},
+		{11, "over max transfer amount"},
+		{5, ""},
+		{10, ""},
+	} {
+		items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(c.big))}
+		err := opr.Process(t.newTransfer(sa.Address, sa.Privs(), items))
+
+		if len(c.err) < 1 {
+			t.NoError(err, "amount=%d", c.big)
+
+			continue
+		}
+
+		var oper operation.ReasonError
+		t.True(xerrors.As(err, &oper))
+		t.Contains(err.Error(), c.err, "amount=%d", c.big)
+	}
+}
+
 func (t *testTransfersOperations) TestSufficientBalance() {
 	faBalance := NewAmount(NewBig(22), t.cid)
 	saBalance := NewAmount(NewBig(33), t.cid)
@@ -330,8 +405,278 @@ func (t *testTransfersOperations) TestSameSenders() {
 
 	items = []TransfersItem{t.newTransfersItem(ra1.Address, NewBig(1))}
 	tf1 := t.newTransfer(sa.Address, sa.Privs(), items)
-	err := opr.Process(tf1)
-	t.Contains(err.Error(), "violates only one sender")
+	t.NoError(opr.Process(tf1))
+
+	// NOTE the balance of sender is already debited by the previous operations
+	items = []TransfersItem{t.newTransfersItem(ra1.Address, NewBig(2))}
+	tf2 := t.newTransfer(sa.Address, sa.Privs(), items)
+	err := opr.Process(tf2)
+
+	var oper operation.ReasonError
+	t.True(xerrors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+
+	var nam, nram0, nram1 Amount
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			nam, _ = StateBalanceValue(st.GetState())
+		} else if st.Key() == StateKeyBalance(ra0.Address, t.cid) {
+			nram0, _ = StateBalanceValue(st.GetState())
+		} else if st.Key() == StateKeyBalance(ra1.Address, t.cid) {
+			nram1, _ = StateBalanceValue(st.GetState())
+		}
+	}
+
+	t.Equal(NewBig(1), nam.Big())
+	t.Equal(NewBig(2), nram0.Big())
+	t.Equal(NewBig(2), nram1.Big())
+}
+
+func (t *testTransfersOperations) TestConcurrentInProposal() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+	rb, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	ops := []Transfers{
+		t.newTransfer(rb.Address, rb.Privs(), []TransfersItem{t.newTransfersItem(sa.Address, NewBig(5))}),
+		t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(12))}),
+		t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(4))}),
+		t.newTransfer(ra.Address, ra.Privs(), []TransfersItem{t.newTransfersItem(rb.Address, NewBig(1))}),
+	}
+
+	pops := make([]state.Processor, len(ops))
+	for i := range ops {
+		pop, err := opr.PreProcess(ops[i])
+		t.NoError(err)
+
+		pops[i] = pop
+	}
+
+	// NOTE process in reverse order; the result should be same with the
+	// processing in the order of proposal.
+	errs := make([]error, len(ops))
+
+	var wg sync.WaitGroup
+	wg.Add(len(pops))
+	for i := len(pops) - 1; i >= 0; i-- {
+		i := i
+		go func() {
+			defer wg.Done()
+
+			errs[i] = opr.Process(pops[i])
+		}()
+	}
+
+	wg.Wait()
+
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+	t.Error(errs[2])
+	t.Contains(errs[2].Error(), "insufficient balance")
+	t.NoError(errs[3])
+
+	t.NoError(opr.Close())
+
+	balances := map[string]Big{}
+	for _, st := range pool.Updates() {
+		am, err := StateBalanceValue(st.GetState())
+		t.NoError(err)
+
+		balances[st.Key()] = am.Big()
+	}
+
+	t.Equal(NewBig(3), balances[StateKeyBalance(sa.Address, t.cid)])
+	t.Equal(NewBig(11), balances[StateKeyBalance(ra.Address, t.cid)])
+	t.Equal(NewBig(1), balances[StateKeyBalance(rb.Address, t.cid)])
+}
+
+func (t *testTransfersOperations) TestFilteredInProposal() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	ops := []Transfers{
+		t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))}),
+		t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(2))}),
+		t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}),
+	}
+
+	var filtered atomic.Value
+	filtered.Store(false)
+
+	copr := t.processor(cp, nil).(*OperationProcessor)
+	copr.SetHasOperationFact(func(h valuehash.Hash) (bool, error) {
+		return filtered.Load().(bool) && h.Equal(ops[1].Fact().Hash()), nil
+	})
+
+	opr := copr.New(pool)
+
+	pops := make([]state.Processor, len(ops))
+	for i := range ops {
+		pop, err := opr.PreProcess(ops[i])
+		t.NoError(err)
+
+		pops[i] = pop
+	}
+
+	// NOTE the second operation is dropped by the work filter after PreProcess;
+	// the next operation does not wait for it.
+	filtered.Store(true)
+
+	errch := make(chan error, 1)
+	go func() {
+		errch <- opr.Process(pops[2])
+	}()
+
+	t.NoError(opr.Process(pops[0]))
+
+	select {
+	case <-time.After(time.Second * 3):
+		t.NoError(xerrors.Errorf("operation waits the filtered operation"))
+	case err := <-errch:
+		t.NoError(err)
+	}
+
+	select {
+	case <-pops[1].(*pendingOperation).done:
+	default:
+		t.NoError(xerrors.Errorf("filtered operation not closed"))
+	}
+
+	t.NoError(opr.Close())
+
+	balances := map[string]Big{}
+	for _, st := range pool.Updates() {
+		am, err := StateBalanceValue(st.GetState())
+		t.NoError(err)
+
+		balances[st.Key()] = am.Big()
+	}
+
+	t.Equal(NewBig(6), balances[StateKeyBalance(sa.Address, t.cid)])
+	t.Equal(NewBig(4), balances[StateKeyBalance(ra.Address, t.cid)])
+}
+
+func (t *testTransfersOperations) TestDynamicFee() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+	fa, st2 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+	feeer := NewDynamicFeeer(fa.Address, NewBig(8), UnlimitedMaxFeeAmount, 1, 8)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	copr := t.processor(cp, nil)
+	opr := copr.New(pool)
+	t.True(opr == copr.New(pool))
+
+	for i := 0; i < 2; i++ {
+		tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))})
+		t.NoError(opr.Process(tf))
+	}
+
+	t.NoError(opr.Close())
+	t.NoError(opr.Close())
+
+	var bst state.State
+	balances := map[string]Big{}
+	for _, st := range pool.Updates() {
+		if IsStateBaseFeeKey(st.Key()) {
+			bst = st.GetState()
+
+			continue
+		}
+
+		am, err := StateBalanceValue(st.GetState())
+		t.NoError(err)
+
+		balances[st.Key()] = am.Big()
+	}
+
+	t.Equal(NewBig(100-2-16), balances[StateKeyBalance(sa.Address, t.cid)])
+	t.Equal(NewBig(16), balances[StateKeyBalance(fa.Address, t.cid)])
+
+	// NOTE 2 items of 100, the default capacity, are over the target, 1%; the
+	// base fee rises by 1/8.
+	t.NotNil(bst)
+	t.Equal(StateKeyBaseFee(t.cid), bst.Key())
+
+	am, err := StateBaseFeeValue(bst)
+	t.NoError(err)
+	t.Equal(NewBig(9), am.Big())
+
+	t.NoError(cp.Set(bst))
+
+	i, found := cp.Feeer(t.cid)
+	t.True(found)
+
+	fee, err := i.Fee(NewBig(1))
+	t.NoError(err)
+	t.Equal(NewBig(9), fee)
+}
+
+func (t *testTransfersOperations) TestDynamicFeeAfterEmptyBlocks() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1000), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+	fa, st2 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+	feeer := NewDynamicFeeer(fa.Address, NewBig(8), UnlimitedMaxFeeAmount, 50, 8)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	// NOTE the base fee was set 3 blocks ago; the 2 empty blocks after it have
+	// no operation.
+	st, err := state.NewStateV0(StateKeyBaseFee(t.cid), nil, pool.Height()-3)
+	t.NoError(err)
+	bst, err := SetStateBaseFeeValue(st, NewAmount(NewBig(800), t.cid))
+	t.NoError(err)
+	t.NoError(cp.Set(bst))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))})
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+
+	var nst state.State
+	balances := map[string]Big{}
+	for _, st := range pool.Updates() {
+		if IsStateBaseFeeKey(st.Key()) {
+			nst = st.GetState()
+
+			continue
+		}
+
+		am, err := StateBalanceValue(st.GetState())
+		t.NoError(err)
+
+		balances[st.Key()] = am.Big()
+	}
+
+	// NOTE 800 -> 700 -> 613
+	t.Equal(NewBig(613), balances[StateKeyBalance(fa.Address, t.cid)])
+
+	// NOTE 1 item of 100 is under the target, 50%
+	t.NotNil(nst)
+	am, err := StateBaseFeeValue(nst)
+	t.NoError(err)
+	t.Equal(NewBig(538), am.Big())
 }
 
 func (t *testTransfersOperations) TestUnderThreshold() {
@@ -376,6 +721,37 @@ func (t *testTransfersOperations) TestUnderThreshold() {
 	t.Contains(err.Error(), "not passed threshold")
 }
 
+func (t *testTransfersOperations) TestThresholdByOperationType() {
+	hpk := key.MustNewBTCPrivatekey()
+	cpk := key.MustNewBTCPrivatekey()
+
+	skeys, err := NewKeysWithThresholds(
+		[]Key{t.newKey(hpk.Publickey(), 30), t.newKey(cpk.Publickey(), 70)},
+		100,
+		map[hint.Type]uint{TransfersType: 30},
+	)
+	t.NoError(err)
+
+	sender, _ := NewAddressFromKeys(skeys)
+	ra, st := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	sts := append(st,
+		t.newStateBalance(sender, NewBig(33), t.cid),
+		t.newStateKeys(sender, skeys),
+	)
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	// NOTE signed only by hot key
+	tf := t.newTransfer(sender, []key.Privatekey{hpk}, []TransfersItem{t.newTransfersItem(ra.Address, NewBig(1))})
+	t.NoError(opr.Process(tf))
+}
+
 func (t *testTransfersOperations) TestUnknownKey() {
 	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
 	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
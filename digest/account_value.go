@@ -4,6 +4,7 @@ import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
 	"golang.org/x/xerrors"
 
 	"github.com/spikeekips/mitum-currency/currency"
@@ -19,6 +20,10 @@ type AccountValue struct {
 	balance        []currency.Amount
 	height         base.Height
 	previousHeight base.Height
+	closedTo       base.Address
+	closedBy       valuehash.Hash
+	data           currency.AccountData
+	sequence       uint64
 }
 
 func NewAccountValue(st state.State) (AccountValue, error) {
@@ -76,3 +81,48 @@ func (va AccountValue) SetBalance(balance []currency.Amount) AccountValue {
 
 	return va
 }
+
+// Data returns the key-value metadata of account, which is set by
+// currency.AccountDataUpdater.
+func (va AccountValue) Data() currency.AccountData {
+	return va.data
+}
+
+func (va AccountValue) SetData(data currency.AccountData) AccountValue {
+	va.data = data
+
+	return va
+}
+
+// Sequence returns the last sequence of account; the next operation of
+// account should have the next of it.
+func (va AccountValue) Sequence() uint64 {
+	return va.sequence
+}
+
+func (va AccountValue) SetSequence(sequence uint64) AccountValue {
+	va.sequence = sequence
+
+	return va
+}
+
+func (va AccountValue) IsClosed() bool {
+	return va.closedBy != nil
+}
+
+// ClosedTo returns the account, which the balances were merged to.
+func (va AccountValue) ClosedTo() base.Address {
+	return va.closedTo
+}
+
+// ClosedBy returns the fact hash of the currency.AccountMerge operation.
+func (va AccountValue) ClosedBy() valuehash.Hash {
+	return va.closedBy
+}
+
+func (va AccountValue) SetClosed(to base.Address, by valuehash.Hash) AccountValue {
+	va.closedTo = to
+	va.closedBy = by
+
+	return va
+}
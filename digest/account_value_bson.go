@@ -3,6 +3,7 @@ package digest
 import (
 	"github.com/spikeekips/mitum/base"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
@@ -14,15 +15,23 @@ func (va AccountValue) MarshalBSON() ([]byte, error) {
 			"balance":         va.balance,
 			"height":          va.height,
 			"previous_height": va.previousHeight,
+			"closed_to":       va.closedTo,
+			"closed_by":       va.closedBy,
+			"data":            va.data,
+			"sequence":        va.sequence,
 		},
 	))
 }
 
 type AccountValueBSONUnpacker struct {
-	AC bson.Raw    `bson:"ac"`
-	BL []bson.Raw  `bson:"balance"`
-	HT base.Height `bson:"height"`
-	PT base.Height `bson:"previous_height"`
+	AC bson.Raw            `bson:"ac"`
+	BL []bson.Raw          `bson:"balance"`
+	HT base.Height         `bson:"height"`
+	PT base.Height         `bson:"previous_height"`
+	CT base.AddressDecoder `bson:"closed_to"`
+	CB valuehash.Bytes     `bson:"closed_by"`
+	DT bson.Raw            `bson:"data,omitempty"`
+	SQ uint64              `bson:"sequence"`
 }
 
 func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +45,5 @@ func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		bb[i] = uva.BL[i]
 	}
 
-	return va.unpack(enc, uva.AC, bb, uva.HT, uva.PT)
+	return va.unpack(enc, uva.AC, bb, uva.HT, uva.PT, uva.CT, uva.CB, uva.DT, uva.SQ)
 }
@@ -4,9 +4,20 @@ import (
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"golang.org/x/xerrors"
 )
 
-func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bb [][]byte, height, previousHeight base.Height) error {
+func (va *AccountValue) unpack(
+	enc encoder.Encoder,
+	bac []byte,
+	bb [][]byte,
+	height, previousHeight base.Height,
+	bClosedTo base.AddressDecoder,
+	closedBy valuehash.Bytes,
+	bdata []byte,
+	sequence uint64,
+) error {
 	if bac != nil {
 		if i, err := currency.DecodeAccount(enc, bac); err != nil {
 			return err
@@ -24,9 +35,29 @@ func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bb [][]byte, hei
 		}
 	}
 
+	if len(closedBy) > 0 {
+		if a, err := bClosedTo.Encode(enc); err != nil {
+			return err
+		} else {
+			va.closedTo = a
+			va.closedBy = closedBy
+		}
+	}
+
+	if len(bdata) > 0 {
+		if hinter, err := enc.DecodeByHint(bdata); err != nil {
+			return err
+		} else if i, ok := hinter.(currency.AccountData); !ok {
+			return xerrors.Errorf("not currency.AccountData: %T", hinter)
+		} else {
+			va.data = i
+		}
+	}
+
 	va.balance = balance
 	va.height = height
 	va.previousHeight = previousHeight
+	va.sequence = sequence
 
 	return nil
 }
@@ -6,14 +6,19 @@ import (
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 type AccountValueJSONPacker struct {
 	jsonenc.HintedHead
 	currency.AccountPackerJSON
-	BL []currency.Amount `json:"balance"`
-	HT base.Height       `json:"height"`
-	PT base.Height       `json:"previous_height"`
+	BL []currency.Amount    `json:"balance"`
+	HT base.Height          `json:"height"`
+	PT base.Height          `json:"previous_height"`
+	CT base.Address         `json:"closed_to,omitempty"`
+	CB valuehash.Hash       `json:"closed_by,omitempty"`
+	DT currency.AccountData `json:"data"`
+	SQ uint64               `json:"sequence"`
 }
 
 func (va AccountValue) MarshalJSON() ([]byte, error) {
@@ -23,13 +28,21 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 		BL:                va.balance,
 		HT:                va.height,
 		PT:                va.previousHeight,
+		CT:                va.closedTo,
+		CB:                va.closedBy,
+		DT:                va.data,
+		SQ:                va.sequence,
 	})
 }
 
 type AccountValueJSONUnpacker struct {
-	BL []json.RawMessage `json:"balance"`
-	HT base.Height       `json:"height"`
-	PT base.Height       `json:"previous_height"`
+	BL []json.RawMessage   `json:"balance"`
+	HT base.Height         `json:"height"`
+	PT base.Height         `json:"previous_height"`
+	CT base.AddressDecoder `json:"closed_to"`
+	CB valuehash.Bytes     `json:"closed_by"`
+	DT json.RawMessage     `json:"data"`
+	SQ uint64              `json:"sequence"`
 }
 
 func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -44,7 +57,7 @@ func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	}
 
 	ac := new(currency.Account)
-	if err := va.unpack(enc, nil, bb, uva.HT, uva.PT); err != nil {
+	if err := va.unpack(enc, nil, bb, uva.HT, uva.PT, uva.CT, uva.CB, uva.DT, uva.SQ); err != nil {
 		return err
 	} else if err := ac.UnpackJSON(b, enc); err != nil {
 		return err
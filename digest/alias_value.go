@@ -0,0 +1,73 @@
+package digest
+
+import (
+	"strings"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	AliasValueType = hint.MustNewType(0xa0, 0x65, "mitum-currency-alias-value")
+	AliasValueHint = hint.MustHint(AliasValueType, "0.0.1")
+)
+
+// AliasValue is the alias with the fact hashes of the operations, which updated
+// it in the block. The address of the released alias is empty.
+type AliasValue struct {
+	name           currency.AliasName
+	address        base.Address
+	operations     []valuehash.Hash
+	height         base.Height
+	previousHeight base.Height
+}
+
+func NewAliasValue(st state.State) (AliasValue, error) {
+	va := AliasValue{
+		name:           currency.AliasName(strings.TrimPrefix(st.Key(), currency.StateKeyAliasPrefix)),
+		operations:     st.Operations(),
+		height:         st.Height(),
+		previousHeight: st.PreviousHeight(),
+	}
+
+	switch al, found, err := currency.StateAliasValue(st); {
+	case err != nil:
+		return AliasValue{}, err
+	case found:
+		va.address = al.Address()
+	}
+
+	return va, nil
+}
+
+func (va AliasValue) Hint() hint.Hint {
+	return AliasValueHint
+}
+
+func (va AliasValue) Name() currency.AliasName {
+	return va.name
+}
+
+func (va AliasValue) Address() base.Address {
+	return va.address
+}
+
+func (va AliasValue) IsReleased() bool {
+	return va.address == nil
+}
+
+func (va AliasValue) Operations() []valuehash.Hash {
+	return va.operations
+}
+
+func (va AliasValue) Height() base.Height {
+	return va.height
+}
+
+func (va AliasValue) PreviousHeight() base.Height {
+	return va.previousHeight
+}
@@ -0,0 +1,43 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (va AliasValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(va.Hint()),
+		bson.M{
+			"name":            va.name,
+			"address":         va.address,
+			"operations":      va.operations,
+			"height":          va.height,
+			"previous_height": va.previousHeight,
+		},
+	))
+}
+
+type AliasValueBSONUnpacker struct {
+	NM string              `bson:"name"`
+	AD base.AddressDecoder `bson:"address"`
+	OP []valuehash.Bytes   `bson:"operations"`
+	HT base.Height         `bson:"height"`
+	PT base.Height         `bson:"previous_height"`
+}
+
+func (va *AliasValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uva AliasValueBSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	ops := make([]valuehash.Hash, len(uva.OP))
+	for i := range uva.OP {
+		ops[i] = uva.OP[i]
+	}
+
+	return va.unpack(enc, uva.NM, uva.AD, ops, uva.HT, uva.PT)
+}
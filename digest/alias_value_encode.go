@@ -0,0 +1,29 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (va *AliasValue) unpack(
+	enc encoder.Encoder,
+	name string,
+	bAddress base.AddressDecoder,
+	ops []valuehash.Hash,
+	height, previousHeight base.Height,
+) error {
+	if a, err := bAddress.Encode(enc); err != nil {
+		return err
+	} else {
+		va.address = a
+	}
+
+	va.name = currency.AliasName(name)
+	va.operations = ops
+	va.height = height
+	va.previousHeight = previousHeight
+
+	return nil
+}
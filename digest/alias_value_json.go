@@ -0,0 +1,50 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AliasValueJSONPacker struct {
+	jsonenc.HintedHead
+	NM currency.AliasName `json:"name"`
+	AD base.Address       `json:"address"`
+	OP []valuehash.Hash   `json:"operations"`
+	HT base.Height        `json:"height"`
+	PT base.Height        `json:"previous_height"`
+}
+
+func (va AliasValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AliasValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		NM:         va.name,
+		AD:         va.address,
+		OP:         va.operations,
+		HT:         va.height,
+		PT:         va.previousHeight,
+	})
+}
+
+type AliasValueJSONUnpacker struct {
+	NM string              `json:"name"`
+	AD base.AddressDecoder `json:"address"`
+	OP []valuehash.Bytes   `json:"operations"`
+	HT base.Height         `json:"height"`
+	PT base.Height         `json:"previous_height"`
+}
+
+func (va *AliasValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uva AliasValueJSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	ops := make([]valuehash.Hash, len(uva.OP))
+	for i := range uva.OP {
+		ops[i] = uva.OP[i]
+	}
+
+	return va.unpack(enc, uva.NM, uva.AD, ops, uva.HT, uva.PT)
+}
@@ -0,0 +1,60 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	AllowanceValueType = hint.MustNewType(0xa0, 0x49, "mitum-currency-allowance-value")
+	AllowanceValueHint = hint.MustHint(AllowanceValueType, "0.0.1")
+)
+
+// AllowanceValue is the allowance of spender with the fact hashes of the
+// operations, which updated it in the block.
+type AllowanceValue struct {
+	aw             currency.Allowance
+	operations     []valuehash.Hash
+	height         base.Height
+	previousHeight base.Height
+}
+
+func NewAllowanceValue(st state.State) (AllowanceValue, error) {
+	var aw currency.Allowance
+	if i, err := currency.StateAllowanceValue(st); err != nil {
+		return AllowanceValue{}, err
+	} else {
+		aw = i
+	}
+
+	return AllowanceValue{
+		aw:             aw,
+		operations:     st.Operations(),
+		height:         st.Height(),
+		previousHeight: st.PreviousHeight(),
+	}, nil
+}
+
+func (va AllowanceValue) Hint() hint.Hint {
+	return AllowanceValueHint
+}
+
+func (va AllowanceValue) Allowance() currency.Allowance {
+	return va.aw
+}
+
+func (va AllowanceValue) Operations() []valuehash.Hash {
+	return va.operations
+}
+
+func (va AllowanceValue) Height() base.Height {
+	return va.height
+}
+
+func (va AllowanceValue) PreviousHeight() base.Height {
+	return va.previousHeight
+}
@@ -0,0 +1,41 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (va AllowanceValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(va.Hint()),
+		bson.M{
+			"allowance":       va.aw,
+			"operations":      va.operations,
+			"height":          va.height,
+			"previous_height": va.previousHeight,
+		},
+	))
+}
+
+type AllowanceValueBSONUnpacker struct {
+	AW bson.Raw          `bson:"allowance"`
+	OP []valuehash.Bytes `bson:"operations"`
+	HT base.Height       `bson:"height"`
+	PT base.Height       `bson:"previous_height"`
+}
+
+func (va *AllowanceValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uva AllowanceValueBSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	ops := make([]valuehash.Hash, len(uva.OP))
+	for i := range uva.OP {
+		ops[i] = uva.OP[i]
+	}
+
+	return va.unpack(enc, uva.AW, ops, uva.HT, uva.PT)
+}
@@ -0,0 +1,30 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"golang.org/x/xerrors"
+)
+
+func (va *AllowanceValue) unpack(
+	enc encoder.Encoder,
+	baw []byte,
+	ops []valuehash.Hash,
+	height, previousHeight base.Height,
+) error {
+	if hinter, err := enc.DecodeByHint(baw); err != nil {
+		return err
+	} else if i, ok := hinter.(currency.Allowance); !ok {
+		return xerrors.Errorf("not currency.Allowance: %T", hinter)
+	} else {
+		va.aw = i
+	}
+
+	va.operations = ops
+	va.height = height
+	va.previousHeight = previousHeight
+
+	return nil
+}
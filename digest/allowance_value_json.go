@@ -0,0 +1,49 @@
+package digest
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AllowanceValueJSONPacker struct {
+	jsonenc.HintedHead
+	AW currency.Allowance `json:"allowance"`
+	OP []valuehash.Hash   `json:"operations"`
+	HT base.Height        `json:"height"`
+	PT base.Height        `json:"previous_height"`
+}
+
+func (va AllowanceValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AllowanceValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		AW:         va.aw,
+		OP:         va.operations,
+		HT:         va.height,
+		PT:         va.previousHeight,
+	})
+}
+
+type AllowanceValueJSONUnpacker struct {
+	AW json.RawMessage   `json:"allowance"`
+	OP []valuehash.Bytes `json:"operations"`
+	HT base.Height       `json:"height"`
+	PT base.Height       `json:"previous_height"`
+}
+
+func (va *AllowanceValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uva AllowanceValueJSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	ops := make([]valuehash.Hash, len(uva.OP))
+	for i := range uva.OP {
+		ops[i] = uva.OP[i]
+	}
+
+	return va.unpack(enc, uva.AW, ops, uva.HT, uva.PT)
+}
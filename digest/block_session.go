@@ -11,6 +11,7 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/block"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
@@ -30,6 +31,10 @@ type BlockSession struct {
 	operationModels []mongo.WriteModel
 	accountModels   []mongo.WriteModel
 	balanceModels   []mongo.WriteModel
+	allowanceModels []mongo.WriteModel
+	aliasModels     []mongo.WriteModel
+	dataModels      []mongo.WriteModel
+	sequenceModels  []mongo.WriteModel
 	statesValue     *sync.Map
 }
 
@@ -98,6 +103,22 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 		return err
 	}
 
+	if err := bs.writeModels(ctx, defaultColNameAllowance, bs.allowanceModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameAlias, bs.aliasModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameData, bs.dataModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameSequence, bs.sequenceModels); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -176,44 +197,150 @@ func (bs *BlockSession) prepareAccounts() error {
 		return nil
 	}
 
+	closed := map[string]state.State{}
+	for i := range bs.block.States() {
+		st := bs.block.States()[i]
+		if currency.IsStateAccountClosedKey(st.Key()) {
+			closed[st.Key()[:len(st.Key())-len(currency.StateKeyAccountClosedSuffix)]] = st
+		}
+	}
+
 	var accountModels []mongo.WriteModel
 	var balanceModels []mongo.WriteModel
+	var allowanceModels []mongo.WriteModel
+	var aliasModels []mongo.WriteModel
+	var dataModels []mongo.WriteModel
+	var sequenceModels []mongo.WriteModel
 	for i := range bs.block.States() {
 		st := bs.block.States()[i]
 		switch {
 		case currency.IsStateAccountKey(st.Key()):
-			if j, err := bs.handleAccountState(st); err != nil {
+			prefix := st.Key()[:len(st.Key())-len(currency.StateKeyAccountSuffix)]
+
+			if j, err := bs.handleAccountState(st, closed[prefix]); err != nil {
 				return err
 			} else {
 				accountModels = append(accountModels, j...)
 			}
+
+			delete(closed, prefix)
 		case currency.IsStateBalanceKey(st.Key()):
 			if j, err := bs.handleBalanceState(st); err != nil {
 				return err
 			} else {
 				balanceModels = append(balanceModels, j...)
 			}
+		case currency.IsStateAllowanceKey(st.Key()):
+			if j, err := bs.handleAllowanceState(st); err != nil {
+				return err
+			} else {
+				allowanceModels = append(allowanceModels, j...)
+			}
+		case currency.IsStateAliasKey(st.Key()):
+			if j, err := bs.handleAliasState(st); err != nil {
+				return err
+			} else {
+				aliasModels = append(aliasModels, j...)
+			}
+		case currency.IsStateAccountDataKey(st.Key()):
+			if j, err := bs.handleAccountDataState(st); err != nil {
+				return err
+			} else {
+				dataModels = append(dataModels, j...)
+			}
+		case currency.IsStateSequenceKey(st.Key()):
+			if j, err := bs.handleSequenceState(st); err != nil {
+				return err
+			} else {
+				sequenceModels = append(sequenceModels, j...)
+			}
 		default:
 			continue
 		}
 	}
 
+	// NOTE the accounts, which are closed without updating account state
+	for prefix := range closed {
+		if j, err := bs.handleAccountClosedState(prefix, closed[prefix]); err != nil {
+			return err
+		} else {
+			accountModels = append(accountModels, j...)
+		}
+	}
+
 	bs.accountModels = accountModels
 	bs.balanceModels = balanceModels
+	bs.allowanceModels = allowanceModels
+	bs.aliasModels = aliasModels
+	bs.dataModels = dataModels
+	bs.sequenceModels = sequenceModels
 
 	return nil
 }
 
-func (bs *BlockSession) handleAccountState(st state.State) ([]mongo.WriteModel, error) {
-	if rs, err := NewAccountValue(st); err != nil {
+func (bs *BlockSession) handleAccountState(st, closed state.State) ([]mongo.WriteModel, error) {
+	var rs AccountValue
+	if i, err := NewAccountValue(st); err != nil {
 		return nil, err
-	} else if doc, err := NewAccountDoc(rs, bs.st.database.Encoder()); err != nil {
+	} else {
+		rs = i
+	}
+
+	if closed != nil {
+		if i, err := setAccountClosed(rs, closed); err != nil {
+			return nil, err
+		} else {
+			rs = i
+		}
+	}
+
+	if doc, err := NewAccountDoc(rs, bs.st.database.Encoder()); err != nil {
 		return nil, err
 	} else {
 		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 	}
 }
 
+func (bs *BlockSession) handleAccountClosedState(prefix string, st state.State) ([]mongo.WriteModel, error) {
+	var rs AccountValue
+	switch i, found, err := bs.st.accountByKeyPrefix(prefix); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, xerrors.Errorf("account of closed state, %q not found", st.Key())
+	default:
+		rs = i
+	}
+
+	if i, err := setAccountClosed(rs, st); err != nil {
+		return nil, err
+	} else {
+		rs = i.SetHeight(st.Height()).SetPreviousHeight(rs.Height())
+	}
+
+	if doc, err := NewAccountDoc(rs, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func setAccountClosed(rs AccountValue, st state.State) (AccountValue, error) {
+	var to base.Address
+	if ac, err := currency.StateAccountClosedValue(st); err != nil {
+		return rs, err
+	} else {
+		to = ac.Receiver()
+	}
+
+	ops := st.Operations()
+	if len(ops) < 1 {
+		return rs, xerrors.Errorf("empty operations of closed state, %q", st.Key())
+	}
+
+	return rs.SetClosed(to, ops[len(ops)-1]), nil
+}
+
 func (bs *BlockSession) handleBalanceState(st state.State) ([]mongo.WriteModel, error) {
 	if doc, err := NewBalanceDoc(st, bs.st.database.Encoder()); err != nil {
 		return nil, err
@@ -222,6 +349,38 @@ func (bs *BlockSession) handleBalanceState(st state.State) ([]mongo.WriteModel,
 	}
 }
 
+func (bs *BlockSession) handleAllowanceState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewAllowanceDoc(st, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleAliasState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewAliasDoc(st, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleAccountDataState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewAccountDataDoc(st, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleSequenceState(st state.State) ([]mongo.WriteModel, error) {
+	if doc, err := NewSequenceDoc(st, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
 func (bs *BlockSession) writeModels(ctx context.Context, col string, models []mongo.WriteModel) error {
 	started := time.Now()
 	defer func() {
@@ -271,6 +430,10 @@ func (bs *BlockSession) close() error {
 	bs.operationModels = nil
 	bs.accountModels = nil
 	bs.balanceModels = nil
+	bs.allowanceModels = nil
+	bs.aliasModels = nil
+	bs.dataModels = nil
+	bs.sequenceModels = nil
 
 	return bs.st.Close()
 }
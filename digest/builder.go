@@ -21,6 +21,7 @@ var (
 	templateSender           = currency.Address("mother")
 	templateReceiver         = currency.Address("father")
 	templateToken            = []byte("raised by")
+	templateSalt             = "brother"
 	templateSignature        = key.Signature([]byte("wolves"))
 	templateBig              = currency.NewBig(-333)
 	templateSignedAtString   = "2020-10-08T07:53:26Z"
@@ -76,14 +77,23 @@ func (bl Builder) templateCreateAccountsFact() Hal {
 		)},
 	)
 
+	// NOTE salted item can replace the item; the address of new account is
+	// derived from keys and salt.
+	salted := currency.NewCreateAccountsItemSalted(
+		nkeys,
+		[]currency.Amount{currency.NewAmount(templateBig, templateCurrencyID)},
+		templateSalt,
+	)
+
 	hal := NewBaseHal(fact, HalLink{})
 	return hal.AddExtras("default", map[string]interface{}{
 		"token":               templateToken,
 		"sender":              templateSender,
 		"items.keys.keys.key": templatePublickey,
 		"items.big":           templateBig,
+		"items.salt":          templateSalt,
 		"currency":            templateCurrencyID,
-	})
+	}).AddExtras("salted_item", salted)
 }
 
 func (bl Builder) templateKeyUpdaterFact() Hal {
@@ -201,15 +211,26 @@ func (bl Builder) buildFactCreateAccounts(fact currency.CreateAccountsFact) (Hal
 			return nil, xerrors.Errorf("empty Amounts")
 		}
 
-		if ks, err := currency.NewKeys(item.Keys().Keys(), item.Keys().Threshold()); err != nil {
+		switch ks, err := bl.rebuildKeys(item.Keys()); {
+		case err != nil:
 			return nil, err
-		} else {
+		case len(item.Salt()) > 0:
+			items[i] = currency.NewCreateAccountsItemSalted(ks, item.Amounts(), item.Salt())
+		default:
 			items[i] = currency.NewCreateAccountsItemSingleAmount(ks, item.Amounts()[0])
 		}
 	}
 
 	nfact := currency.NewCreateAccountsFact(token, fact.Sender(), items)
 	nfact = nfact.Rebulild()
+	if fact.IsExpiring() {
+		nfact = nfact.WithExpiry(fact.Expiry())
+	}
+
+	if fact.IsSequenced() {
+		nfact = nfact.WithSequence(fact.Sequence())
+	}
+
 	if err := bl.isValidFactCreateAccounts(nfact); err != nil {
 		return nil, err
 	}
@@ -236,6 +257,15 @@ func (bl Builder) buildFactCreateAccounts(fact currency.CreateAccountsFact) (Hal
 		AddExtras("signature_base", operation.NewBytesForFactSignature(nfact, bl.networkID)), nil
 }
 
+// rebuildKeys rebuilds the Keys; the thresholds by operation type are kept.
+func (Builder) rebuildKeys(ks currency.Keys) (currency.Keys, error) {
+	if ks.Hint().Equal(currency.KeysWithThresholdsHint) {
+		return currency.NewKeysWithThresholds(ks.Keys(), ks.Threshold(), ks.Thresholds())
+	}
+
+	return currency.NewKeys(ks.Keys(), ks.Threshold())
+}
+
 func (bl Builder) buildFactKeyUpdater(fact currency.KeyUpdaterFact) (Hal, error) {
 	var token []byte
 	if t, err := bl.checkToken(fact.Token()); err != nil {
@@ -245,13 +275,26 @@ func (bl Builder) buildFactKeyUpdater(fact currency.KeyUpdaterFact) (Hal, error)
 	}
 
 	var ks currency.Keys
-	if k, err := currency.NewKeys(fact.Keys().Keys(), fact.Keys().Threshold()); err != nil {
+	if k, err := bl.rebuildKeys(fact.Keys()); err != nil {
 		return nil, err
 	} else {
 		ks = k
 	}
 
-	nfact := currency.NewKeyUpdaterFact(token, fact.Target(), ks, fact.Currency())
+	newFact := currency.NewKeyUpdaterFact
+	if fact.IsStrict() {
+		newFact = currency.NewKeyUpdaterFactStrict
+	}
+
+	nfact := newFact(token, fact.Target(), ks, fact.Currency())
+	if fact.IsExpiring() {
+		nfact = nfact.WithExpiry(fact.Expiry())
+	}
+
+	if fact.IsSequenced() {
+		nfact = nfact.WithSequence(fact.Sequence())
+	}
+
 	if err := bl.isValidFactKeyUpdater(nfact); err != nil {
 		return nil, err
 	}
@@ -288,6 +331,14 @@ func (bl Builder) buildFactTransfers(fact currency.TransfersFact) (Hal, error) {
 
 	nfact := currency.NewTransfersFact(token, fact.Sender(), fact.Items())
 	nfact = nfact.Rebulild()
+	if fact.IsExpiring() {
+		nfact = nfact.WithExpiry(fact.Expiry())
+	}
+
+	if fact.IsSequenced() {
+		nfact = nfact.WithSequence(fact.Sequence())
+	}
+
 	if err := bl.isValidFactTransfers(nfact); err != nil {
 		return nil, err
 	}
@@ -323,6 +374,10 @@ func (bl Builder) buildFactCurrencyRegister(fact currency.CurrencyRegisterFact)
 	}
 
 	nfact := currency.NewCurrencyRegisterFact(token, fact.Currency())
+	if fact.IsExpiring() {
+		nfact = nfact.WithExpiry(fact.Expiry())
+	}
+
 	if err := bl.isValidFactCurrencyRegister(nfact); err != nil {
 		return nil, err
 	}
@@ -358,6 +413,14 @@ func (bl Builder) buildFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpd
 	}
 
 	nfact := currency.NewCurrencyPolicyUpdaterFact(token, fact.Currency(), fact.Policy())
+	if fact.IsScheduled() {
+		nfact = nfact.WithActivation(fact.ActivationHeight())
+	}
+
+	if fact.IsExpiring() {
+		nfact = nfact.WithExpiry(fact.Expiry())
+	}
+
 	if err := bl.isValidFactCurrencyPolicyUpdater(nfact); err != nil {
 		return nil, err
 	}
@@ -401,6 +464,10 @@ func (bl Builder) isValidFactCreateAccounts(fact currency.CreateAccountsFact) er
 		if _, same := fact.Items()[i].Keys().Key(templatePublickey); same {
 			return xerrors.Errorf("Please set key; key is same with template default")
 		}
+
+		if fact.Items()[i].Salt() == templateSalt {
+			return xerrors.Errorf("Please set salt; salt is same with template default")
+		}
 	}
 
 	return nil
@@ -14,6 +14,7 @@ import (
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/localtime"
 	"github.com/stretchr/testify/suite"
 )
@@ -222,6 +223,43 @@ func (t *testBuilder) TestBuildFactKeyUpdater() {
 	_ = t.buildOperation(uop, sb.([]byte))
 }
 
+func (t *testBuilder) TestBuildFactKeysWithThresholds() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	k, err := currency.NewKey(key.MustNewBTCPrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	ks, err := currency.NewKeysWithThresholds([]currency.Key{k}, 100, map[hint.Type]uint{currency.TransfersType: 30})
+	t.NoError(err)
+
+	item := currency.NewCreateAccountsItemSingleAmount(ks, currency.NewAmount(currency.NewBig(33), templateCurrencyID))
+	cfact := currency.NewCreateAccountsFact(util.UUID().Bytes(), templateSender, []currency.CreateAccountsItem{item})
+
+	b, err := t.JSONEnc.Marshal(cfact)
+	t.NoError(err)
+
+	uhal, err := bl.BuildFact(b)
+	t.NoError(err)
+
+	ucfact := uhal.Interface().(currency.CreateAccounts).Fact().(currency.CreateAccountsFact)
+	t.True(ks.Equal(ucfact.Items()[0].Keys()))
+	t.True(ks.Hint().Equal(ucfact.Items()[0].Keys().Hint()))
+	t.Equal(uint(30), ucfact.Items()[0].Keys().ThresholdOf(currency.TransfersType))
+
+	kfact := currency.NewKeyUpdaterFact(util.UUID().Bytes(), templateSender, ks, templateCurrencyID)
+
+	b, err = t.JSONEnc.Marshal(kfact)
+	t.NoError(err)
+
+	uhal, err = bl.BuildFact(b)
+	t.NoError(err)
+
+	ukfact := uhal.Interface().(currency.KeyUpdater).Fact().(currency.KeyUpdaterFact)
+	t.True(ks.Equal(ukfact.Keys()))
+	t.True(ks.Hint().Equal(ukfact.Keys().Hint()))
+	t.Equal(uint(30), ukfact.Keys().ThresholdOf(currency.TransfersType))
+}
+
 func (t *testBuilder) TestBuildFactTransfers() {
 	bl := NewBuilder(t.JSONEnc, t.networkID)
 
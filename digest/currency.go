@@ -1,6 +1,7 @@
 package digest
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -69,6 +70,33 @@ func LoadCurrenciesFromDatabase(
 	return nil
 }
 
+// LoadCurrencyRecordsFromDatabase loads all the states of currency designs from
+// the height in the order of height, so CurrencyPool can find the currency
+// designs of the past height.
+func LoadCurrencyRecordsFromDatabase(
+	st *mongodbstorage.Database,
+	height base.Height,
+	callback func(state.State) (bool, error),
+) error {
+	filter := util.NewBSONFilter("key", bson.M{
+		"$regex": fmt.Sprintf(`^%s`, regexp.QuoteMeta(currency.StateKeyCurrencyDesignPrefix)),
+	}).Add("height", bson.M{"$gte": height})
+
+	return st.Client().Find(
+		context.Background(),
+		mongodbstorage.ColNameState,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			if i, err := loadStateFromDecoder(cursor.Decode, st.Encoders()); err != nil {
+				return false, err
+			} else {
+				return callback(i)
+			}
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", 1).D()),
+	)
+}
+
 func loadStateFromDecoder(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
 	var b bson.Raw
 	if err := decoder(&b); err != nil {
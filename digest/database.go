@@ -28,6 +28,10 @@ var maxLimit int64 = 50
 var (
 	defaultColNameAccount   = "digest_ac"
 	defaultColNameBalance   = "digest_bl"
+	defaultColNameAllowance = "digest_aw"
+	defaultColNameAlias     = "digest_al"
+	defaultColNameData      = "digest_ad"
+	defaultColNameSequence  = "digest_sq"
 	defaultColNameOperation = "digest_op"
 )
 
@@ -177,6 +181,10 @@ func (st *Database) clean() error {
 	for _, col := range []string{
 		defaultColNameAccount,
 		defaultColNameBalance,
+		defaultColNameAllowance,
+		defaultColNameAlias,
+		defaultColNameData,
+		defaultColNameSequence,
 		defaultColNameOperation,
 	} {
 		if err := st.database.Client().Collection(col).Drop(context.Background()); err != nil {
@@ -217,6 +225,10 @@ func (st *Database) cleanByHeight(height base.Height) error {
 	for _, col := range []string{
 		defaultColNameAccount,
 		defaultColNameBalance,
+		defaultColNameAllowance,
+		defaultColNameAlias,
+		defaultColNameData,
+		defaultColNameSequence,
 		defaultColNameOperation,
 	} {
 		res, err := st.database.Client().Collection(col).BulkWrite(
@@ -428,9 +440,82 @@ func (st *Database) Operations(
 // Account returns AccountValue.
 func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, error) {
 	var rs AccountValue
+	switch i, found, err := st.accountByKeyPrefix(currency.StateAddressKeyPrefix(a)); {
+	case err != nil:
+		return rs, false, err
+	case !found:
+		return rs, false, nil
+	default:
+		rs = i
+	}
+
+	// NOTE load balance
+	switch am, lastHeight, previousHeight, err := st.balance(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetBalance(am).
+			SetHeight(lastHeight).
+			SetPreviousHeight(previousHeight)
+	}
+
+	// NOTE load account data
+	switch data, found, err := st.accountData(a); {
+	case err != nil:
+		return rs, false, err
+	case found:
+		rs = rs.SetData(data)
+	}
+
+	// NOTE load sequence
+	switch sequence, err := st.sequence(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetSequence(sequence)
+	}
+
+	return rs, true, nil
+}
+
+// accountData returns the latest currency.AccountData of account.
+func (st *Database) accountData(a base.Address) (currency.AccountData, bool /* exists */, error) {
+	var sta state.State
 	if err := st.database.Client().GetByFilter(
-		defaultColNameAccount,
+		defaultColNameData,
 		util.NewBSONFilter("address", currency.StateAddressKeyPrefix(a)).D(),
+		func(res *mongo.SingleResult) error {
+			if i, err := loadAccountData(res.Decode, st.database.Encoders()); err != nil {
+				return err
+			} else {
+				sta = i
+
+				return nil
+			}
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if xerrors.Is(err, util.NotFoundError) {
+			return currency.AccountData{}, false, nil
+		}
+
+		return currency.AccountData{}, false, err
+	}
+
+	if data, err := currency.StateAccountDataValue(sta); err != nil {
+		return currency.AccountData{}, false, err
+	} else {
+		return data, true, nil
+	}
+}
+
+// accountByKeyPrefix returns the latest AccountValue of the address key
+// prefix, currency.StateAddressKeyPrefix; the balance is not loaded.
+func (st *Database) accountByKeyPrefix(prefix string) (AccountValue, bool /* exists */, error) {
+	var rs AccountValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameAccount,
+		util.NewBSONFilter("address", prefix).D(),
 		func(res *mongo.SingleResult) error {
 			if i, err := loadAccountValue(res.Decode, st.database.Encoders()); err != nil {
 				return err
@@ -449,16 +534,6 @@ func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, er
 		return rs, false, err
 	}
 
-	// NOTE load balance
-	switch am, lastHeight, previousHeight, err := st.balance(a); {
-	case err != nil:
-		return rs, false, err
-	default:
-		rs = rs.SetBalance(am).
-			SetHeight(lastHeight).
-			SetPreviousHeight(previousHeight)
-	}
-
 	return rs, true, nil
 }
 
@@ -523,6 +598,136 @@ func (st *Database) balance(a base.Address) ([]currency.Amount, base.Height, bas
 	return ams, lastHeight, previousHeight, nil
 }
 
+// Allowances returns the last AllowanceValues, which owner approved. The
+// revoked allowances, zero amount, are also returned.
+func (st *Database) Allowances(owner base.Address) ([]AllowanceValue, error) {
+	var keys []string
+	var vas []AllowanceValue
+	for {
+		filter := util.NewBSONFilter("address", currency.StateAddressKeyPrefix(owner))
+
+		var q primitive.D
+		if len(keys) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("key", bson.M{"$nin": keys}).D()
+		}
+
+		var va AllowanceValue
+		var key string
+		if err := st.database.Client().GetByFilter(
+			defaultColNameAllowance,
+			q,
+			func(res *mongo.SingleResult) error {
+				var doc struct {
+					K string `bson:"key"`
+				}
+
+				if err := res.Decode(&doc); err != nil {
+					return err
+				} else if i, err := loadAllowanceValue(res.Decode, st.database.Encoders()); err != nil {
+					return err
+				} else {
+					va = i
+					key = doc.K
+
+					return nil
+				}
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if xerrors.Is(err, util.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		vas = append(vas, va)
+		keys = append(keys, key)
+	}
+
+	return vas, nil
+}
+
+// AllowanceOperations finds the operations, which updated the allowances of
+// owner. The arguments are same with OperationsByAddress.
+func (st *Database) AllowanceOperations(
+	owner base.Address,
+	load,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
+) error {
+	var facts []valuehash.Hash
+	if err := st.database.Client().Find(
+		context.Background(),
+		defaultColNameAllowance,
+		util.NewBSONFilter("address", currency.StateAddressKeyPrefix(owner)).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			var doc struct {
+				OP []valuehash.Bytes `bson:"operations"`
+			}
+
+			if err := cursor.Decode(&doc); err != nil {
+				return false, err
+			}
+
+			for i := range doc.OP {
+				facts = append(facts, doc.OP[i])
+			}
+
+			return true, nil
+		},
+		options.Find().SetProjection(bson.M{"operations": 1}),
+	); err != nil {
+		return err
+	}
+
+	if len(facts) < 1 {
+		return nil
+	}
+
+	var filter bson.M
+	if f, err := buildOperationsFilterByOffset(offset, reverse); err != nil {
+		return err
+	} else {
+		filter = f
+		filter["fact"] = bson.M{"$in": facts}
+	}
+
+	return st.Operations(filter, load, reverse, limit, callback)
+}
+
+// Alias returns the last AliasValue of name. The released alias is also
+// returned.
+func (st *Database) Alias(name currency.AliasName) (AliasValue, bool /* exists */, error) {
+	var va AliasValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameAlias,
+		util.NewBSONFilter("name", name.String()).D(),
+		func(res *mongo.SingleResult) error {
+			if i, err := loadAliasValue(res.Decode, st.database.Encoders()); err != nil {
+				return err
+			} else {
+				va = i
+
+				return nil
+			}
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if xerrors.Is(err, util.NotFoundError) {
+			return va, false, nil
+		}
+
+		return va, false, err
+	}
+
+	return va, true, nil
+}
+
 func loadLastBlock(st *Database) (base.Height, bool, error) {
 	switch b, found, err := st.database.Info(DigestStorageLastBlockKey); {
 	case err != nil:
@@ -589,3 +794,31 @@ func buildOperationsFilterByAddress(address base.Address, offset string, reverse
 
 	return filter, nil
 }
+
+// sequence returns the last sequence of account. If not yet set, 0 is
+// returned.
+func (st *Database) sequence(a base.Address) (uint64, error) {
+	var sta state.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNameSequence,
+		util.NewBSONFilter("address", currency.StateAddressKeyPrefix(a)).D(),
+		func(res *mongo.SingleResult) error {
+			if i, err := loadSequence(res.Decode, st.database.Encoders()); err != nil {
+				return err
+			} else {
+				sta = i
+
+				return nil
+			}
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if xerrors.Is(err, util.NotFoundError) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return currency.StateSequenceValue(sta)
+}
@@ -65,3 +65,63 @@ func loadBalance(decoder func(interface{}) error, encs *encoder.Encoders) (state
 		return st, nil
 	}
 }
+
+func loadAccountData(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadSequence(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, xerrors.Errorf("not state.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func loadAllowanceValue(decoder func(interface{}) error, encs *encoder.Encoders) (AllowanceValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return AllowanceValue{}, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return AllowanceValue{}, err
+	} else if va, ok := hinter.(AllowanceValue); !ok {
+		return AllowanceValue{}, xerrors.Errorf("not AllowanceValue: %T", hinter)
+	} else {
+		return va, nil
+	}
+}
+
+func loadAliasValue(decoder func(interface{}) error, encs *encoder.Encoders) (AliasValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return AliasValue{}, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return AliasValue{}, err
+	} else if va, ok := hinter.(AliasValue); !ok {
+		return AliasValue{}, xerrors.Errorf("not AliasValue: %T", hinter)
+	} else {
+		return va, nil
+	}
+}
@@ -81,3 +81,159 @@ func (doc BalanceDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type AccountDataDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+}
+
+// NewAccountDataDoc gets the State of AccountData
+func NewAccountDataDoc(st state.State, enc encoder.Encoder) (AccountDataDoc, error) {
+	if _, err := currency.StateAccountDataValue(st); err != nil {
+		return AccountDataDoc{}, xerrors.Errorf("AccountDataDoc needs AccountData state: %w", err)
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return AccountDataDoc{}, err
+	}
+
+	return AccountDataDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc AccountDataDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = doc.st.Key()[:len(doc.st.Key())-len(currency.StateKeyAccountDataSuffix)]
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type SequenceDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+}
+
+// NewSequenceDoc gets the State of sequence
+func NewSequenceDoc(st state.State, enc encoder.Encoder) (SequenceDoc, error) {
+	if _, err := currency.StateSequenceValue(st); err != nil {
+		return SequenceDoc{}, xerrors.Errorf("SequenceDoc needs sequence state: %w", err)
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return SequenceDoc{}, err
+	}
+
+	return SequenceDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc SequenceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = doc.st.Key()[:len(doc.st.Key())-len(currency.StateKeySequenceSuffix)]
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type AllowanceDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	va AllowanceValue
+}
+
+// NewAllowanceDoc gets the State of Allowance
+func NewAllowanceDoc(st state.State, enc encoder.Encoder) (AllowanceDoc, error) {
+	var va AllowanceValue
+	if i, err := NewAllowanceValue(st); err != nil {
+		return AllowanceDoc{}, xerrors.Errorf("AllowanceDoc needs Allowance state: %w", err)
+	} else {
+		va = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
+	if err != nil {
+		return AllowanceDoc{}, err
+	}
+
+	return AllowanceDoc{
+		BaseDoc: b,
+		st:      st,
+		va:      va,
+	}, nil
+}
+
+func (doc AllowanceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	aw := doc.va.Allowance()
+	m["key"] = doc.st.Key()
+	m["address"] = currency.StateAddressKeyPrefix(aw.Owner())
+	m["spender"] = currency.StateAddressKeyPrefix(aw.Spender())
+	m["currency"] = aw.Amount().Currency().String()
+	m["operations"] = doc.va.Operations()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type AliasDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	va AliasValue
+}
+
+// NewAliasDoc gets the State of Alias
+func NewAliasDoc(st state.State, enc encoder.Encoder) (AliasDoc, error) {
+	var va AliasValue
+	if i, err := NewAliasValue(st); err != nil {
+		return AliasDoc{}, xerrors.Errorf("AliasDoc needs Alias state: %w", err)
+	} else {
+		va = i
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
+	if err != nil {
+		return AliasDoc{}, err
+	}
+
+	return AliasDoc{
+		BaseDoc: b,
+		st:      st,
+		va:      va,
+	}, nil
+}
+
+func (doc AliasDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["name"] = doc.va.Name().String()
+	if !doc.va.IsReleased() {
+		m["address"] = currency.StateAddressKeyPrefix(doc.va.Address())
+	}
+	m["operations"] = doc.va.Operations()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -41,8 +41,11 @@ var (
 	HandlerPathOperationsByHeight         = `/block/{height:[0-9]+}/operations`
 	HandlerPathManifestByHeight           = `/block/{height:[0-9]+}/manifest`
 	HandlerPathManifestByHash             = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}/manifest`
-	HandlerPathAccount                    = `/account/{address:(?i)[0-9a-z][0-9a-z\-]+\-[a-z0-9]{4}\:[a-z0-9\.]*}`
-	HandlerPathAccountOperations          = `/account/{address:(?i)[0-9a-z][0-9a-z\-]+\-[a-z0-9]{4}\:[a-z0-9\.]*}/operations` // nolint:lll
+	HandlerPathAccount                    = `/account/{address:(?i)[0-9a-z][0-9a-z\-]+(?:~[0-9a-f]{8})?\-[a-z0-9]{4}\:[a-z0-9\.]*}`
+	HandlerPathAccountOperations          = `/account/{address:(?i)[0-9a-z][0-9a-z\-]+(?:~[0-9a-f]{8})?\-[a-z0-9]{4}\:[a-z0-9\.]*}/operations`            // nolint:lll
+	HandlerPathAccountAllowances          = `/account/{address:(?i)[0-9a-z][0-9a-z\-]+(?:~[0-9a-f]{8})?\-[a-z0-9]{4}\:[a-z0-9\.]*}/allowances`            // nolint:lll
+	HandlerPathAccountAllowanceOperations = `/account/{address:(?i)[0-9a-z][0-9a-z\-]+(?:~[0-9a-f]{8})?\-[a-z0-9]{4}\:[a-z0-9\.]*}/allowances/operations` // nolint:lll
+	HandlerPathAlias                      = `/alias/{name:[a-z][a-z0-9_\-]*[a-z0-9]}`
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
@@ -156,6 +159,12 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccountOperations, hd.handleAccountOperations, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountAllowances, hd.handleAccountAllowances, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountAllowanceOperations, hd.handleAccountAllowanceOperations, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAlias, hd.handleAlias, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathOperationBuildFact, hd.handleOperationBuildFact, false).
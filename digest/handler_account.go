@@ -86,6 +86,18 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 			AddLink("operations:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
 	}
 
+	if h, err := hd.combineURL(HandlerPathAccountAllowances, "address", hinted); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("allowances", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccountAllowanceOperations, "address", hinted); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("allowance_operations", NewHalLink(h, nil))
+	}
+
 	if h, err := hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String()); err != nil {
 		return nil, err
 	} else {
@@ -106,6 +118,20 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 		}
 	}
 
+	if va.IsClosed() {
+		if h, err := hd.combineURL(HandlerPathOperation, "hash", va.ClosedBy().String()); err != nil {
+			return nil, err
+		} else {
+			hal = hal.AddLink("closed_by", NewHalLink(h, nil))
+		}
+
+		if h, err := hd.combineURL(HandlerPathAccount, "address", va.ClosedTo().String()); err != nil {
+			return nil, err
+		} else {
+			hal = hal.AddLink("closed_to", NewHalLink(h, nil))
+		}
+	}
+
 	return hal, nil
 }
 
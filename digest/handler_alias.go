@@ -0,0 +1,90 @@
+package digest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+func (hd *Handlers) handleAlias(w http.ResponseWriter, r *http.Request) {
+	cachekey := cacheKeyPath(r)
+	if err := loadFromCache(hd.cache, cachekey, w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	name := currency.AliasName(strings.TrimSpace(mux.Vars(r)["name"]))
+	if err := name.IsValid(nil); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAliasInGroup(name)
+	}); err != nil {
+		hd.handleError(w, err)
+	} else {
+		hd.writeHalBytes(w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			hd.writeCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleAliasInGroup(name currency.AliasName) ([]byte, error) {
+	switch va, found, err := hd.database.Alias(name); {
+	case err != nil:
+		return nil, err
+	case !found, va.IsReleased():
+		return nil, util.NotFoundError.Errorf("alias not found")
+	default:
+		if hal, err := hd.buildAliasHal(va); err != nil {
+			return nil, err
+		} else {
+			return hd.enc.Marshal(hal)
+		}
+	}
+}
+
+func (hd *Handlers) buildAliasHal(va AliasValue) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAlias, "name", va.Name().String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(va, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", va.Address().String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("block", NewHalLink(h, nil))
+	}
+
+	for i := range va.Operations() {
+		fh := va.Operations()[i].String()
+		if h, err := hd.combineURL(HandlerPathOperation, "hash", fh); err != nil {
+			return nil, err
+		} else {
+			hal = hal.AddLink(fmt.Sprintf("operation:%s", fh), NewHalLink(h, nil))
+		}
+	}
+
+	return hal, nil
+}
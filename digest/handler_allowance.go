@@ -0,0 +1,207 @@
+package digest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (hd *Handlers) handleAccountAllowances(w http.ResponseWriter, r *http.Request) {
+	cachekey := cacheKeyPath(r)
+	if err := loadFromCache(hd.cache, cachekey, w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountAllowancesInGroup(address)
+	}); err != nil {
+		hd.handleError(w, err)
+	} else {
+		hd.writeHalBytes(w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			hd.writeCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountAllowancesInGroup(address base.Address) ([]byte, error) {
+	var vas []Hal
+	switch l, err := hd.database.Allowances(address); {
+	case err != nil:
+		return nil, err
+	case len(l) < 1:
+		return nil, util.NotFoundError.Errorf("allowances not found")
+	default:
+		vas = make([]Hal, len(l))
+		for i := range l {
+			if hal, err := hd.buildAllowanceHal(l[i]); err != nil {
+				return nil, err
+			} else {
+				vas[i] = hal
+			}
+		}
+	}
+
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAccountAllowances, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(vas, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("account", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccountAllowanceOperations, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("operations", NewHalLink(h, nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) buildAllowanceHal(va AllowanceValue) (Hal, error) {
+	var hal Hal
+	if h, err := hd.combineURL(HandlerPathAccountAllowances, "address", va.Allowance().Owner().String()); err != nil {
+		return nil, err
+	} else {
+		hal = NewBaseHal(va, NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathAccount, "address", va.Allowance().Spender().String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("spender", NewHalLink(h, nil))
+	}
+
+	if h, err := hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("block", NewHalLink(h, nil))
+	}
+
+	for i := range va.Operations() {
+		fh := va.Operations()[i].String()
+		if h, err := hd.combineURL(HandlerPathOperation, "hash", fh); err != nil {
+			return nil, err
+		} else {
+			hal = hal.AddLink(fmt.Sprintf("operation:%s", fh), NewHalLink(h, nil))
+		}
+	}
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountAllowanceOperations(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(hd.enc, strings.TrimSpace(mux.Vars(r)["address"])); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		hd.problemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := cacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse))
+	if err := loadFromCache(hd.cache, cachekey, w); err != nil {
+		hd.Log().Verbose().Err(err).Msg("failed to load cache")
+	} else {
+		hd.Log().Verbose().Msg("loaded from cache")
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountAllowanceOperationsInGroup(address, offset, reverse)
+	}); err != nil {
+		hd.handleError(w, err)
+	} else {
+		hd.writeHalBytes(w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			hd.writeCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountAllowanceOperationsInGroup(
+	address base.Address,
+	offset string,
+	reverse bool,
+) ([]byte, error) {
+	limit := hd.itemsLimiter("account-operations")
+	var vas []Hal
+	if err := hd.database.AllowanceOperations(
+		address, true, reverse, offset, limit,
+		func(_ valuehash.Hash, va OperationValue) (bool, error) {
+			if hal, err := hd.buildOperationHal(va); err != nil {
+				return false, err
+			} else {
+				vas = append(vas, hal)
+			}
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("operations not found")
+	}
+
+	var baseSelf string
+	if h, err := hd.combineURL(HandlerPathAccountAllowanceOperations, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		baseSelf = h
+	}
+
+	hal := hd.buildOperationsHal(baseSelf, vas, offset, reverse)
+
+	if h, err := hd.combineURL(HandlerPathAccountAllowances, "address", address.String()); err != nil {
+		return nil, err
+	} else {
+		hal = hal.AddLink("allowances", NewHalLink(h, nil))
+	}
+
+	va := vas[len(vas)-1].Interface().(OperationValue)
+	next := addQueryValue(baseSelf, stringOffsetQuery(buildOffset(va.Height(), va.Index())))
+	if reverse {
+		next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+	}
+
+	hal = hal.AddLink("next", NewHalLink(next, nil))
+
+	return hd.enc.Marshal(hal)
+}
@@ -126,6 +126,25 @@ func (hd *Handlers) buildCurrency(de currency.CurrencyDesign, st state.State) (H
 
 	hal = hal.AddLink("currency:{currencyid}", NewHalLink(HandlerPathCurrency, nil).SetTemplated())
 
+	if hd.cp != nil {
+		if i, found := hd.cp.Feeer(de.Currency()); found {
+			if j, ok := i.(currency.DynamicFeeer); ok {
+				hal = hal.AddExtras("base_fee", j.BaseFee())
+			}
+		}
+
+		if i, found := hd.cp.PendingPolicy(de.Currency()); found {
+			hal = hal.AddExtras("pending_policy", i)
+		}
+	}
+
+	if po := de.Policy(); po.HasTransferLimits() {
+		hal = hal.AddExtras("transfer_limits", map[string]currency.Big{
+			"min": po.MinTransferAmount(),
+			"max": po.MaxTransferAmount(),
+		})
+	}
+
 	if h, err := hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String()); err != nil {
 		return nil, err
 	} else {
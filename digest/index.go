@@ -39,6 +39,63 @@ var balanceIndexModels = []mongo.IndexModel{
 	},
 }
 
+var allowanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_key"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_height"),
+	},
+}
+
+var aliasIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "name", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias_height"),
+	},
+}
+
+var dataIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_data"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_data_height"),
+	},
+}
+
+var sequenceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_sequence"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_sequence_height"),
+	},
+}
+
 var operationIndexModels = []mongo.IndexModel{
 	{
 		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
@@ -60,5 +117,9 @@ var operationIndexModels = []mongo.IndexModel{
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
 	defaultColNameAccount:   accountIndexModels,
 	defaultColNameBalance:   balanceIndexModels,
+	defaultColNameAllowance: allowanceIndexModels,
+	defaultColNameAlias:     aliasIndexModels,
+	defaultColNameData:      dataIndexModels,
+	defaultColNameSequence:  sequenceIndexModels,
 	defaultColNameOperation: operationIndexModels,
 }
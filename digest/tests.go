@@ -61,19 +61,27 @@ func (t *baseTest) SetupSuite() {
 	}
 
 	_ = t.Encs.AddHinter(AccountValue{})
+	_ = t.Encs.AddHinter(AliasValue{})
+	_ = t.Encs.AddHinter(AllowanceValue{})
 	_ = t.Encs.AddHinter(BaseHal{})
 	_ = t.Encs.AddHinter(NodeInfo{})
 	_ = t.Encs.AddHinter(OperationValue{})
 	_ = t.Encs.AddHinter(Problem{})
 	_ = t.Encs.AddHinter(currency.Account{})
 	_ = t.Encs.AddHinter(currency.Address(""))
+	_ = t.Encs.AddHinter(currency.AddressChecksumHinter)
+	_ = t.Encs.AddHinter(currency.Allowance{})
 	_ = t.Encs.AddHinter(currency.Amount{})
+	_ = t.Encs.AddHinter(currency.ApproveFact{})
+	_ = t.Encs.AddHinter(currency.Approve{})
 	_ = t.Encs.AddHinter(currency.CreateAccountsFact{})
 	_ = t.Encs.AddHinter(currency.CreateAccountsItemMultiAmountsHinter)
 	_ = t.Encs.AddHinter(currency.CreateAccountsItemSingleAmountHinter)
+	_ = t.Encs.AddHinter(currency.CreateAccountsItemSaltedHinter)
 	_ = t.Encs.AddHinter(currency.CreateAccounts{})
 	_ = t.Encs.AddHinter(currency.CurrencyDesign{})
 	_ = t.Encs.AddHinter(currency.CurrencyPolicyUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.CurrencyPolicyUpdaterFactScheduledHinter)
 	_ = t.Encs.AddHinter(currency.CurrencyPolicyUpdater{})
 	_ = t.Encs.AddHinter(currency.CurrencyRegisterFact{})
 	_ = t.Encs.AddHinter(currency.CurrencyRegister{})
@@ -83,16 +91,83 @@ func (t *baseTest) SetupSuite() {
 	_ = t.Encs.AddHinter(currency.GenesisCurrenciesFact{})
 	_ = t.Encs.AddHinter(currency.GenesisCurrencies{})
 	_ = t.Encs.AddHinter(currency.KeyUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.KeyUpdaterFactStrictHinter)
+	_ = t.Encs.AddHinter(currency.TransfersFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.CreateAccountsFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.KeyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.AccountDataUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.AccountMergeFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.AccountPolicyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.AliasRegisterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.AliasReleaseFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.AliasTransferFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.ApproveFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.BatchFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.ClaimBalanceFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.CreateClaimableBalanceFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.CurrencyPolicyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.CurrencyRegisterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.GuardiansUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.KeyRecoveryFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.KeyRecoveryCancelerFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.MultiTransfersFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.NetworkPolicyUpdaterFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.ReclaimBalanceFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.TransferFromFactExpiringHinter)
+	_ = t.Encs.AddHinter(currency.TransfersFactSequencedHinter)
+	_ = t.Encs.AddHinter(currency.CreateAccountsFactSequencedHinter)
+	_ = t.Encs.AddHinter(currency.KeyUpdaterFactSequencedHinter)
 	_ = t.Encs.AddHinter(currency.KeyUpdater{})
 	_ = t.Encs.AddHinter(currency.Keys{})
+	_ = t.Encs.AddHinter(currency.KeysWithThresholdsHinter)
 	_ = t.Encs.AddHinter(currency.Key{})
+	_ = t.Encs.AddHinter(currency.MultiTransfersFact{})
+	_ = t.Encs.AddHinter(currency.MultiTransfersSender{})
+	_ = t.Encs.AddHinter(currency.MultiTransfers{})
+	_ = t.Encs.AddHinter(currency.BatchFact{})
+	_ = t.Encs.AddHinter(currency.Batch{})
+	_ = t.Encs.AddHinter(currency.AccountClosed{})
+	_ = t.Encs.AddHinter(currency.AccountMergeFact{})
+	_ = t.Encs.AddHinter(currency.AccountMerge{})
+	_ = t.Encs.AddHinter(currency.Guardians{})
+	_ = t.Encs.AddHinter(currency.Recovery{})
+	_ = t.Encs.AddHinter(currency.GuardiansUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.GuardiansUpdater{})
+	_ = t.Encs.AddHinter(currency.KeyRecoveryFact{})
+	_ = t.Encs.AddHinter(currency.KeyRecovery{})
+	_ = t.Encs.AddHinter(currency.KeyRecoveryCancelerFact{})
+	_ = t.Encs.AddHinter(currency.KeyRecoveryCanceler{})
+	_ = t.Encs.AddHinter(currency.AccountPolicy{})
+	_ = t.Encs.AddHinter(currency.AccountSpent{})
+	_ = t.Encs.AddHinter(currency.PendingAccountPolicy{})
+	_ = t.Encs.AddHinter(currency.AccountPolicyUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.AccountPolicyUpdater{})
+	_ = t.Encs.AddHinter(currency.Alias{})
+	_ = t.Encs.AddHinter(currency.AliasRegisterFact{})
+	_ = t.Encs.AddHinter(currency.AliasRegister{})
+	_ = t.Encs.AddHinter(currency.AliasTransferFact{})
+	_ = t.Encs.AddHinter(currency.AliasTransfer{})
+	_ = t.Encs.AddHinter(currency.AliasReleaseFact{})
+	_ = t.Encs.AddHinter(currency.AliasRelease{})
+	_ = t.Encs.AddHinter(currency.AccountData{})
+	_ = t.Encs.AddHinter(currency.AccountDataUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.AccountDataUpdater{})
 	_ = t.Encs.AddHinter(currency.NilFeeer{})
 	_ = t.Encs.AddHinter(currency.RatioFeeer{})
+	_ = t.Encs.AddHinter(currency.DynamicFeeer{})
+	_ = t.Encs.AddHinter(currency.TransferFromFact{})
+	_ = t.Encs.AddHinter(currency.TransferFrom{})
 	_ = t.Encs.AddHinter(currency.TransfersFact{})
 	_ = t.Encs.AddHinter(currency.TransfersItemMultiAmountsHinter)
 	_ = t.Encs.AddHinter(currency.TransfersItemSingleAmountHinter)
 	_ = t.Encs.AddHinter(currency.Transfers{})
 	_ = t.Encs.AddHinter(currency.CurrencyPolicy{})
+	_ = t.Encs.AddHinter(currency.CurrencyPolicyMinBalanceHinter)
+	_ = t.Encs.AddHinter(currency.CurrencyPolicyTransferLimitsHinter)
+	_ = t.Encs.AddHinter(currency.PendingCurrencyPolicy{})
+	_ = t.Encs.AddHinter(currency.NetworkPolicyUpdaterFact{})
+	_ = t.Encs.AddHinter(currency.NetworkPolicyUpdater{})
+	_ = t.Encs.AddHinter(currency.NetworkPolicy{})
 
 	t.networkID = util.UUID().Bytes()
 